@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/core"
+)
+
+// maxMagnetLineSize bounds the length of a single line in a magnet list
+const maxMagnetLineSize = 1024 * 1024
+
+// batchMagnet is a magnet URI read from a magnet list with its line number
+type batchMagnet struct {
+	line   int
+	magnet string
+}
+
+// batchAddResult is the outcome of adding a single magnet from a magnet list
+type batchAddResult struct {
+	batchMagnet
+	name    string
+	err     error
+	exists  bool // Already in qBittorrent, so it was skipped
+	warning string
+}
+
+// NewAddBatchCommand creates the add-batch command that adds magnets from a file
+func NewAddBatchCommand(ctx context.Context, torrentService *core.TorrentService, seedingService *core.SeedingService) *cobra.Command {
+	var file string
+	var category string
+	var paused bool
+	var parallel int
+
+	cmd := &cobra.Command{
+		Use:   "add-batch",
+		Short: "📥 Add torrents from a list of magnets",
+		Long: `📥 Add torrents from a list of magnet URIs
+
+Reads one magnet URI per line from a file, or from stdin with --file -.
+Blank lines and lines starting with # are skipped. Every magnet is added to
+the same category; a failed magnet doesn't stop the others, and a summary with
+the failed line numbers is shown at the end.
+
+Examples:
+  akira add-batch --file magnets.txt                      # Add every magnet in the file
+  akira add-batch --file magnets.txt --category movies    # Add them all to movies
+  akira magnets | akira add-batch --file -                # Read the magnets from stdin
+  akira add-batch --file magnets.txt --parallel 4         # Add four at a time`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			in := cmd.InOrStdin()
+			if file != "-" {
+				f, err := os.Open(file)
+				if err != nil {
+					return fmt.Errorf("failed to open magnet list: %w", err)
+				}
+				defer f.Close()
+				in = f
+			}
+			return runAddBatchCommand(ctx, in, cmd.OutOrStdout(), torrentService, seedingService, category, paused, parallel)
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "", "file with one magnet URI per line, or - for stdin")
+	cmd.Flags().StringVar(&category, "category", "", "category for all torrents ("+categoryList(torrentService)+")")
+	cmd.Flags().BoolVar(&paused, "paused", false, "add the torrents in the paused state")
+	cmd.Flags().IntVar(&parallel, "parallel", 1, "number of torrents to add at the same time")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+// runAddBatchCommand implements the add-batch command functionality
+func runAddBatchCommand(ctx context.Context, in io.Reader, out io.Writer, torrentService *core.TorrentService,
+	seedingService *core.SeedingService, category string, paused bool, parallel int) error {
+
+	if parallel < 1 {
+		return validationError(fmt.Errorf("--parallel must be at least 1"))
+	}
+	if category != "" {
+		if err := cli.ValidateCategory(category, torrentService.Categories()); err != nil && !torrentService.CanCreateCategories() {
+			return err
+		}
+		category = strings.ToLower(category)
+	}
+
+	magnets, err := readMagnetList(in)
+	if err != nil {
+		return err
+	}
+	if len(magnets) == 0 {
+		fmt.Fprintln(out, "✨ No magnet URIs to add")
+		return nil
+	}
+
+	fmt.Fprintf(out, "📥 %s\n\n", cli.ColorHeader.Sprintf("Adding %d torrent(s)...", len(magnets)))
+
+	results := make([]batchAddResult, len(magnets))
+	var outMutex sync.Mutex
+	var group errgroup.Group
+	group.SetLimit(parallel)
+
+	for i, magnet := range magnets {
+		group.Go(func() error {
+			result := addBatchMagnet(ctx, torrentService, seedingService, magnet, category, paused)
+			results[i] = result
+
+			outMutex.Lock()
+			defer outMutex.Unlock()
+			printBatchAddResult(out, result)
+			return nil
+		})
+	}
+	group.Wait()
+
+	// Summary, with the failures in line order
+	failed, skipped := 0, 0
+	for _, result := range results {
+		if result.err != nil {
+			failed++
+		} else if result.exists {
+			skipped++
+		}
+	}
+
+	fmt.Fprintf(out, "\n📊 %s\n", cli.ColorHeader.Sprint("Summary"))
+	fmt.Fprintf(out, "   Added: %s\n", cli.ColorSeeding.Sprint(len(magnets)-failed-skipped))
+	if skipped > 0 {
+		fmt.Fprintf(out, "   Already added: %s\n", cli.ColorPaused.Sprint(skipped))
+	}
+	if failed > 0 {
+		fmt.Fprintf(out, "   Failed: %s\n", cli.ColorError.Sprint(failed))
+		for _, result := range results {
+			if result.err != nil {
+				fmt.Fprintf(out, "   • Line %d: %v\n", result.line, result.err)
+			}
+		}
+	}
+
+	switch {
+	case failed == len(magnets):
+		return fmt.Errorf("failed to add all %d torrent(s)", failed)
+	case failed > 0:
+		return NewExitError(ExitPartialFailure, fmt.Errorf("failed to add %d of %d torrent(s)", failed, len(magnets)))
+	}
+	return nil
+}
+
+// addBatchMagnet adds a single magnet and starts seeding tracking for it
+func addBatchMagnet(ctx context.Context, torrentService *core.TorrentService, seedingService *core.SeedingService,
+	magnet batchMagnet, category string, paused bool) batchAddResult {
+
+	result := batchAddResult{batchMagnet: magnet}
+
+	magnetInfo, err := cli.ExtractMagnetInfo(magnet.magnet)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	result.name = magnetInfo.DisplayName
+
+	torrent, err := torrentService.AddMagnet(ctx, &core.AddTorrentRequest{
+		MagnetURI: magnet.magnet,
+		Category:  category,
+		Paused:    paused,
+	})
+	var existsErr *core.TorrentExistsError
+	if errors.As(err, &existsErr) {
+		result.name = existsErr.Name
+		result.exists = true
+		return result
+	}
+	if err != nil {
+		result.err = err
+		return result
+	}
+
+	hash := core.NormalizeInfoHash(magnetInfo.Hash)
+	if torrent != nil {
+		hash = torrent.Hash
+		if torrent.Name != "" {
+			result.name = torrent.Name
+		}
+	}
+
+	if err := seedingService.StartTracking(ctx, hash, result.name); err != nil {
+		result.warning = fmt.Sprintf("failed to start seeding tracking: %v", err)
+	}
+	return result
+}
+
+// printBatchAddResult prints the outcome of adding a single magnet
+func printBatchAddResult(out io.Writer, result batchAddResult) {
+	name := result.name
+	if name == "" {
+		name = cli.TruncateString(result.magnet, 60)
+	}
+
+	if result.err != nil {
+		fmt.Fprintf(out, "❌ Line %d: %s - %v\n", result.line, cli.TruncateString(name, 60), result.err)
+		return
+	}
+	if result.exists {
+		fmt.Fprintf(out, "⏭️  Line %d: %s - already added\n", result.line, cli.TruncateString(name, 60))
+		return
+	}
+	fmt.Fprintf(out, "✅ Line %d: %s\n", result.line, cli.TruncateString(name, 60))
+	if result.warning != "" {
+		fmt.Fprintf(out, "   ⚠️  Warning: %s\n", result.warning)
+	}
+}
+
+// readMagnetList reads one magnet URI per line, skipping blank lines and # comments
+func readMagnetList(in io.Reader) ([]batchMagnet, error) {
+	var magnets []batchMagnet
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), maxMagnetLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		magnets = append(magnets, batchMagnet{line: line, magnet: text})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read magnet list: %w", err)
+	}
+	return magnets, nil
+}
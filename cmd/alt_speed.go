@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/core"
+)
+
+// NewAltSpeedCommand creates the alt-speed command
+func NewAltSpeedCommand(ctx context.Context, torrentService *core.TorrentService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:       "alt-speed [on|off|toggle|status]",
+		Short:     "🐢 Switch qBittorrent's alternative speed limits",
+		ValidArgs: []string{"on", "off", "toggle", "status"},
+		Args:      cobra.MatchAll(cobra.MaximumNArgs(1), cobra.OnlyValidArgs),
+		Long: `🐢 Switch qBittorrent's alternative speed limits
+
+The alternative speed limits are a second set of global limits configured in
+qBittorrent, handy for throttling transfers during peak hours. Without an
+argument the current mode is shown.
+
+Examples:
+  akira alt-speed             # Show whether the alternative limits are active
+  akira alt-speed on          # Throttle to the alternative limits
+  akira alt-speed off         # Go back to the normal limits
+  akira alt-speed toggle      # Switch to the other mode`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			action := "status"
+			if len(args) > 0 {
+				action = args[0]
+			}
+			return runAltSpeedCommand(ctx, cmd.OutOrStdout(), torrentService, action)
+		},
+	}
+
+	return cmd
+}
+
+// runAltSpeedCommand implements the alt-speed command functionality
+func runAltSpeedCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService, action string) error {
+	var enabled bool
+	var err error
+
+	switch action {
+	case "status":
+		enabled, err = torrentService.GetAlternativeSpeedLimits(ctx)
+	case "on":
+		enabled, err = torrentService.SetAlternativeSpeedLimits(ctx, true)
+	case "off":
+		enabled, err = torrentService.SetAlternativeSpeedLimits(ctx, false)
+	case "toggle":
+		enabled, err = torrentService.ToggleAlternativeSpeedLimits(ctx)
+	default:
+		return validationError(fmt.Errorf("invalid action '%s' (use on, off, toggle or status)", action))
+	}
+	if err != nil {
+		return err
+	}
+
+	if enabled {
+		fmt.Fprintf(out, "🐢 %s\n", cli.ColorPaused.Sprint("Alternative speed limits are ON"))
+	} else {
+		fmt.Fprintf(out, "🚀 %s\n", cli.ColorSeeding.Sprint("Alternative speed limits are OFF (normal limits)"))
+	}
+
+	return nil
+}
@@ -1,43 +1,53 @@
 package cmd
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/raainshe/akira/internal/cache"
 	"github.com/raainshe/akira/internal/cli"
 	"github.com/raainshe/akira/internal/config"
 	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/logging"
 	"github.com/raainshe/akira/internal/qbittorrent"
 	"github.com/raainshe/akira/internal/tui"
 )
 
 // NewTUICommand creates the TUI command
 func NewTUICommand(ctx context.Context, cfg *config.Config, torrentService *core.TorrentService,
-	diskService *core.DiskService, seedingService *core.SeedingService, qbClient *qbittorrent.Client) *cobra.Command {
+	diskService *core.DiskService, seedingService *core.SeedingService, qbClient *qbittorrent.Client,
+	cacheManager *cache.CacheManager) *cobra.Command {
 
 	return &cobra.Command{
 		Use:   "tui",
 		Short: "🌟 Launch interactive TUI",
 		Long:  "Launch the beautiful interactive Terminal User Interface for torrent management",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return tui.Run(ctx, cfg, torrentService, diskService, seedingService, qbClient)
+			MarkSessionRan()
+			return tui.Run(ctx, cfg, torrentService, diskService, seedingService, qbClient, cacheManager)
 		},
 	}
 }
 
 // NewListCommand creates the list command
-func NewListCommand(ctx context.Context, torrentService *core.TorrentService) *cobra.Command {
-	var category string
-	var state string
-	var seedingOnly bool
-	var downloadingOnly bool
+func NewListCommand(ctx context.Context, torrentService *core.TorrentService, seedingService *core.SeedingService) *cobra.Command {
+	var opts listOptions
+	var output string
 	var jsonOutput bool
+	var statsOnly bool
+	var errorsOnly bool
+	var stalledOnly bool
+	var stalledThreshold time.Duration
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -48,34 +58,187 @@ This command displays all torrents with a beautiful table format including:
 - Progress bars and completion status
 - Download/upload speeds and ETA
 - Color-coded states (downloading, seeding, paused, error)
-- Filtering by category, state, and activity
-- JSON output for scripting
+- Filtering by category, tag, name, state, activity, size, ratio and age
+- Sorting and limiting the number of torrents shown
+- A choice of table columns, with --columns
+- JSON or CSV output for scripts and spreadsheets, with --output
+- Live monitoring that redraws the table, with --watch
+- Aggregate statistics only, with --stats
+- Error triage with quick fixes (recheck, reannounce, delete), with --errors
+- Downloads stalled longer than a threshold, with --stalled
 
 Examples:
   akira list                           # Show all torrents
   akira list --category movies         # Show only movies
+  akira list --tag foo                 # Show only torrents tagged foo
+  akira list --search "ubuntu.*24"     # Names matching a case-insensitive regex
   akira list --seeding-only           # Show only seeding torrents
   akira list --downloading            # Show only downloading torrents
   akira list --state downloading      # Show only downloading (alternative)
-  akira list --json                   # JSON output for scripts`,
+  akira list --output json            # JSON output for scripts
+  akira list --output csv > torrents.csv  # CSV for spreadsheets
+  akira list --reverse                # Reverse the listing order
+  akira list --sort size --desc --limit 10  # The 10 biggest torrents
+  akira list --min-size 1G --max-size 4.5G  # Torrents between 1 GiB and 4.5 GiB
+  akira list --seeding-only --max-ratio 1   # Seeding torrents below a ratio of 1
+  akira list --completed-before 30d         # Completed more than 30 days ago
+  akira list --added-after 2025-01-01 --columns name,size,age  # Added this year, with their age
+  akira list --columns name,size,ratio --no-summary  # Only the chosen columns, no summary
+  akira list --snapshot before.json   # Save current state for 'akira diff'
+  akira list --watch --downloading    # Redraw active downloads every 2s until Ctrl+C
+  akira list --watch --interval 10s   # Redraw every 10 seconds
+  akira list --stats                  # Show only aggregate statistics
+  akira list --stats --output json    # Statistics as JSON for dashboards
+  akira list --errors                 # Triage errored torrents interactively
+  akira list --errors --output json   # List errored torrents for scripts
+  akira list --stalled                # Downloads stalled past QBITTORRENT_STALLED_THRESHOLD
+  akira list --stalled --stalled-threshold 1h  # Only downloads stalled for an hour or more`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := resolveOutputFormat(cmd, output, jsonOutput)
+			if err != nil {
+				return err
+			}
+			opts.output = format
+			jsonOutput = format == cli.OutputJSON
+
+			if opts.watch && (statsOnly || errorsOnly || stalledOnly) {
+				return validationError(fmt.Errorf("--watch cannot be combined with --stats, --errors or --stalled"))
+			}
+			if format == cli.OutputCSV && (statsOnly || errorsOnly || stalledOnly) {
+				return validationError(fmt.Errorf("--output csv cannot be combined with --stats, --errors or --stalled"))
+			}
+			if stalledOnly {
+				if opts.filtered() || opts.snapshotFile != "" || statsOnly || errorsOnly {
+					return validationError(fmt.Errorf("--stalled cannot be combined with other filters, --snapshot, --stats or --errors"))
+				}
+				return runListStalledCommand(ctx, cmd.OutOrStdout(), torrentService, stalledThreshold, jsonOutput)
+			}
+			if errorsOnly {
+				if opts.filtered() || opts.snapshotFile != "" || statsOnly {
+					return validationError(fmt.Errorf("--errors cannot be combined with other filters, --snapshot or --stats"))
+				}
+				interactive := !jsonOutput && isTerminal(cmd.InOrStdin())
+				return runListErrorsCommand(ctx, cmd.OutOrStdout(), cmd.InOrStdin(), torrentService, seedingService, jsonOutput, interactive)
+			}
+			if statsOnly {
+				if opts.filtered() || opts.snapshotFile != "" {
+					return validationError(fmt.Errorf("--stats covers all torrents and cannot be combined with filters or --snapshot"))
+				}
+				return runListStatsCommand(ctx, cmd.OutOrStdout(), torrentService, jsonOutput)
+			}
+			if opts.watch {
+				if format != cli.OutputTable || opts.snapshotFile != "" {
+					return validationError(fmt.Errorf("--watch cannot be combined with --output %s or --snapshot", format))
+				}
+				return runListWatchCommand(ctx, cmd.OutOrStdout(), torrentService, opts)
+			}
+			return runListCommand(ctx, cmd.OutOrStdout(), torrentService, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.category, "category", "", "filter by category ("+categoryList(torrentService)+")")
+	cmd.Flags().StringVar(&opts.tag, "tag", "", "filter by tag")
+	cmd.Flags().StringVar(&opts.search, "search", "", "filter by name (case-insensitive regex)")
+	cmd.Flags().StringVarP(&opts.state, "state", "s", "", "filter by state (downloading, seeding, paused, error)")
+	cmd.Flags().BoolVar(&opts.seedingOnly, "seeding-only", false, "show only seeding torrents")
+	cmd.Flags().BoolVar(&opts.downloadingOnly, "downloading", false, "show only downloading torrents")
+	cmd.Flags().StringVar(&opts.minSize, "min-size", "", "show only torrents at least this big (e.g. 500M, 1G)")
+	cmd.Flags().StringVar(&opts.maxSize, "max-size", "", "show only torrents at most this big (e.g. 500M, 1G)")
+	cmd.Flags().Float64Var(&opts.minRatio, "min-ratio", 0, "show only torrents with at least this share ratio")
+	cmd.Flags().Float64Var(&opts.maxRatio, "max-ratio", 0, "show only torrents with at most this share ratio")
+	cmd.Flags().StringVar(&opts.addedBefore, "added-before", "", "show only torrents added before this age or date (e.g. 7d, 2025-01-31)")
+	cmd.Flags().StringVar(&opts.addedAfter, "added-after", "", "show only torrents added after this age or date (e.g. 7d, 2025-01-31)")
+	cmd.Flags().StringVar(&opts.completedBefore, "completed-before", "", "show only torrents completed before this age or date (e.g. 30d)")
+	cmd.Flags().StringVar(&opts.completedAfter, "completed-after", "", "show only torrents completed after this age or date (e.g. 30d)")
+	addOutputFlags(cmd, &output, &jsonOutput)
+	cmd.Flags().BoolVarP(&opts.reverse, "reverse", "r", false, "reverse the listing order")
+	cmd.Flags().StringVar(&opts.sortBy, "sort", "", "sort by field ("+sortFieldList()+")")
+	cmd.Flags().BoolVar(&opts.sortDesc, "desc", false, "sort in descending order")
+	cmd.Flags().IntVar(&opts.limit, "limit", 0, "show at most this many torrents (0 for all)")
+	cmd.Flags().StringVar(&opts.columns, "columns", "", "table columns in order ("+strings.Join(cli.TorrentColumnNames, ", ")+")")
+	cmd.Flags().BoolVar(&opts.noSummary, "no-summary", false, "leave out the summary after the table")
+	cmd.Flags().StringVar(&opts.snapshotFile, "snapshot", "", "save the listed torrents to a snapshot file")
+	cmd.Flags().BoolVar(&opts.watch, "watch", false, "redraw the list on an interval until interrupted")
+	cmd.Flags().DurationVar(&opts.interval, "interval", 2*time.Second, "how often --watch refreshes the list")
+	cmd.Flags().BoolVar(&statsOnly, "stats", false, "show only aggregate torrent statistics")
+	cmd.Flags().BoolVar(&errorsOnly, "errors", false, "show only errored torrents and offer quick fixes")
+	cmd.Flags().BoolVar(&stalledOnly, "stalled", false, "show only downloads stalled longer than the stalled threshold")
+	cmd.Flags().DurationVar(&stalledThreshold, "stalled-threshold", torrentService.StalledThreshold(),
+		"how long a download must be inactive to count as stalled")
+
+	return cmd
+}
+
+// NewDiffCommand creates the diff command for comparing torrent snapshots
+func NewDiffCommand(ctx context.Context, torrentService *core.TorrentService) *cobra.Command {
+	var compareFile string
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "diff [snapshotA] [snapshotB]",
+		Short: "🔀 Show what changed between torrent snapshots",
+		Long: `🔀 Show what changed between torrent snapshots
+
+Snapshots are created with 'akira list --snapshot <file>'. This command reports:
+- Torrents that were added or removed
+- Torrents that finished downloading
+- Torrents whose state or progress changed
+
+Compare two snapshot files, or use --compare to compare a snapshot
+against the current state in qBittorrent.
+
+Examples:
+  akira diff before.json after.json       # Compare two snapshots
+  akira diff --compare before.json        # Compare a snapshot with now
+  akira diff before.json after.json --json  # JSON output for scripts`,
+		Args: cobra.MaximumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runListCommand(ctx, torrentService, category, state, seedingOnly, downloadingOnly, jsonOutput)
+			return runDiffCommand(ctx, cmd.OutOrStdout(), torrentService, args, compareFile, jsonOutput)
 		},
 	}
 
-	cmd.Flags().StringVar(&category, "category", "", "filter by category (series, movies, anime)")
-	cmd.Flags().StringVarP(&state, "state", "s", "", "filter by state (downloading, seeding, paused, error)")
-	cmd.Flags().BoolVar(&seedingOnly, "seeding-only", false, "show only seeding torrents")
-	cmd.Flags().BoolVar(&downloadingOnly, "downloading", false, "show only downloading torrents")
+	cmd.Flags().StringVar(&compareFile, "compare", "", "compare a snapshot file against the current state")
 	cmd.Flags().BoolVarP(&jsonOutput, "json", "j", false, "output in JSON format")
 
 	return cmd
 }
 
+// addOptions holds the flags accepted by the add command
+type addOptions struct {
+	category      string   // Category to add the torrent to
+	path          string   // Custom save path
+	skipPathCheck bool     // Skip the local existence check for the custom path
+	paused        bool     // Add the torrent in the paused state
+	top           bool     // Move the torrent to the top of the download queue
+	skipCheck     bool     // Skip hash checking of existing files
+	sequential    bool     // Download pieces in order
+	firstLast     bool     // Download the first and last pieces first
+	upLimit       int64    // Upload speed limit in bytes/s, 0 for unlimited
+	dlLimit       int64    // Download speed limit in bytes/s, 0 for unlimited
+	tags          []string // Tags to apply
+	jsonOutput    bool     // Print a single JSON result instead of progress
+}
+
+// addResult is the result of the add command printed with --json
+type addResult struct {
+	Success         bool            `json:"success"`
+	Magnet          *cli.MagnetInfo `json:"magnet,omitempty"`
+	Name            string          `json:"name,omitempty"`
+	Hash            string          `json:"hash,omitempty"`
+	Category        string          `json:"category,omitempty"`
+	SavePath        string          `json:"save_path,omitempty"`
+	Tags            []string        `json:"tags,omitempty"`
+	Paused          bool            `json:"paused"`
+	SeedingTracking bool            `json:"seeding_tracking"`
+	AlreadyExists   bool            `json:"already_exists,omitempty"`
+	Warnings        []string        `json:"warnings,omitempty"`
+	Error           string          `json:"error,omitempty"`
+}
+
 // NewAddCommand creates the add command
-func NewAddCommand(ctx context.Context, torrentService *core.TorrentService, seedingService *core.SeedingService) *cobra.Command {
-	var category string
-	var path string
+func NewAddCommand(ctx context.Context, cfg *config.Config, torrentService *core.TorrentService, seedingService *core.SeedingService) *cobra.Command {
+	var opts addOptions
+	var upLimit, dlLimit, tags string
 
 	cmd := &cobra.Command{
 		Use:   "add <magnet-uri>",
@@ -84,24 +247,60 @@ func NewAddCommand(ctx context.Context, torrentService *core.TorrentService, see
 
 This command adds a torrent to qBittorrent with validation and feedback:
 - Validates magnet URI format and info hash
-- Validates category selection (series, movies, anime)
+- Validates category selection against the configured CATEGORIES
 - Supports custom save path override
+- Checks that a custom path exists locally (skipped for remote qBittorrent)
+- Optionally adds paused and/or at the top of the download queue
+- Optionally skips hash checking, downloads sequentially or first/last
+  pieces first, limits the torrent's speeds and adds tags
 - Shows detailed torrent information after adding
 - Provides progress tracking guidance
 
 Examples:
   akira add "magnet:?xt=urn:btih:..."                    # Add with default settings
   akira add "magnet:?xt=urn:btih:..." --category movies  # Add to movies category
-  akira add "magnet:?xt=urn:btih:..." --path /custom     # Add with custom path`,
+  akira add "magnet:?xt=urn:btih:..." --path /custom     # Add with custom path
+  akira add "magnet:?xt=urn:btih:..." --path /srv/media --skip-path-check  # Path lives on the qBittorrent host
+  akira add "magnet:?xt=urn:btih:..." --paused --top     # Stage at the front of the queue
+  akira add "magnet:?xt=urn:btih:..." --paused --sequential --first-last  # Inspect a large torrent before downloading
+  akira add "magnet:?xt=urn:btih:..." --dl-limit 5M --up-limit 500K       # Limit this torrent's speeds
+  akira add "magnet:?xt=urn:btih:..." --tags 4k,remux     # Tag the torrent in qBittorrent
+  akira add "magnet:?xt=urn:btih:..." --json             # Print only a JSON result for scripts`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			// Failures are part of the JSON result, Cobra must not add its error and usage text
+			if opts.jsonOutput {
+				cmd.SilenceErrors = true
+				cmd.SilenceUsage = true
+			}
+
+			var err error
+			if opts.upLimit, err = parseLimitFlag("up-limit", upLimit); err != nil {
+				return err
+			}
+			if opts.dlLimit, err = parseLimitFlag("dl-limit", dlLimit); err != nil {
+				return err
+			}
+			opts.tags = qbittorrent.SplitTags(tags)
+
 			magnetURI := args[0]
-			return runAddCommand(ctx, torrentService, seedingService, magnetURI, category, path)
+			return runAddCommand(ctx, cmd.OutOrStdout(), torrentService, seedingService, magnetURI, opts)
 		},
 	}
 
-	cmd.Flags().StringVar(&category, "category", "", "category (series, movies, anime)")
-	cmd.Flags().StringVarP(&path, "path", "p", "", "custom save path")
+	cmd.Flags().StringVar(&opts.category, "category", "", "category ("+categoryList(torrentService)+")")
+	cmd.Flags().StringVarP(&opts.path, "path", "p", "", "custom save path")
+	cmd.Flags().BoolVar(&opts.skipPathCheck, "skip-path-check", cfg.QBittorrent.Remote,
+		"don't check that the custom path exists locally (default true when QBITTORRENT_REMOTE is set)")
+	cmd.Flags().BoolVar(&opts.paused, "paused", false, "add the torrent in the paused state")
+	cmd.Flags().BoolVar(&opts.top, "top", false, "move the torrent to the top of the download queue")
+	cmd.Flags().BoolVar(&opts.skipCheck, "skip-check", false, "skip hash checking of files already on disk")
+	cmd.Flags().BoolVar(&opts.sequential, "sequential", false, "download pieces in order")
+	cmd.Flags().BoolVar(&opts.firstLast, "first-last", false, "download the first and last pieces first")
+	cmd.Flags().StringVar(&upLimit, "up-limit", "", "upload limit, e.g. 500K or 1M (0 for unlimited)")
+	cmd.Flags().StringVar(&dlLimit, "dl-limit", "", "download limit, e.g. 5M or 1G (0 for unlimited)")
+	cmd.Flags().StringVar(&tags, "tags", "", "comma-separated tags, e.g. foo,bar")
+	cmd.Flags().BoolVarP(&opts.jsonOutput, "json", "j", false, "print only a JSON result instead of progress")
 
 	return cmd
 }
@@ -133,13 +332,13 @@ Examples:
   akira delete --hash abc123... --delete-files    # Delete torrent and its files
   akira delete --name "Ubuntu" --force            # Skip confirmation prompt`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runDeleteCommand(ctx, torrentService, seedingService, hash, namePattern, category, deleteFiles, force)
+			return runDeleteCommand(ctx, cmd.OutOrStdout(), torrentService, seedingService, hash, namePattern, category, deleteFiles, force)
 		},
 	}
 
 	cmd.Flags().StringVar(&hash, "hash", "", "specific torrent hash to delete")
 	cmd.Flags().StringVar(&namePattern, "name", "", "delete torrents matching name pattern")
-	cmd.Flags().StringVar(&category, "category", "", "delete all torrents in category")
+	cmd.Flags().StringVar(&category, "category", "", "delete all torrents in category ("+categoryList(torrentService)+")")
 	cmd.Flags().BoolVar(&deleteFiles, "delete-files", false, "also delete downloaded files")
 	cmd.Flags().BoolVar(&force, "force", false, "skip confirmation prompt")
 
@@ -149,6 +348,8 @@ Examples:
 // NewDiskCommand creates the disk space command
 func NewDiskCommand(ctx context.Context, diskService *core.DiskService) *cobra.Command {
 	var path string
+	var summary bool
+	var output string
 	var jsonOutput bool
 
 	cmd := &cobra.Command{
@@ -161,19 +362,33 @@ This command displays disk usage information with beautiful progress bars:
 - Color-coded health indicators (healthy, warning, critical)
 - Human-readable sizes (GB, TB) with precise percentages
 - Summary statistics for multiple paths
-- JSON output for scripting and automation
+- Aggregate totals and the paths needing attention only, with --summary
+- JSON or CSV output for scripting and automation
 
 Examples:
   akira disk                    # Show all configured paths
   akira disk --path /custom     # Check specific path
-  akira disk --json            # JSON output for scripts`,
+  akira disk --summary          # Totals and warning/critical paths only
+  akira disk --output json      # JSON output for scripts
+  akira disk --output csv       # CSV output for spreadsheets`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runDiskCommand(ctx, diskService, path, jsonOutput)
+			format, err := resolveOutputFormat(cmd, output, jsonOutput)
+			if err != nil {
+				return err
+			}
+			if summary {
+				if path != "" {
+					return validationError(fmt.Errorf("cannot use --summary with --path"))
+				}
+				return runDiskSummaryCommand(ctx, cmd.OutOrStdout(), diskService, format)
+			}
+			return runDiskCommand(ctx, cmd.OutOrStdout(), diskService, path, format)
 		},
 	}
 
 	cmd.Flags().StringVarP(&path, "path", "p", "", "specific path to check")
-	cmd.Flags().BoolVarP(&jsonOutput, "json", "j", false, "output in JSON format")
+	cmd.Flags().BoolVar(&summary, "summary", false, "show only the totals and the paths needing attention")
+	addOutputFlags(cmd, &output, &jsonOutput)
 
 	return cmd
 }
@@ -184,30 +399,84 @@ func NewLogsCommand(ctx context.Context, cfg *config.Config) *cobra.Command {
 	var follow bool
 	var level string
 	var component string
+	var since time.Duration
 
 	cmd := &cobra.Command{
 		Use:   "logs",
 		Short: "📜 View logs",
-		Long:  "View application logs with filtering options",
+		Long: `📜 View application logs with filtering options
+
+Reads the log file configured with LOG_FILE. Both JSON and text log lines are
+understood; lines that cannot be parsed are shown as-is.
+
+With --follow the command keeps running and prints new matching entries as they
+are written, waiting for the file if it doesn't exist yet and picking up the new
+file after log rotation. Press Ctrl+C to stop.
+
+Examples:
+  akira logs                           # Show the last 50 entries
+  akira logs -n 200 --level error      # Show the last 200 errors
+  akira logs --component qbittorrent   # Show qBittorrent client entries
+  akira logs --since 1h                # Show entries from the last hour
+  akira logs -f --level warn           # Follow new warnings`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// TODO: Implement logs command
-			fmt.Println("📜 Logs command - Coming soon!")
-			fmt.Printf("Tail: %d, Follow: %v, Level: %s, Component: %s\n",
-				tail, follow, level, component)
-			return nil
+			opts := logging.ReadOptions{
+				Level:     level,
+				Component: component,
+				Tail:      tail,
+			}
+			if since > 0 {
+				opts.Since = time.Now().Add(-since)
+			}
+			return runLogsCommand(ctx, cmd.OutOrStdout(), cfg.Logging.File, opts, follow)
 		},
 	}
 
+	// -c and -l are taken by the global --config and --log-level flags
 	cmd.Flags().IntVarP(&tail, "tail", "n", 50, "number of recent entries to show")
-	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "follow log output")
-	cmd.Flags().StringVarP(&level, "level", "l", "", "filter by log level")
-	cmd.Flags().StringVarP(&component, "component", "c", "", "filter by component")
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "keep printing new entries as they are written")
+	cmd.Flags().StringVar(&level, "level", "", "filter by log level (debug, info, warn, error)")
+	cmd.Flags().StringVar(&component, "component", "", "filter by component (e.g. qbittorrent, seeding_manager, discord_bot)")
+	cmd.Flags().DurationVar(&since, "since", 0, "only show entries newer than this duration (e.g. 30m, 2h)")
 
 	return cmd
 }
 
+// runLogsCommand implements the logs command functionality
+func runLogsCommand(ctx context.Context, out io.Writer, logFile string, opts logging.ReadOptions, follow bool) error {
+	if opts.Tail < 0 {
+		return validationError(fmt.Errorf("--tail must not be negative"))
+	}
+
+	if follow {
+		if _, err := os.Stat(logFile); os.IsNotExist(err) {
+			fmt.Fprintf(out, "⏳ Waiting for %s to be created...\n", logFile)
+		}
+		return logging.Follow(ctx, logFile, opts, logging.DefaultFollowInterval, func(entry logging.LogEntry) {
+			fmt.Fprintln(out, cli.FormatLogEntry(entry))
+		})
+	}
+
+	entries, err := logging.ReadEntries(logFile, opts)
+	if errors.Is(err, os.ErrNotExist) {
+		fmt.Fprintf(out, "📭 Log file %s does not exist yet\n", logFile)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 {
+		fmt.Fprintf(out, "📭 No log entries found in %s\n", logFile)
+		return nil
+	}
+
+	cli.PrintLogEntries(out, entries)
+	return nil
+}
+
 // NewSeedingCommand creates the seeding command
-func NewSeedingCommand(ctx context.Context, seedingService *core.SeedingService) *cobra.Command {
+func NewSeedingCommand(ctx context.Context, cfg *config.Config, seedingService *core.SeedingService) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "seeding",
 		Short: "🌱 Seeding management",
@@ -233,22 +502,110 @@ Examples:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			jsonOutput, _ := cmd.Flags().GetBool("json")
 			detailed, _ := cmd.Flags().GetBool("detailed")
-			return runSeedingStatusCommand(ctx, seedingService, jsonOutput, detailed)
+			return runSeedingStatusCommand(ctx, cmd.OutOrStdout(), cfg.UI, seedingService, jsonOutput, detailed)
 		},
 	}
 	statusCmd.Flags().BoolP("json", "j", false, "output in JSON format")
 	statusCmd.Flags().BoolP("detailed", "d", false, "show detailed torrent information")
 
+	verifyCmd := &cobra.Command{
+		Use:   "verify",
+		Short: "🩺 Verify seeding tracking data",
+		Long: `🩺 Verify seeding tracking data against qBittorrent
+
+This command cross-references the seeding tracking data with the live
+torrent list and reports:
+- Records for torrents that no longer exist in qBittorrent
+- Torrents paused in qBittorrent but not marked as stopped
+- Completed torrents that are missing a completion time
+
+Use --repair to remove stale records and reconcile timestamps.
+
+Examples:
+  akira seeding verify                   # Report problems only
+  akira seeding verify --repair          # Fix the problems found
+  akira seeding verify --json            # Export as JSON`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			jsonOutput, _ := cmd.Flags().GetBool("json")
+			repair, _ := cmd.Flags().GetBool("repair")
+			return runSeedingVerifyCommand(ctx, cmd.OutOrStdout(), seedingService, repair, jsonOutput)
+		},
+	}
+	verifyCmd.Flags().BoolP("json", "j", false, "output in JSON format")
+	verifyCmd.Flags().Bool("repair", false, "fix the problems found")
+
+	pauseCmd := &cobra.Command{
+		Use:   "pause",
+		Short: "⏸️  Pause automatic seeding stops",
+		Long: `⏸️  Pause automatic seeding stops
+
+While paused, torrents keep seeding past their time limit. Tracking data is
+still updated, so limits are enforced again as soon as the service is resumed.
+The pause applies to every Akira process, including a running daemon.
+
+Examples:
+  akira seeding pause                     # Keep everything seeding (e.g. during a freeleech event)
+  akira seeding resume                    # Enforce seeding limits again`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSeedingPauseCommand(cmd.OutOrStdout(), cfg.UI, seedingService, true)
+		},
+	}
+
+	resumeCmd := &cobra.Command{
+		Use:   "resume",
+		Short: "▶️  Resume automatic seeding stops",
+		Long: `▶️  Resume automatic seeding stops
+
+Re-enables enforcement of seeding time limits after 'akira seeding pause'.
+Torrents that went over their limit while paused are stopped on the next check.
+
+Examples:
+  akira seeding resume`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSeedingPauseCommand(cmd.OutOrStdout(), cfg.UI, seedingService, false)
+		},
+	}
+
+	var topBy string
+	var topLimit int
+	var topJSON bool
+	topCmd := &cobra.Command{
+		Use:   "top",
+		Short: "🏆 Show the seeding leaderboard",
+		Long: `🏆 Show the seeding leaderboard
+
+Ranks tracked torrents by share ratio or by total uploaded data, using live
+values from qBittorrent. Torrents at the bottom are candidates to stop.
+
+Examples:
+  akira seeding top                       # Top 10 by ratio
+  akira seeding top --by uploaded         # Top 10 by uploaded data
+  akira seeding top --limit 0             # Rank every tracked torrent
+  akira seeding top --json                # Export as JSON`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSeedingTopCommand(ctx, cmd.OutOrStdout(), seedingService, topBy, topLimit, topJSON)
+		},
+	}
+	topCmd.Flags().StringVar(&topBy, "by", string(core.LeaderboardByRatio), "rank by ratio or uploaded")
+	topCmd.Flags().IntVar(&topLimit, "limit", 10, "number of torrents to show (0 for all)")
+	topCmd.Flags().BoolVarP(&topJSON, "json", "j", false, "output in JSON format")
+
 	// Add subcommands
 	cmd.AddCommand(
 		statusCmd,
+		verifyCmd,
+		pauseCmd,
+		resumeCmd,
+		topCmd,
 		&cobra.Command{
 			Use:   "stop-all",
 			Short: "⏹️  Stop all seeding",
+			Long: `⏹️  Stop all seeding
+
+Pauses every tracked torrent that is still seeding and marks it as stopped by
+hand, so it isn't resumed automatically.`,
 			RunE: func(cmd *cobra.Command, args []string) error {
-				// TODO: Implement stop-all
-				fmt.Println("⏹️ Stop all seeding - Coming soon!")
-				return nil
+				return runSeedingStopAllCommand(ctx, cmd.OutOrStdout(), seedingService)
 			},
 		},
 		&cobra.Command{
@@ -266,53 +623,207 @@ Examples:
 }
 
 // NewVersionCommand creates the version command
-func NewVersionCommand(version, buildTime, gitCommit string) *cobra.Command {
+func NewVersionCommand(ctx context.Context, version, buildTime, gitCommit string, qbClient *qbittorrent.Client) *cobra.Command {
 	return &cobra.Command{
 		Use:   "version",
 		Short: "📋 Show version information",
-		Long:  "Display version, build time, and git commit information",
+		Long:  "Display version, build time, and git commit information, and the version of the connected qBittorrent",
 		Run: func(cmd *cobra.Command, args []string) {
-			fmt.Printf("🌟 Akira Torrent Manager\n")
-			fmt.Printf("Version: %s\n", version)
-			fmt.Printf("Built: %s\n", buildTime)
-			fmt.Printf("Commit: %s\n", gitCommit)
+			runVersionCommand(ctx, cmd.OutOrStdout(), version, buildTime, gitCommit, qbClient)
 		},
 	}
 }
 
+// runVersionCommand implements the version command functionality
+func runVersionCommand(ctx context.Context, out io.Writer, version, buildTime, gitCommit string, qbClient *qbittorrent.Client) {
+	fmt.Fprintf(out, "🌟 Akira Torrent Manager\n")
+	fmt.Fprintf(out, "Version: %s\n", version)
+	fmt.Fprintf(out, "Built: %s\n", buildTime)
+	fmt.Fprintf(out, "Commit: %s\n", gitCommit)
+
+	appVersion, err := qbClient.GetAppVersion(ctx)
+	if err != nil {
+		fmt.Fprintf(out, "qBittorrent: %s\n", cli.ColorPaused.Sprint("unavailable"))
+		return
+	}
+	apiVersion, err := qbClient.GetAPIVersion(ctx)
+	if err != nil {
+		apiVersion = "unknown"
+	}
+	fmt.Fprintf(out, "qBittorrent: %s (Web API %s)\n", appVersion, apiVersion)
+}
+
+// addOutputFlags adds --output and the deprecated --json alias to cmd
+func addOutputFlags(cmd *cobra.Command, output *string, jsonOutput *bool) {
+	cmd.Flags().StringVarP(output, "output", "o", string(cli.OutputTable), "output format ("+strings.Join(cli.OutputFormatNames, ", ")+")")
+	cmd.Flags().BoolVarP(jsonOutput, "json", "j", false, "output in JSON format")
+	cmd.Flags().MarkDeprecated("json", "use --output json instead")
+}
+
+// resolveOutputFormat parses --output, treating the deprecated --json as --output json
+func resolveOutputFormat(cmd *cobra.Command, output string, jsonOutput bool) (cli.OutputFormat, error) {
+	format, err := cli.ParseOutputFormat(output)
+	if err != nil {
+		return "", validationError(err)
+	}
+	if jsonOutput {
+		if cmd.Flags().Changed("output") && format != cli.OutputJSON {
+			return "", validationError(fmt.Errorf("--json cannot be combined with --output %s", format))
+		}
+		return cli.OutputJSON, nil
+	}
+	return format, nil
+}
+
+// listOptions holds the flags accepted by the list command
+type listOptions struct {
+	category        string           // Category filter
+	tag             string           // Tag filter
+	search          string           // Case-insensitive regex the name must match
+	state           string           // State filter
+	seedingOnly     bool             // Show only seeding torrents
+	downloadingOnly bool             // Show only downloading torrents
+	minSize         string           // Minimum size, e.g. "1G" (see qbittorrent.ParseBytes)
+	maxSize         string           // Maximum size, e.g. "4.5G"
+	minRatio        float64          // Minimum share ratio (0 = no limit)
+	maxRatio        float64          // Maximum share ratio (0 = no limit)
+	addedBefore     string           // Added before this age or date, see cli.ParseTimeOrAge
+	addedAfter      string           // Added after this age or date
+	completedBefore string           // Completed before this age or date
+	completedAfter  string           // Completed after this age or date
+	output          cli.OutputFormat // Table, JSON or CSV output
+	reverse         bool             // Reverse the final order
+	sortBy          string           // Field to sort by, see core.TorrentSortFields
+	sortDesc        bool             // Sort in descending order
+	limit           int              // Show at most this many torrents (0 = all)
+	columns         string           // Comma-separated table columns, see cli.TorrentColumnNames
+	noSummary       bool             // Leave out the summary after the table
+	snapshotFile    string           // Save the listed torrents to this snapshot file
+	watch           bool             // Redraw the list on an interval until interrupted
+	interval        time.Duration    // How often --watch refreshes the list
+}
+
+// filtered returns true if any filter that narrows down the torrents is set
+func (o listOptions) filtered() bool {
+	return o.category != "" || o.tag != "" || o.search != "" || o.state != "" || o.seedingOnly || o.downloadingOnly ||
+		o.minSize != "" || o.maxSize != "" || o.minRatio > 0 || o.maxRatio > 0 ||
+		o.addedBefore != "" || o.addedAfter != "" || o.completedBefore != "" || o.completedAfter != ""
+}
+
+// parseSizeFlag parses a human-readable size flag such as "1G"; an empty value means no limit
+func parseSizeFlag(flag, value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	size, err := qbittorrent.ParseBytes(value)
+	if err != nil {
+		return 0, validationError(fmt.Errorf("invalid %s: %w", flag, err))
+	}
+	return size, nil
+}
+
+// sortFieldList returns the supported sort field names for flag help text
+func sortFieldList() string {
+	names := make([]string, len(core.TorrentSortFields))
+	for i, field := range core.TorrentSortFields {
+		names[i] = string(field)
+	}
+	return strings.Join(names, ", ")
+}
+
+// categoryList returns the selectable category names for flag help text
+func categoryList(torrentService *core.TorrentService) string {
+	return strings.Join(config.CategoryNames(torrentService.Categories()), ", ")
+}
+
 // runListCommand implements the list command functionality
-func runListCommand(ctx context.Context, torrentService *core.TorrentService,
-	category, state string, seedingOnly, downloadingOnly, jsonOutput bool) error {
+func runListCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService, opts listOptions) error {
 
 	// Validate conflicting flags
-	if seedingOnly && downloadingOnly {
-		return fmt.Errorf("cannot use both --seeding-only and --downloading flags together")
+	if opts.seedingOnly && opts.downloadingOnly {
+		return validationError(fmt.Errorf("cannot use both --seeding-only and --downloading flags together"))
+	}
+	if opts.limit < 0 {
+		return validationError(fmt.Errorf("--limit cannot be negative"))
+	}
+	columns, err := cli.ParseTorrentColumns(opts.columns)
+	if err != nil {
+		return validationError(err)
 	}
 
 	// Create filter options
-	filter := &core.TorrentFilter{}
+	filter := &core.TorrentFilter{
+		Reverse:  opts.reverse,
+		SortDesc: opts.sortDesc,
+		Limit:    opts.limit,
+		MinRatio: opts.minRatio,
+		MaxRatio: opts.maxRatio,
+	}
+
+	// Apply size and ratio filters
+	if filter.MinSize, err = parseSizeFlag("--min-size", opts.minSize); err != nil {
+		return err
+	}
+	if filter.MaxSize, err = parseSizeFlag("--max-size", opts.maxSize); err != nil {
+		return err
+	}
+	if filter.MinSize > 0 && filter.MaxSize > 0 && filter.MinSize > filter.MaxSize {
+		return validationError(fmt.Errorf("--min-size cannot be greater than --max-size"))
+	}
+	if opts.minRatio < 0 || opts.maxRatio < 0 {
+		return validationError(fmt.Errorf("--min-ratio and --max-ratio cannot be negative"))
+	}
+	if opts.minRatio > 0 && opts.maxRatio > 0 && opts.minRatio > opts.maxRatio {
+		return validationError(fmt.Errorf("--min-ratio cannot be greater than --max-ratio"))
+	}
+
+	// Apply age filters
+	now := time.Now()
+	for _, bound := range []struct {
+		flag  string
+		value string
+		time  *time.Time
+	}{
+		{"--added-before", opts.addedBefore, &filter.AddedBefore},
+		{"--added-after", opts.addedAfter, &filter.AddedAfter},
+		{"--completed-before", opts.completedBefore, &filter.CompletedBefore},
+		{"--completed-after", opts.completedAfter, &filter.CompletedAfter},
+	} {
+		if bound.value == "" {
+			continue
+		}
+		if *bound.time, err = cli.ParseTimeOrAge(bound.value, now); err != nil {
+			return validationError(fmt.Errorf("invalid %s: %w", bound.flag, err))
+		}
+	}
+
+	// Apply sorting
+	if opts.sortBy != "" {
+		sortBy, err := core.ParseTorrentSortField(opts.sortBy)
+		if err != nil {
+			return validationError(err)
+		}
+		filter.SortBy = sortBy
+	}
 
 	// Apply category filter
-	if category != "" {
+	if opts.category != "" {
 		// Validate category
-		validCategories := []string{"series", "movies", "anime"}
-		categoryLower := strings.ToLower(category)
-		isValid := false
-		for _, valid := range validCategories {
-			if categoryLower == valid {
-				isValid = true
-				break
-			}
-		}
-		if !isValid {
-			return fmt.Errorf("invalid category '%s'. Valid categories: %v", category, validCategories)
+		if err := cli.ValidateCategory(opts.category, torrentService.Categories()); err != nil {
+			return err
 		}
-		filter.Category = categoryLower
+		filter.Category = strings.ToLower(opts.category)
 	}
 
+	// Apply tag filter
+	filter.Tag = strings.TrimSpace(opts.tag)
+
+	// Apply name filter (the service reports an invalid regex)
+	filter.NamePattern = opts.search
+
 	// Apply state filter
-	if state != "" {
-		stateLower := strings.ToLower(state)
+	if opts.state != "" {
+		stateLower := strings.ToLower(opts.state)
 		// Map user-friendly state names to qBittorrent states
 		switch stateLower {
 		case "downloading":
@@ -320,22 +831,28 @@ func runListCommand(ctx context.Context, torrentService *core.TorrentService,
 		case "seeding":
 			filter.State = qbittorrent.StateUploading
 		case "paused":
-			filter.State = qbittorrent.StatePausedDL // Will also match paused upload
+			// qBittorrent has separate paused (and, since 5.0, stopped) states for downloads and uploads
+			filter.States = []qbittorrent.TorrentState{
+				qbittorrent.StatePausedDL,
+				qbittorrent.StatePausedUP,
+				qbittorrent.StateStoppedDL,
+				qbittorrent.StateStoppedUP,
+			}
 		case "error":
 			filter.State = qbittorrent.StateError
 		default:
 			// Try to use the state directly as TorrentState
-			filter.State = qbittorrent.TorrentState(state)
+			filter.State = qbittorrent.TorrentState(opts.state)
 		}
 	}
 
 	// Apply seeding-only filter
-	if seedingOnly {
+	if opts.seedingOnly {
 		filter.State = qbittorrent.StateUploading
 	}
 
 	// Apply downloading-only filter
-	if downloadingOnly {
+	if opts.downloadingOnly {
 		// Set filter to show downloading states
 		filter.States = []qbittorrent.TorrentState{
 			qbittorrent.StateDownloading,
@@ -354,15 +871,11 @@ func runListCommand(ctx context.Context, torrentService *core.TorrentService,
 		return fmt.Errorf("failed to get torrents: %w", err)
 	}
 
-	// Additional filtering for paused state (since qBittorrent has multiple paused states)
-	if state != "" && strings.ToLower(state) == "paused" {
-		var filteredTorrents []qbittorrent.Torrent
-		for _, torrent := range torrents {
-			if strings.Contains(strings.ToLower(string(torrent.State)), "paused") {
-				filteredTorrents = append(filteredTorrents, torrent)
-			}
+	// Save a snapshot of the listed torrents if requested
+	if opts.snapshotFile != "" {
+		if err := cli.SaveSnapshot(opts.snapshotFile, torrents); err != nil {
+			return err
 		}
-		torrents = filteredTorrents
 	}
 
 	// Convert to pointer slice for the table formatter
@@ -372,7 +885,227 @@ func runListCommand(ctx context.Context, torrentService *core.TorrentService,
 	}
 
 	// Print results
-	return cli.PrintTorrentTable(torrentPtrs, jsonOutput)
+	return cli.PrintTorrentTable(out, torrentPtrs, opts.output, cli.TorrentTableOptions{
+		Columns:   columns,
+		NoSummary: opts.noSummary,
+	})
+}
+
+// runListWatchCommand redraws the torrent list every opts.interval, like the
+// watch utility, until the context is cancelled. Errors after the first
+// successful refresh are shown in place of the list instead of stopping.
+func runListWatchCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService, opts listOptions) error {
+	if opts.interval <= 0 {
+		return validationError(fmt.Errorf("--interval must be greater than 0"))
+	}
+
+	// Hide the cursor while redrawing and restore it however the loop ends
+	fmt.Fprint(out, "\033[?25l")
+	defer fmt.Fprint(out, "\033[?25h")
+
+	ticker := time.NewTicker(opts.interval)
+	defer ticker.Stop()
+
+	for refreshed := false; ; refreshed = true {
+		var frame bytes.Buffer
+		fmt.Fprintf(&frame, "📋 %s   %s\n\n",
+			cli.ColorHeader.Sprintf("Every %s: akira list", opts.interval), time.Now().Format("15:04:05"))
+
+		if err := runListCommand(ctx, &frame, torrentService, opts); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			if !refreshed {
+				return err
+			}
+			fmt.Fprintf(&frame, "❌ %v\n", err)
+		}
+
+		// Clear the screen and draw the new frame in one write to avoid flicker
+		fmt.Fprint(out, "\033[H\033[2J"+frame.String())
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// runListStatsCommand prints aggregate statistics for all torrents
+func runListStatsCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService, jsonOutput bool) error {
+	stats, err := torrentService.GetTorrentStats(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get torrent statistics: %w", err)
+	}
+
+	// JSON output
+	if jsonOutput {
+		jsonData, err := json.MarshalIndent(stats, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Fprintln(out, string(jsonData))
+		return nil
+	}
+
+	fmt.Fprintf(out, "📊 %s\n\n", cli.ColorHeader.Sprint("Torrent Statistics"))
+
+	fmt.Fprintf(out, "📋 %s: %d\n", cli.ColorHeader.Sprint("Total"), stats.Total)
+	fmt.Fprintf(out, "📥 %s: %d\n", cli.ColorDownloading.Sprint("Downloading"), stats.Downloading)
+	fmt.Fprintf(out, "🌱 %s: %d\n", cli.ColorSeeding.Sprint("Seeding"), stats.Seeding)
+	fmt.Fprintf(out, "✅ %s: %d\n", cli.ColorCompleted.Sprint("Completed"), stats.Completed)
+	fmt.Fprintf(out, "⏸️  %s: %d\n", cli.ColorPaused.Sprint("Paused"), stats.Paused)
+	fmt.Fprintf(out, "❌ %s: %d\n\n", cli.ColorError.Sprint("Errored"), stats.Error)
+
+	fmt.Fprintf(out, "💾 Total Size: %s\n", cli.FormatBytes(stats.TotalSize))
+	fmt.Fprintf(out, "⬇️  Downloaded: %s\n", cli.FormatBytes(stats.Downloaded))
+	fmt.Fprintf(out, "⬆️  Uploaded: %s\n", cli.FormatBytes(stats.Uploaded))
+	fmt.Fprintf(out, "🚀 Speed: ↓ %s  ↑ %s\n", cli.FormatSpeed(stats.DownloadSpeed), cli.FormatSpeed(stats.UploadSpeed))
+
+	return nil
+}
+
+// runListStalledCommand lists downloads that have been stalled for at least threshold
+func runListStalledCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService,
+	threshold time.Duration, jsonOutput bool) error {
+
+	if threshold <= 0 {
+		return validationError(fmt.Errorf("--stalled-threshold must be greater than 0"))
+	}
+
+	torrents, err := torrentService.GetStalledTorrents(ctx, threshold)
+	if err != nil {
+		return fmt.Errorf("failed to get stalled torrents: %w", err)
+	}
+
+	now := time.Now()
+
+	// JSON output
+	if jsonOutput {
+		type stalledTorrent struct {
+			*cli.TorrentTableRow
+			StalledSeconds int64 `json:"stalled_seconds"`
+		}
+		rows := make([]stalledTorrent, len(torrents))
+		for i := range torrents {
+			rows[i] = stalledTorrent{
+				TorrentTableRow: cli.ConvertTorrentToTableRow(&torrents[i]),
+				StalledSeconds:  int64(torrents[i].StalledDuration(now).Seconds()),
+			}
+		}
+		jsonData, err := json.MarshalIndent(rows, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Fprintln(out, string(jsonData))
+		return nil
+	}
+
+	fmt.Fprintf(out, "⏳ %s\n\n", cli.ColorHeader.Sprintf("Stalled Downloads (inactive for %s or more)", threshold))
+
+	if len(torrents) == 0 {
+		fmt.Fprintln(out, "✨ No stalled downloads")
+		return nil
+	}
+
+	for _, torrent := range torrents {
+		fmt.Fprintf(out, "📥 %s\n", torrent.Name)
+		fmt.Fprintf(out, "   Stalled For: %s\n", cli.ColorPaused.Sprint(cli.FormatDuration(int64(torrent.StalledDuration(now).Seconds()))))
+		fmt.Fprintf(out, "   Progress: %.1f%% • Seeds: %d • Peers: %d\n", torrent.GetProgressPercentage(), torrent.NumSeeds, torrent.NumLeechs)
+		fmt.Fprintf(out, "   Hash: %s\n\n", torrent.Hash)
+	}
+
+	fmt.Fprintf(out, "📊 %d stalled download(s)\n", len(torrents))
+	return nil
+}
+
+// isTerminal reports whether r is an interactive terminal
+func isTerminal(r io.Reader) bool {
+	file, ok := r.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// runListErrorsCommand lists errored torrents and, when interactive, offers quick fixes for each
+func runListErrorsCommand(ctx context.Context, out io.Writer, in io.Reader, torrentService *core.TorrentService,
+	seedingService *core.SeedingService, jsonOutput, interactive bool) error {
+
+	torrents, err := torrentService.GetErroredTorrents(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get errored torrents: %w", err)
+	}
+
+	torrentPtrs := make([]*qbittorrent.Torrent, len(torrents))
+	for i := range torrents {
+		torrentPtrs[i] = &torrents[i]
+	}
+
+	if err := cli.PrintTorrentTable(out, torrentPtrs, cli.JSONOrTable(jsonOutput), cli.TorrentTableOptions{}); err != nil {
+		return err
+	}
+
+	if !interactive || len(torrents) == 0 {
+		return nil
+	}
+
+	// Offer quick fixes one torrent at a time
+	fmt.Fprintf(out, "\n🩺 %s\n", cli.ColorHeader.Sprint("Quick Fix"))
+
+	reader := bufio.NewReader(in)
+	fixed, failed := 0, 0
+
+	for _, torrent := range torrents {
+		fmt.Fprintf(out, "\n%s %s\n", cli.GetStateIcon(string(torrent.State)), torrent.Name)
+		fmt.Fprintf(out, "   State: %s\n", torrent.GetStateDisplayName())
+		fmt.Fprintf(out, "   Save Path: %s\n", torrent.SavePath)
+		fmt.Fprintf(out, "❓ [r]echeck, re[a]nnounce, [d]elete (keep files), [s]kip, [q]uit (s): ")
+
+		response, _ := reader.ReadString('\n')
+		response = strings.ToLower(strings.TrimSpace(response))
+
+		var actionErr error
+		switch response {
+		case "r", "recheck":
+			actionErr = torrentService.RecheckTorrents(ctx, []string{torrent.Hash})
+		case "a", "reannounce":
+			actionErr = torrentService.ReannounceTorrents(ctx, []string{torrent.Hash})
+		case "d", "delete":
+			actionErr = torrentService.DeleteTorrents(ctx, []string{torrent.Hash}, false)
+			if actionErr == nil {
+				// Don't fail the quick fix, the torrent was deleted
+				if err := seedingService.StopTracking(torrent.Hash); err != nil {
+					fmt.Fprintf(out, "   ⚠️  Warning: Failed to stop seeding tracking: %v\n", err)
+				}
+			}
+		case "q", "quit":
+			fmt.Fprintf(out, "\n📊 %d fixed • %d failed\n", fixed, failed)
+			return nil
+		default:
+			fmt.Fprintf(out, "   ⏭️  Skipped\n")
+			continue
+		}
+
+		if actionErr != nil {
+			fmt.Fprintf(out, "   ❌ %v\n", actionErr)
+			failed++
+		} else {
+			fmt.Fprintf(out, "   ✅ Done\n")
+			fixed++
+		}
+	}
+
+	fmt.Fprintf(out, "\n📊 %d fixed • %d failed\n", fixed, failed)
+	if failed > 0 {
+		return NewExitError(ExitPartialFailure, fmt.Errorf("%d quick fix(es) failed", failed))
+	}
+	return nil
 }
 
 // NewDownloadingCommand creates a dedicated downloading torrents command
@@ -395,7 +1128,7 @@ Examples:
   akira downloading --json         # JSON output for scripts`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Call runListCommand with downloading filter enabled
-			return runListCommand(ctx, torrentService, "", "", false, true, jsonOutput)
+			return runListCommand(ctx, cmd.OutOrStdout(), torrentService, listOptions{downloadingOnly: true, output: cli.JSONOrTable(jsonOutput)})
 		},
 	}
 
@@ -404,9 +1137,51 @@ Examples:
 	return cmd
 }
 
+// runDiffCommand implements the diff command functionality
+func runDiffCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService,
+	args []string, compareFile string, jsonOutput bool) error {
+
+	var oldTorrents, newTorrents []qbittorrent.Torrent
+	var err error
+
+	switch {
+	case compareFile != "":
+		if len(args) > 0 {
+			return validationError(fmt.Errorf("cannot combine --compare with snapshot arguments"))
+		}
+
+		oldTorrents, err = cli.LoadSnapshot(compareFile)
+		if err != nil {
+			return err
+		}
+
+		newTorrents, err = torrentService.GetTorrents(ctx, &core.TorrentFilter{})
+		if err != nil {
+			return fmt.Errorf("failed to get torrents: %w", err)
+		}
+
+	case len(args) == 2:
+		oldTorrents, err = cli.LoadSnapshot(args[0])
+		if err != nil {
+			return err
+		}
+
+		newTorrents, err = cli.LoadSnapshot(args[1])
+		if err != nil {
+			return err
+		}
+
+	default:
+		return validationError(fmt.Errorf("must specify two snapshot files or --compare <file>"))
+	}
+
+	diff := cli.DiffSnapshots(oldTorrents, newTorrents)
+	return cli.PrintSnapshotDiff(out, diff, jsonOutput)
+}
+
 // runDiskCommand implements the disk space command functionality
-func runDiskCommand(ctx context.Context, diskService *core.DiskService,
-	customPath string, jsonOutput bool) error {
+func runDiskCommand(ctx context.Context, out io.Writer, diskService *core.DiskService,
+	customPath string, format cli.OutputFormat) error {
 
 	var diskInfos []*cli.DiskSpaceInfo
 
@@ -417,158 +1192,288 @@ func runDiskCommand(ctx context.Context, diskService *core.DiskService,
 			return fmt.Errorf("failed to get disk space for path '%s': %w", customPath, err)
 		}
 
-		info := cli.ConvertDiskSpaceInfo(customPath, diskSpace.Used, diskSpace.Free, diskSpace.Total)
+		info := cli.ConvertDiskSpaceInfo(customPath, diskSpace)
 		diskInfos = append(diskInfos, info)
 
 	} else {
-		// Get all configured qBittorrent paths from environment
-		// We'll use a simple approach and check common paths for now
-		paths := []string{
-			os.Getenv("QBITTORRENT_DEFAULT_SAVE_PATH"),
-			os.Getenv("QBITTORRENT_SERIES_SAVE_PATH"),
-			os.Getenv("QBITTORRENT_MOVIES_SAVE_PATH"),
-			os.Getenv("QBITTORRENT_ANIME_SAVE_PATH"),
-		}
-
-		// Remove duplicates and empty paths
-		uniquePaths := make(map[string]bool)
-		var validPaths []string
-
-		for _, path := range paths {
-			if path != "" && !uniquePaths[path] {
-				uniquePaths[path] = true
-				validPaths = append(validPaths, path)
-			}
+		// Resolve all configured paths to their physical disks so that
+		// categories sharing a filesystem are only shown once
+		disks, err := diskService.GetPhysicalDisks(ctx)
+		if err != nil {
+			return fmt.Errorf("no valid paths found to check disk space: %w", err)
 		}
 
-		// Get disk space for each unique path
-		for _, path := range validPaths {
-			diskSpace, err := diskService.GetDiskSpace(ctx, path)
-			if err != nil {
-				// Log error but continue with other paths
-				fmt.Fprintf(os.Stderr, "⚠️  Warning: Failed to get disk space for '%s': %v\n", path, err)
-				continue
-			}
-
-			info := cli.ConvertDiskSpaceInfo(path, diskSpace.Used, diskSpace.Free, diskSpace.Total)
+		for _, disk := range disks {
+			info := cli.ConvertDiskSpaceInfo(disk.MountPoint, disk.Info)
+			info.Paths = disk.Paths
+			info.Categories = disk.Categories
 			diskInfos = append(diskInfos, info)
 		}
+	}
 
-		if len(diskInfos) == 0 {
-			return fmt.Errorf("no valid paths found to check disk space")
+	// Print results
+	if err := cli.PrintDiskSpaceInfo(out, diskInfos, format); err != nil {
+		return err
+	}
+
+	// Exit non-zero when any path is in danger of running out of space
+	var criticalPaths []string
+	for _, info := range diskInfos {
+		if info.Health == core.DiskHealthDanger {
+			criticalPaths = append(criticalPaths, info.Path)
 		}
 	}
+	if len(criticalPaths) > 0 {
+		return NewExitError(ExitDiskCritical,
+			fmt.Errorf("disk space critical for: %s", strings.Join(criticalPaths, ", ")))
+	}
 
-	// Print results
-	return cli.PrintDiskSpaceInfo(diskInfos, jsonOutput)
+	return nil
+}
+
+// runDiskSummaryCommand implements disk --summary, the aggregate view of all configured paths
+func runDiskSummaryCommand(ctx context.Context, out io.Writer, diskService *core.DiskService, format cli.OutputFormat) error {
+	if format == cli.OutputCSV {
+		return validationError(fmt.Errorf("--summary supports table and json output"))
+	}
+
+	configured := diskService.GetAllConfiguredPaths()
+	summary, err := diskService.GetAllDiskSpaces(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get disk space summary: %w", err)
+	}
+	if len(summary.Paths) == 0 {
+		return fmt.Errorf("no valid paths found to check disk space")
+	}
+
+	if err := cli.PrintDiskSummary(out, summary, configured, format); err != nil {
+		return err
+	}
+
+	// Same exit code as the full view when a path is in danger of running out of space
+	var dangerPaths []string
+	for _, path := range summary.CriticalPaths {
+		if summary.Paths[path].Health == core.DiskHealthDanger {
+			dangerPaths = append(dangerPaths, path)
+		}
+	}
+	if len(dangerPaths) > 0 {
+		return NewExitError(ExitDiskCritical, fmt.Errorf("disk space critical for: %s", strings.Join(dangerPaths, ", ")))
+	}
+	return nil
 }
 
 // runAddCommand implements the add magnet command functionality
-func runAddCommand(ctx context.Context, torrentService *core.TorrentService, seedingService *core.SeedingService,
-	magnetURI, category, customPath string) error {
+func runAddCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService, seedingService *core.SeedingService,
+	magnetURI string, opts addOptions) error {
+
+	category := opts.category
+	customPath := opts.path
+
+	// With --json the progress is discarded and only the result is printed
+	progress := out
+	if opts.jsonOutput {
+		progress = io.Discard
+	}
+	result := addResult{Category: category, SavePath: customPath, Tags: opts.tags, Paused: opts.paused}
+
+	// fail reports shown as the failure and returns err. With --json the error is
+	// only in the result, so the returned error just sets the exit code.
+	fail := func(shown, err error) error {
+		if opts.jsonOutput {
+			result.Error = shown.Error()
+			if printErr := printAddResultJSON(out, result); printErr != nil {
+				return printErr
+			}
+			return NewReportedError(err)
+		}
+		cli.PrintAddResult(out, false, result.Magnet, nil, category, customPath, shown)
+		return err
+	}
 
 	// Step 1: Validate magnet URI
-	fmt.Printf("🔍 %s\n", cli.ColorHeader.Sprint("Validating magnet URI..."))
+	fmt.Fprintf(progress, "🔍 %s\n", cli.ColorHeader.Sprint("Validating magnet URI..."))
 
 	magnetInfo, err := cli.ExtractMagnetInfo(magnetURI)
 	if err != nil {
-		cli.PrintAddResult(false, nil, category, customPath, err)
-		return err
+		return fail(err, err)
 	}
+	result.Magnet = magnetInfo
 
-	fmt.Printf("✅ Valid magnet URI found\n")
-	fmt.Printf("   Name: %s\n", magnetInfo.DisplayName)
-	fmt.Printf("   Hash: %s\n", magnetInfo.Hash)
-	fmt.Printf("   Trackers: %d\n\n", len(magnetInfo.Trackers))
+	fmt.Fprintf(progress, "✅ Valid magnet URI found\n")
+	fmt.Fprintf(progress, "   Name: %s\n", magnetInfo.DisplayName)
+	fmt.Fprintf(progress, "   Hash: %s\n", magnetInfo.Hash)
+	fmt.Fprintf(progress, "   Trackers: %d\n\n", len(magnetInfo.Trackers))
 
 	// Step 2: Validate category
 	if category != "" {
-		fmt.Printf("🏷️  %s\n", cli.ColorHeader.Sprint("Validating category..."))
+		fmt.Fprintf(progress, "🏷️  %s\n", cli.ColorHeader.Sprint("Validating category..."))
 
-		if err := cli.ValidateCategory(category); err != nil {
-			cli.PrintAddResult(false, magnetInfo, category, customPath, err)
-			return err
+		if err := cli.ValidateCategory(category, torrentService.Categories()); err != nil && !torrentService.CanCreateCategories() {
+			return fail(err, err)
 		}
 
-		fmt.Printf("✅ Category '%s' is valid\n\n", category)
+		fmt.Fprintf(progress, "✅ Category '%s' is valid\n\n", category)
 	}
 
-	// Step 3: Validate custom path if provided
-	if customPath != "" {
-		fmt.Printf("📁 %s\n", cli.ColorHeader.Sprint("Validating custom path..."))
+	// Step 3: Validate custom path if provided. For a remote qBittorrent the path
+	// lives on the server, so leave validation to qBittorrent.
+	if customPath != "" && opts.skipPathCheck {
+		fmt.Fprintf(progress, "📁 Skipping local check for custom path '%s' (validated by qBittorrent)\n\n", customPath)
+	} else if customPath != "" {
+		fmt.Fprintf(progress, "📁 %s\n", cli.ColorHeader.Sprint("Validating custom path..."))
 
 		if _, err := os.Stat(customPath); err != nil {
 			pathErr := fmt.Errorf("custom path does not exist or is not accessible: %w", err)
-			cli.PrintAddResult(false, magnetInfo, category, customPath, pathErr)
-			return pathErr
+			return fail(pathErr, pathErr)
 		}
 
-		fmt.Printf("✅ Custom path '%s' is accessible\n\n", customPath)
+		fmt.Fprintf(progress, "✅ Custom path '%s' is accessible\n\n", customPath)
 	}
 
 	// Step 4: Add torrent to qBittorrent
-	fmt.Printf("⬇️  %s\n", cli.ColorHeader.Sprint("Adding torrent to qBittorrent..."))
+	fmt.Fprintf(progress, "⬇️  %s\n", cli.ColorHeader.Sprint("Adding torrent to qBittorrent..."))
 
 	// Create add request
 	addRequest := &core.AddTorrentRequest{
-		MagnetURI: magnetURI,
-		Category:  category,
-		SavePath:  customPath,
+		MagnetURI:          magnetURI,
+		Category:           category,
+		SavePath:           customPath,
+		Paused:             opts.paused,
+		SkipChecking:       opts.skipCheck,
+		SequentialDownload: opts.sequential,
+		FirstLastPriority:  opts.firstLast,
+		UploadLimit:        opts.upLimit,
+		DownloadLimit:      opts.dlLimit,
+		Tags:               opts.tags,
 	}
 
 	// Add the torrent
 	addedTorrent, err := torrentService.AddMagnet(ctx, addRequest)
+	var existsErr *core.TorrentExistsError
+	if errors.As(err, &existsErr) {
+		// Nothing to do, so this isn't treated as a failure
+		return printTorrentExists(out, opts.jsonOutput, result, existsErr)
+	}
 	if err != nil {
 		// Check if it's a qBittorrent API error
-		if apiErr, ok := err.(*qbittorrent.APIError); ok {
-			cli.PrintAddResult(false, magnetInfo, category, customPath, fmt.Errorf("qBittorrent Error: %s", apiErr.Details))
-			return fmt.Errorf("qBittorrent error: %s", apiErr.Details)
-		} else {
-			cli.PrintAddResult(false, magnetInfo, category, customPath, err)
-			return fmt.Errorf("failed to add torrent: %w", err)
+		var apiErr *qbittorrent.APIError
+		if errors.As(err, &apiErr) {
+			return fail(fmt.Errorf("qBittorrent Error: %s", apiErr.Details), fmt.Errorf("qBittorrent error: %s", apiErr.Details))
 		}
+		return fail(err, fmt.Errorf("failed to add torrent: %w", err))
 	}
 
-	// Update magnet info with actual torrent data if available
+	// Prefer the details qBittorrent assigned over the magnet's display name
+	result.Name = magnetInfo.DisplayName
+	result.Hash = core.NormalizeInfoHash(magnetInfo.Hash) // qBittorrent reports lowercase hex
+	result.Category = addRequest.Category
 	if addedTorrent != nil {
-		magnetInfo.DisplayName = addedTorrent.Name
-		magnetInfo.Hash = addedTorrent.Hash
+		if addedTorrent.Name != "" {
+			result.Name = addedTorrent.Name
+		}
+		if addedTorrent.Hash != "" {
+			result.Hash = addedTorrent.Hash
+		}
+		if addedTorrent.Category != "" {
+			result.Category = addedTorrent.Category
+		}
+		if addedTorrent.SavePath != "" {
+			result.SavePath = addedTorrent.SavePath
+		}
 	}
 
-	// Step 5: Start seeding tracking
-	fmt.Printf("🌱 %s\n", cli.ColorHeader.Sprint("Starting seeding tracking..."))
+	// Step 5: Move to the front of the download queue if requested
+	if opts.top {
+		fmt.Fprintf(progress, "⏫ %s\n", cli.ColorHeader.Sprint("Moving torrent to top of queue..."))
+
+		if err := torrentService.SetTorrentPriorityTop(ctx, []string{result.Hash}); err != nil {
+			// Don't fail the whole operation, the torrent was added
+			fmt.Fprintf(progress, "⚠️  Warning: Failed to move torrent to top of queue: %v\n\n", err)
+			result.Warnings = append(result.Warnings, fmt.Sprintf("failed to move torrent to top of queue: %v", err))
+		} else if torrent, err := torrentService.FindTorrentByHash(ctx, result.Hash); err == nil && torrent.Priority > 0 {
+			fmt.Fprintf(progress, "✅ Queue position: %d\n\n", torrent.Priority)
+		} else if err == nil {
+			fmt.Fprintf(progress, "ℹ️  Torrent moved to top (queueing is disabled in qBittorrent)\n\n")
+		} else {
+			fmt.Fprintf(progress, "✅ Torrent moved to top of queue\n\n")
+		}
+	}
+
+	// Step 6: Start seeding tracking
+	fmt.Fprintf(progress, "🌱 %s\n", cli.ColorHeader.Sprint("Starting seeding tracking..."))
 
-	err = seedingService.StartTracking(ctx, magnetInfo.Hash, magnetInfo.DisplayName)
+	err = seedingService.StartTracking(ctx, result.Hash, result.Name)
 	if err != nil {
 		// Don't fail the whole operation if seeding tracking fails
-		fmt.Printf("⚠️  Warning: Failed to start seeding tracking: %v\n", err)
+		fmt.Fprintf(progress, "⚠️  Warning: Failed to start seeding tracking: %v\n", err)
+		result.Warnings = append(result.Warnings, fmt.Sprintf("failed to start seeding tracking: %v", err))
 	} else {
-		fmt.Printf("✅ Seeding tracking started\n\n")
+		fmt.Fprintf(progress, "✅ Seeding tracking started\n\n")
+		result.SeedingTracking = true
+	}
+
+	// Step 7: Success!
+	result.Success = true
+	if opts.jsonOutput {
+		return printAddResultJSON(out, result)
+	}
+	magnetInfo.DisplayName = result.Name
+	magnetInfo.Hash = result.Hash
+	cli.PrintAddResult(out, true, magnetInfo, addedTorrent, category, customPath, nil)
+	return nil
+}
+
+// printTorrentExists reports that the torrent being added is already in qBittorrent
+func printTorrentExists(out io.Writer, jsonOutput bool, result addResult, existsErr *core.TorrentExistsError) error {
+	if jsonOutput {
+		result.AlreadyExists = true
+		result.Name = existsErr.Name
+		result.Hash = existsErr.Hash
+		result.Category = existsErr.Category
+		result.Error = existsErr.Error()
+		return printAddResultJSON(out, result)
 	}
 
-	// Step 6: Success!
-	cli.PrintAddResult(true, magnetInfo, category, customPath, nil)
+	fmt.Fprintf(out, "ℹ️  %s\n", cli.ColorPaused.Sprint("Torrent already added"))
+	fmt.Fprintf(out, "   Name: %s\n", existsErr.Name)
+	fmt.Fprintf(out, "   Hash: %s\n", existsErr.Hash)
+	fmt.Fprintf(out, "   State: %s\n", existsErr.State)
+	if existsErr.Category != "" {
+		fmt.Fprintf(out, "   Category: %s\n", existsErr.Category)
+	}
+	fmt.Fprintf(out, "\n💡 Nothing was changed; use 'akira set-category' to move it to another category\n")
+	return nil
+}
+
+// printAddResultJSON prints the result of the add command as JSON
+func printAddResultJSON(out io.Writer, result addResult) error {
+	jsonData, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal add result to JSON: %w", err)
+	}
+
+	fmt.Fprintln(out, string(jsonData))
 	return nil
 }
 
 // runDeleteCommand implements the delete torrent command functionality
-func runDeleteCommand(ctx context.Context, torrentService *core.TorrentService, seedingService *core.SeedingService,
+func runDeleteCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService, seedingService *core.SeedingService,
 	hash, namePattern, category string, deleteFiles, force bool) error {
 
 	// Step 1: Validate input parameters
 	if hash == "" && namePattern == "" && category == "" {
-		return fmt.Errorf("must specify one of: --hash, --name, or --category")
+		return validationError(fmt.Errorf("must specify one of: --hash, --name, or --category"))
 	}
 
 	if (hash != "" && namePattern != "") || (hash != "" && category != "") || (namePattern != "" && category != "") {
-		return fmt.Errorf("can only specify one of: --hash, --name, or --category")
+		return validationError(fmt.Errorf("can only specify one of: --hash, --name, or --category"))
 	}
 
 	// Step 2: Find torrents to delete
-	fmt.Printf("🔍 %s\n", cli.ColorHeader.Sprint("Finding torrents to delete..."))
+	fmt.Fprintf(out, "🔍 %s\n", cli.ColorHeader.Sprint("Finding torrents to delete..."))
 
 	var torrentsToDelete []qbittorrent.Torrent
-	var err error
 
 	if hash != "" {
 		// Delete by specific hash
@@ -577,7 +1482,7 @@ func runDeleteCommand(ctx context.Context, torrentService *core.TorrentService,
 			return fmt.Errorf("failed to find torrent: %w", err)
 		}
 		torrentsToDelete = []qbittorrent.Torrent{*torrent}
-		fmt.Printf("✅ Found torrent: %s\n\n", torrent.Name)
+		fmt.Fprintf(out, "✅ Found torrent: %s\n\n", torrent.Name)
 
 	} else if namePattern != "" {
 		// Delete by name pattern
@@ -589,7 +1494,7 @@ func runDeleteCommand(ctx context.Context, torrentService *core.TorrentService,
 			return fmt.Errorf("no torrents found matching pattern '%s'", namePattern)
 		}
 		torrentsToDelete = torrents
-		fmt.Printf("✅ Found %d torrent(s) matching '%s'\n\n", len(torrents), namePattern)
+		fmt.Fprintf(out, "✅ Found %d torrent(s) matching '%s'\n\n", len(torrents), namePattern)
 
 	} else if category != "" {
 		// Delete by category
@@ -604,13 +1509,24 @@ func runDeleteCommand(ctx context.Context, torrentService *core.TorrentService,
 			return fmt.Errorf("no torrents found in category '%s'", category)
 		}
 		torrentsToDelete = torrents
-		fmt.Printf("✅ Found %d torrent(s) in category '%s'\n\n", len(torrents), category)
+		fmt.Fprintf(out, "✅ Found %d torrent(s) in category '%s'\n\n", len(torrents), category)
+	}
+
+	// Errored torrents can often be fixed instead of deleted
+	erroredCount := 0
+	for _, torrent := range torrentsToDelete {
+		if torrent.HasError() {
+			erroredCount++
+		}
+	}
+	if erroredCount > 0 {
+		fmt.Fprintf(out, "💡 %d torrent(s) are errored or missing files; 'akira recheck --errored' may fix them without deleting\n\n", erroredCount)
 	}
 
 	// Step 3: Get confirmation (unless forced)
 	var confirmed bool
 	if force {
-		fmt.Printf("⚡ %s\n\n", cli.ColorDownloading.Sprint("Force mode enabled - skipping confirmation"))
+		fmt.Fprintf(out, "⚡ %s\n\n", cli.ColorDownloading.Sprint("Force mode enabled - skipping confirmation"))
 		confirmed = true
 	} else {
 		// Convert to pointer slice for PrintDeleteConfirmation
@@ -618,16 +1534,16 @@ func runDeleteCommand(ctx context.Context, torrentService *core.TorrentService,
 		for i := range torrentsToDelete {
 			torrentPtrs[i] = &torrentsToDelete[i]
 		}
-		confirmed = cli.PrintDeleteConfirmation(torrentPtrs, deleteFiles)
+		confirmed = cli.PrintDeleteConfirmation(out, torrentPtrs, deleteFiles)
 	}
 
 	if !confirmed {
-		fmt.Println("❌ Deletion cancelled by user")
+		fmt.Fprintln(out, "❌ Deletion cancelled by user")
 		return nil
 	}
 
 	// Step 4: Delete torrents
-	fmt.Printf("🗑️  %s\n", cli.ColorHeader.Sprint("Deleting torrents..."))
+	fmt.Fprintf(out, "🗑️  %s\n", cli.ColorHeader.Sprint("Deleting torrents..."))
 
 	// Extract hashes
 	hashes := make([]string, len(torrentsToDelete))
@@ -635,54 +1551,62 @@ func runDeleteCommand(ctx context.Context, torrentService *core.TorrentService,
 		hashes[i] = torrent.Hash
 	}
 
-	// Perform deletion
-	err = torrentService.DeleteTorrents(ctx, hashes, deleteFiles)
-	if err != nil {
-		// For now, treat as complete failure
-		failed := make(map[string]error)
-		for _, hash := range hashes {
+	// Perform deletion one torrent at a time so partial failures can be reported
+	var deleted []string
+	failed := make(map[string]error)
+	for _, hash := range hashes {
+		if err := torrentService.DeleteTorrents(ctx, []string{hash}, deleteFiles); err != nil {
 			failed[hash] = err
+			continue
 		}
-		cli.PrintDeleteResult([]string{}, failed, deleteFiles)
-		return fmt.Errorf("failed to delete torrents: %w", err)
+		deleted = append(deleted, hash)
+	}
+
+	if len(deleted) == 0 {
+		cli.PrintDeleteResult(out, []string{}, failed, deleteFiles)
+		return fmt.Errorf("failed to delete torrents: %w", failed[hashes[0]])
 	}
 
 	// Step 5: Stop seeding tracking for deleted torrents
-	fmt.Printf("🛑 %s\n", cli.ColorHeader.Sprint("Stopping seeding tracking..."))
+	fmt.Fprintf(out, "🛑 %s\n", cli.ColorHeader.Sprint("Stopping seeding tracking..."))
 
 	stoppedCount := 0
-	for _, hash := range hashes {
+	for _, hash := range deleted {
 		err := seedingService.StopTracking(hash)
 		if err != nil {
 			// Don't fail the whole operation, just log the warning
-			fmt.Printf("⚠️  Warning: Failed to stop seeding tracking for %s: %v\n", hash[:16]+"...", err)
+			fmt.Fprintf(out, "⚠️  Warning: Failed to stop seeding tracking for %s: %v\n", hash[:16]+"...", err)
 		} else {
 			stoppedCount++
 		}
 	}
 
 	if stoppedCount > 0 {
-		fmt.Printf("✅ Stopped seeding tracking for %d torrent(s)\n\n", stoppedCount)
+		fmt.Fprintf(out, "✅ Stopped seeding tracking for %d torrent(s)\n\n", stoppedCount)
 	}
 
-	// Step 6: Success!
-	cli.PrintDeleteResult(hashes, map[string]error{}, deleteFiles)
+	// Step 6: Report results
+	cli.PrintDeleteResult(out, deleted, failed, deleteFiles)
+	if len(failed) > 0 {
+		return NewExitError(ExitPartialFailure,
+			fmt.Errorf("failed to delete %d of %d torrent(s)", len(failed), len(hashes)))
+	}
 	return nil
 }
 
 // runSeedingStatusCommand implements the seeding status command functionality
-func runSeedingStatusCommand(ctx context.Context, seedingService *core.SeedingService,
+func runSeedingStatusCommand(ctx context.Context, out io.Writer, ui config.UIConfig, seedingService *core.SeedingService,
 	jsonOutput, detailed bool) error {
 
 	// Get seeding service status
-	fmt.Printf("🔍 %s\n", cli.ColorHeader.Sprint("Checking seeding service status..."))
+	fmt.Fprintf(out, "🔍 %s\n", cli.ColorHeader.Sprint("Checking seeding service status..."))
 
 	if !seedingService.IsRunning() {
-		fmt.Printf("❌ %s\n", cli.ColorError.Sprint("Seeding service is not running"))
+		fmt.Fprintf(out, "❌ %s\n", cli.ColorError.Sprint("Seeding service is not running"))
 		return fmt.Errorf("seeding service is not running")
 	}
 
-	fmt.Printf("✅ Seeding service is running\n\n")
+	fmt.Fprintf(out, "✅ Seeding service is running\n\n")
 
 	// Get detailed seeding status
 	status, err := seedingService.GetSeedingStatus(ctx)
@@ -692,109 +1616,277 @@ func runSeedingStatusCommand(ctx context.Context, seedingService *core.SeedingSe
 
 	// Output in JSON format if requested
 	if jsonOutput {
-		return outputSeedingStatusJSON(status)
+		return outputSeedingStatusJSON(out, status)
 	}
 
 	// Output in human-readable format
-	return outputSeedingStatusHuman(status, detailed)
+	return outputSeedingStatusHuman(out, ui, status, detailed)
+}
+
+// runSeedingVerifyCommand implements the seeding verify command functionality
+func runSeedingVerifyCommand(ctx context.Context, out io.Writer, seedingService *core.SeedingService,
+	repair, jsonOutput bool) error {
+
+	result, err := seedingService.VerifyTracking(ctx, repair)
+	if err != nil {
+		return fmt.Errorf("failed to verify seeding tracking: %w", err)
+	}
+
+	if jsonOutput {
+		jsonData, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal verification result to JSON: %w", err)
+		}
+		fmt.Fprintln(out, string(jsonData))
+		return nil
+	}
+
+	fmt.Fprintf(out, "🩺 %s\n\n", cli.ColorHeader.Sprint("Seeding Tracking Verification"))
+	fmt.Fprintf(out, "   Checked Records: %d\n", result.Checked)
+	fmt.Fprintf(out, "   Issues Found: %d\n\n", len(result.Issues))
+
+	if len(result.Issues) == 0 {
+		fmt.Fprintf(out, "✅ %s\n", cli.ColorSeeding.Sprint("Tracking data is consistent with qBittorrent"))
+		return nil
+	}
+
+	for _, issue := range result.Issues {
+		marker := cli.ColorError.Sprint("✗")
+		if issue.Repaired {
+			marker = cli.ColorSeeding.Sprint("✓")
+		}
+		fmt.Fprintf(out, "   %s %s (%s)\n", marker, issue.Name, issue.Hash[:16]+"...")
+		fmt.Fprintf(out, "     %s\n", issue.Description)
+	}
+
+	if repair {
+		fmt.Fprintf(out, "\n🔧 Repaired %d of %d issue(s)\n", result.Repaired, len(result.Issues))
+	} else {
+		fmt.Fprintf(out, "\n💡 Use '%s' to fix these issues\n",
+			cli.ColorDownloading.Sprint("akira seeding verify --repair"))
+	}
+
+	return nil
+}
+
+// runSeedingTopCommand implements the seeding top command functionality
+func runSeedingTopCommand(ctx context.Context, out io.Writer, seedingService *core.SeedingService,
+	by string, limit int, jsonOutput bool) error {
+
+	sortBy, err := core.ParseLeaderboardSort(by)
+	if err != nil {
+		return err
+	}
+	if limit < 0 {
+		return validationError(fmt.Errorf("--limit cannot be negative"))
+	}
+
+	entries, err := seedingService.GetLeaderboard(ctx, sortBy, limit)
+	if err != nil {
+		return fmt.Errorf("failed to get seeding leaderboard: %w", err)
+	}
+
+	if jsonOutput {
+		jsonData, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal leaderboard to JSON: %w", err)
+		}
+		fmt.Fprintln(out, string(jsonData))
+		return nil
+	}
+
+	title := "Seeding Leaderboard by Ratio"
+	if sortBy == core.LeaderboardByUploaded {
+		title = "Seeding Leaderboard by Uploaded"
+	}
+	fmt.Fprintf(out, "🏆 %s\n\n", cli.ColorHeader.Sprint(title))
+
+	if len(entries) == 0 {
+		fmt.Fprintln(out, "📭 No tracked torrents yet")
+		return nil
+	}
+
+	fmt.Fprintf(out, "%-5s %-40s %-8s %-10s %s\n",
+		cli.ColorHeader.Sprint("#"),
+		cli.ColorHeader.Sprint("Name"),
+		cli.ColorHeader.Sprint("Ratio"),
+		cli.ColorHeader.Sprint("Uploaded"),
+		cli.ColorHeader.Sprint("State"))
+	fmt.Fprintln(out, strings.Repeat("─", 80))
+
+	medals := []string{"🥇", "🥈", "🥉"}
+	for _, entry := range entries {
+		rank := fmt.Sprintf("%d.", entry.Rank)
+		if entry.Rank <= len(medals) {
+			rank = medals[entry.Rank-1]
+		}
+
+		fmt.Fprintf(out, "%-5s %-40s %-8.2f %-10s %s %s\n",
+			rank,
+			cli.TruncateString(entry.Name, 40),
+			entry.Ratio,
+			cli.FormatBytes(entry.Uploaded),
+			cli.GetStateIcon(string(entry.State)),
+			cli.GetStateName(string(entry.State)))
+	}
+
+	return nil
+}
+
+// runSeedingPauseCommand pauses or resumes enforcement of seeding limits
+func runSeedingPauseCommand(out io.Writer, ui config.UIConfig, seedingService *core.SeedingService, pause bool) error {
+	if pause {
+		if err := seedingService.Pause(); err != nil {
+			return fmt.Errorf("failed to pause seeding service: %w", err)
+		}
+		_, pausedAt := seedingService.PauseState()
+		fmt.Fprintf(out, "⏸️  %s\n", cli.ColorPaused.Sprint("Automatic seeding stops paused"))
+		fmt.Fprintf(out, "   Paused Since: %s\n", ui.FormatTime(pausedAt))
+		fmt.Fprintf(out, "💡 Run '%s' to enforce seeding limits again\n", cli.ColorDownloading.Sprint("akira seeding resume"))
+		return nil
+	}
+
+	if err := seedingService.Resume(); err != nil {
+		return fmt.Errorf("failed to resume seeding service: %w", err)
+	}
+	fmt.Fprintf(out, "▶️  %s\n", cli.ColorSeeding.Sprint("Automatic seeding stops resumed"))
+	return nil
+}
+
+// runSeedingStopAllCommand stops every tracked torrent that is still seeding
+func runSeedingStopAllCommand(ctx context.Context, out io.Writer, seedingService *core.SeedingService) error {
+	stopped, err := seedingService.StopAllSeeding(ctx, qbittorrent.SeedingStopReasonManual)
+	if err != nil {
+		return fmt.Errorf("failed to stop all seeding: %w", err)
+	}
+
+	if stopped == 0 {
+		fmt.Fprintf(out, "ℹ️  No tracked torrents are seeding\n")
+		return nil
+	}
+	fmt.Fprintf(out, "✅ %s\n", cli.ColorSeeding.Sprintf("Stopped seeding for %d torrent(s)", stopped))
+	return nil
 }
 
 // runForceStopSeeding handles force stopping seeding for a specific torrent
-func runForceStopSeeding(ctx context.Context, seedingService *core.SeedingService, hash string) error {
-	fmt.Printf("🛑 %s\n", cli.ColorHeader.Sprintf("Force stopping seeding for %s...", hash[:16]+"..."))
+func runForceStopSeeding(ctx context.Context, out io.Writer, seedingService *core.SeedingService, hash string) error {
+	fmt.Fprintf(out, "🛑 %s\n", cli.ColorHeader.Sprintf("Force stopping seeding for %s...", hash[:16]+"..."))
 
 	err := seedingService.ForceStopSeeding(ctx, []string{hash})
 	if err != nil {
-		fmt.Printf("❌ %s\n", cli.ColorError.Sprintf("Failed to force stop seeding: %v", err))
+		fmt.Fprintf(out, "❌ %s\n", cli.ColorError.Sprintf("Failed to force stop seeding: %v", err))
 		return fmt.Errorf("failed to force stop seeding: %w", err)
 	}
 
-	fmt.Printf("✅ %s\n", cli.ColorSeeding.Sprint("Successfully force stopped seeding"))
+	fmt.Fprintf(out, "✅ %s\n", cli.ColorSeeding.Sprint("Successfully force stopped seeding"))
 	return nil
 }
 
 // outputSeedingStatusJSON outputs seeding status in JSON format
-func outputSeedingStatusJSON(status *core.SeedingStatus) error {
+func outputSeedingStatusJSON(out io.Writer, status *core.SeedingStatus) error {
 	jsonData, err := json.MarshalIndent(status, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal seeding status to JSON: %w", err)
 	}
 
-	fmt.Println(string(jsonData))
+	fmt.Fprintln(out, string(jsonData))
 	return nil
 }
 
 // outputSeedingStatusHuman outputs seeding status in human-readable format
-func outputSeedingStatusHuman(status *core.SeedingStatus, detailed bool) error {
+func outputSeedingStatusHuman(out io.Writer, ui config.UIConfig, status *core.SeedingStatus, detailed bool) error {
 	// Service overview
-	fmt.Printf("🌱 %s\n\n", cli.ColorHeader.Sprint("Seeding Service Overview"))
+	fmt.Fprintf(out, "🌱 %s\n\n", cli.ColorHeader.Sprint("Seeding Service Overview"))
 
-	fmt.Printf("📊 Statistics:\n")
-	fmt.Printf("   Tracked Torrents: %d\n", status.TrackedTorrents)
-	fmt.Printf("   Active Seeding: %d\n", status.ActiveSeeding)
-	fmt.Printf("   Completed Seeding: %d\n", status.CompletedSeeding)
-	fmt.Printf("   Overdue Seeding: %d\n", status.OverdueSeeding)
+	fmt.Fprintf(out, "📊 Statistics:\n")
+	fmt.Fprintf(out, "   Tracked Torrents: %d\n", status.TrackedTorrents)
+	fmt.Fprintf(out, "   Active Seeding: %d\n", status.ActiveSeeding)
+	fmt.Fprintf(out, "   Completed Seeding: %d\n", status.CompletedSeeding)
+	fmt.Fprintf(out, "   Overdue Seeding: %d\n", status.OverdueSeeding)
 
 	if status.TotalDownloadTime > 0 {
-		fmt.Printf("   Total Download Time: %s\n", formatDuration(status.TotalDownloadTime))
+		fmt.Fprintf(out, "   Total Download Time: %s\n", formatDuration(status.TotalDownloadTime))
 	}
 	if status.TotalSeedingTime > 0 {
-		fmt.Printf("   Total Seeding Time: %s\n", formatDuration(status.TotalSeedingTime))
+		fmt.Fprintf(out, "   Total Seeding Time: %s\n", formatDuration(status.TotalSeedingTime))
 	}
 
-	fmt.Printf("   Last Checked: %s\n", status.LastChecked.Format("2006-01-02 15:04:05"))
+	fmt.Fprintf(out, "   Last Checked: %s\n", ui.FormatTime(status.LastChecked))
+	if status.Paused {
+		fmt.Fprintf(out, "   Auto-Stop: %s\n", cli.ColorPaused.Sprintf("⏸️  Paused since %s", ui.FormatTime(status.PausedAt)))
+	} else {
+		fmt.Fprintf(out, "   Auto-Stop: %s\n", cli.ColorSeeding.Sprint("▶️  Active"))
+	}
 
 	// Show detailed torrent information if requested
 	if detailed && len(status.Details) > 0 {
-		fmt.Printf("\n📋 %s\n\n", cli.ColorHeader.Sprint("Tracked Torrents"))
+		fmt.Fprintf(out, "\n📋 %s\n\n", cli.ColorHeader.Sprint("Tracked Torrents"))
 
 		for hash, torrentStatus := range status.Details {
-			fmt.Printf("🔗 %s\n", hash[:16]+"...")
-			fmt.Printf("   Name: %s\n", torrentStatus.Name)
+			fmt.Fprintf(out, "🔗 %s\n", hash[:16]+"...")
+			fmt.Fprintf(out, "   Name: %s\n", torrentStatus.Name)
 
 			if torrentStatus.DownloadDuration > 0 {
-				fmt.Printf("   Download Time: %s\n", formatDuration(torrentStatus.DownloadDuration))
+				fmt.Fprintf(out, "   Download Time: %s\n", formatDuration(torrentStatus.DownloadDuration))
 			}
 			if torrentStatus.SeedingDuration > 0 {
-				fmt.Printf("   Seeding Time: %s\n", formatDuration(torrentStatus.SeedingDuration))
+				fmt.Fprintf(out, "   Seeding Time: %s\n", formatDuration(torrentStatus.SeedingDuration))
 			}
 			if torrentStatus.SeedingLimit > 0 {
-				fmt.Printf("   Seeding Limit: %s\n", formatDuration(torrentStatus.SeedingLimit))
+				fmt.Fprintf(out, "   Seeding Limit: %s\n", formatDuration(torrentStatus.SeedingLimit))
 			}
 			if torrentStatus.TimeRemaining > 0 {
-				fmt.Printf("   Time Remaining: %s\n", formatDuration(torrentStatus.TimeRemaining))
+				fmt.Fprintf(out, "   Time Remaining: %s\n", formatDuration(torrentStatus.TimeRemaining))
+			}
+			if torrentStatus.RatioLimit > 0 {
+				fmt.Fprintf(out, "   Ratio: %.2f / %.2f\n", torrentStatus.Ratio, torrentStatus.RatioLimit)
 			}
 
 			// Status indicator
 			if torrentStatus.AutoStopped {
-				fmt.Printf("   Status: %s\n", cli.ColorSeeding.Sprint("✅ Seeding Complete (Auto-stopped)"))
+				fmt.Fprintf(out, "   Status: %s\n", cli.ColorSeeding.Sprint(seedingStoppedLabel(torrentStatus.StopReason)))
 			} else if torrentStatus.IsOverdue {
-				fmt.Printf("   Status: %s\n", cli.ColorError.Sprint("⏰ Overdue"))
+				fmt.Fprintf(out, "   Status: %s\n", cli.ColorError.Sprint("⏰ Overdue"))
 			} else {
-				fmt.Printf("   Status: %s\n", cli.ColorDownloading.Sprint("🌱 Active Seeding"))
+				fmt.Fprintf(out, "   Status: %s\n", cli.ColorDownloading.Sprint("🌱 Active Seeding"))
 			}
 
 			if torrentStatus.CurrentState != "" {
-				fmt.Printf("   Current State: %s\n", torrentStatus.CurrentState)
+				fmt.Fprintf(out, "   Current State: %s\n", torrentStatus.CurrentState)
 			}
 
-			fmt.Println()
+			fmt.Fprintln(out)
 		}
 	} else if len(status.Details) > 0 {
-		fmt.Printf("\n💡 Use '%s' to see detailed torrent information\n",
+		fmt.Fprintf(out, "\n💡 Use '%s' to see detailed torrent information\n",
 			cli.ColorDownloading.Sprint("akira seeding --detailed"))
 	}
 
 	// Summary message
 	if status.TrackedTorrents == 0 {
-		fmt.Printf("\n📭 No torrents are currently being tracked for seeding\n")
-		fmt.Printf("💡 Add torrents with '%s' to start seeding tracking\n",
+		fmt.Fprintf(out, "\n📭 No torrents are currently being tracked for seeding\n")
+		fmt.Fprintf(out, "💡 Add torrents with '%s' to start seeding tracking\n",
 			cli.ColorDownloading.Sprint("akira add"))
 	}
 
 	return nil
 }
 
+// seedingStoppedLabel describes why seeding of a tracked torrent was stopped
+func seedingStoppedLabel(reason string) string {
+	switch reason {
+	case qbittorrent.SeedingStopReasonTime:
+		return "✅ Seeding Complete (Time limit reached)"
+	case qbittorrent.SeedingStopReasonRatio:
+		return "✅ Seeding Complete (Ratio limit reached)"
+	case qbittorrent.SeedingStopReasonManual:
+		return "✅ Seeding Complete (Stopped manually)"
+	case qbittorrent.SeedingStopReasonExit:
+		return "✅ Seeding Complete (Stopped when Akira exited)"
+	}
+	return "✅ Seeding Complete (Auto-stopped)"
+}
+
 // formatDuration formats a duration in a human-readable way
 func formatDuration(d time.Duration) string {
 	if d < time.Minute {
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/config"
+)
+
+// NewConfigCommand creates the config command with its subcommands
+func NewConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "⚙️  Manage Akira configuration",
+		Long: `⚙️  Manage Akira configuration
+
+Akira reads its settings from a .env file in the current directory and from
+environment variables. See .env.example for all available options.
+
+Examples:
+  akira config init            # Create a .env file interactively
+  akira config init --force    # Overwrite an existing .env file`,
+	}
+
+	cmd.AddCommand(newConfigInitCommand())
+
+	return cmd
+}
+
+// newConfigInitCommand creates the config init subcommand
+func newConfigInitCommand() *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "📝 Create a .env file interactively",
+		Long: `📝 Create a .env file interactively
+
+Asks for the Discord bot token, the qBittorrent WebUI address and credentials,
+and the default save path, then writes them to .env in the current directory.
+
+Examples:
+  akira config init            # Create .env
+  akira config init --force    # Overwrite an existing .env`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return RunConfigInit(cmd.InOrStdin(), cmd.OutOrStdout(), config.ConfigPath(), force)
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "overwrite an existing config file")
+
+	return cmd
+}
+
+// RunConfigInit prompts for the essential settings and writes them to path
+func RunConfigInit(in io.Reader, out io.Writer, path string, force bool) error {
+	if _, err := os.Stat(path); err == nil && !force {
+		return fmt.Errorf("config file already exists at %s (use --force to overwrite)", path)
+	}
+
+	fmt.Fprintf(out, "📝 %s\n\n", cli.ColorHeader.Sprint("Akira Setup"))
+	fmt.Fprintf(out, "Press Enter to accept the default shown in brackets.\n\n")
+
+	reader := bufio.NewReader(in)
+	var opts config.InitOptions
+	fields := []struct {
+		label        string
+		defaultValue string
+		value        *string
+	}{
+		{"Discord bot token", "", &opts.DiscordBotToken},
+		{"qBittorrent WebUI URL", "http://localhost:8080", &opts.URL},
+		{"qBittorrent username", "admin", &opts.Username},
+		{"qBittorrent password", "", &opts.Password},
+		{"Default save path", "/downloads/default", &opts.DefaultSavePath},
+	}
+
+	for _, field := range fields {
+		value, err := prompt(reader, out, field.label, field.defaultValue)
+		if err != nil {
+			return err
+		}
+		*field.value = value
+	}
+
+	if err := config.WriteConfigFile(path, opts); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "\n✅ Configuration written to %s\n", path)
+	fmt.Fprintf(out, "💡 See .env.example for category save paths, seeding and other options\n")
+	return nil
+}
+
+// IsInteractive returns true if r is a terminal that can answer prompts
+func IsInteractive(r io.Reader) bool {
+	return isTerminal(r)
+}
+
+// prompt asks for a single value, returning defaultValue when the answer is empty.
+// Values without a default are required and asked for again until given.
+func prompt(reader *bufio.Reader, out io.Writer, label, defaultValue string) (string, error) {
+	for {
+		if defaultValue != "" {
+			fmt.Fprintf(out, "%s [%s]: ", label, defaultValue)
+		} else {
+			fmt.Fprintf(out, "%s: ", label)
+		}
+
+		answer, err := reader.ReadString('\n')
+		answer = strings.TrimSpace(answer)
+		if answer != "" {
+			return answer, nil
+		}
+		if defaultValue != "" {
+			return defaultValue, nil
+		}
+		if err != nil {
+			fmt.Fprintln(out)
+			return "", fmt.Errorf("setup cancelled: %s is required", label)
+		}
+		fmt.Fprintf(out, "   ⚠️  %s is required\n", label)
+	}
+}
@@ -39,6 +39,8 @@ func NewDaemonCommand(ctx context.Context, cfg *config.Config, torrentService *c
 The daemon will:
 - Start the Discord bot with slash commands
 - Run the seeding service in the background
+- Alert when a disk runs low on space (see DISK_ALERT_INTERVAL)
+- Post completed torrents and critical disks to DISCORD_NOTIFICATION_CHANNEL_ID
 - Handle graceful shutdown on SIGINT/SIGTERM
 - Create a PID file for process management`,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -87,7 +89,7 @@ func NewRestartCommand(ctx context.Context, cfg *config.Config, torrentService *
 }
 
 // displayAkiraBanner displays the AKIRA ASCII art banner with proper alignment
-func displayAkiraBanner() {
+func displayAkiraBanner(ui config.UIConfig) {
 	const innerWidth = 62
 
 	// ASCII letters for AKIRA
@@ -152,7 +154,7 @@ func displayAkiraBanner() {
 	printLine("")
 	printLine("     Discord Bot Daemon Starting...")
 	printLine(fmt.Sprintf("     PID: %-6d", os.Getpid()))
-	printLine("     Time: " + time.Now().Format("2006-01-02 15:04:05"))
+	printLine("     Time: " + ui.FormatTime(time.Now()))
 	printLine("")
 	fmt.Printf("    ╚%s╝\n", strings.Repeat("═", innerWidth))
 }
@@ -189,7 +191,7 @@ func runDaemon(ctx context.Context, cfg *config.Config, torrentService *core.Tor
 	defer removePIDFile(daemonConfig.pidFile)
 
 	// Display AKIRA ASCII art banner
-	displayAkiraBanner()
+	displayAkiraBanner(cfg.UI)
 
 	logger.Info("Starting Akira daemon", map[string]interface{}{
 		"pid_file":   daemonConfig.pidFile,
@@ -223,10 +225,17 @@ func runDaemon(ctx context.Context, cfg *config.Config, torrentService *core.Tor
 		}
 	}()
 
+	// Watch for disks running low on space, posting completions and critical
+	// disks to the Discord notification channel if one is configured
+	alerter := core.NewDiskAlerter(cfg, diskService)
+	discordBot.StartNotifications(alerter)
+	go alerter.Run(daemonCtx)
+
 	// Set up signal handling
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
+	MarkSessionRan()
 	logger.Info("Daemon started successfully", map[string]interface{}{
 		"discord_guilds": len(cfg.Discord.GuildIDs),
 		"pid":            os.Getpid(),
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/qbittorrent"
+	"github.com/spf13/cobra"
+)
+
+// Exit codes returned by Akira commands so that scripts can react to the outcome
+const (
+	ExitSuccess           = 0 // Command completed successfully
+	ExitFailure           = 1 // Generic failure (API error, etc.)
+	ExitConnectionError   = 2 // qBittorrent could not be reached; retrying later may help
+	ExitNotFound          = 3 // The requested torrent does not exist
+	ExitValidationError   = 4 // A flag, magnet URI, category or pattern was rejected; retrying won't help
+	ExitPartialFailure    = 5 // Some items were processed, but at least one failed
+	ExitDiskCritical      = 6 // At least one checked path is critically low on space
+	ExitHealthCheckFailed = 7 // A health check did not pass
+)
+
+// ExitError wraps an error with the process exit code it should produce
+type ExitError struct {
+	Code     int
+	Err      error
+	Reported bool // The command already printed the error, so only the exit code is left to set
+}
+
+// Error implements the error interface
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// NewExitError creates an error that makes the process exit with the given code
+func NewExitError(code int, err error) *ExitError {
+	return &ExitError{Code: code, Err: err}
+}
+
+// NewReportedError wraps an error the command has already printed, for example
+// inside a --json result. The process exits with the code for err without
+// printing it again.
+func NewReportedError(err error) *ExitError {
+	return &ExitError{Code: ExitCode(err), Err: err, Reported: true}
+}
+
+// validationError marks err as rejected user input, so the process exits with
+// ExitValidationError
+func validationError(err error) error {
+	return NewExitError(ExitValidationError, err)
+}
+
+// ValidateArgs makes the positional argument checks (cobra.ExactArgs etc.) of c
+// and all of its subcommands fail with ExitValidationError. Call it once every
+// subcommand has been added.
+func ValidateArgs(c *cobra.Command) {
+	if args := c.Args; args != nil {
+		c.Args = func(c *cobra.Command, a []string) error {
+			if err := args(c, a); err != nil {
+				return validationError(err)
+			}
+			return nil
+		}
+	}
+	for _, sub := range c.Commands() {
+		ValidateArgs(sub)
+	}
+}
+
+// IsReported reports whether err was already printed by the command
+func IsReported(err error) bool {
+	var exitErr *ExitError
+	return errors.As(err, &exitErr) && exitErr.Reported
+}
+
+// ExitCode returns the process exit code for an error returned by a command
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitSuccess
+	}
+
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+
+	switch {
+	case errors.Is(err, qbittorrent.ErrConnection):
+		return ExitConnectionError
+	case errors.Is(err, core.ErrTorrentNotFound):
+		return ExitNotFound
+	case errors.Is(err, core.ErrInvalidMagnet), errors.Is(err, core.ErrInvalidCategory), errors.Is(err, core.ErrInvalidPattern):
+		return ExitValidationError
+	}
+
+	return ExitFailure
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/core"
+)
+
+// NewExportCommand creates the export command
+func NewExportCommand(ctx context.Context, torrentService *core.TorrentService) *cobra.Command {
+	var output string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "export <hash>",
+		Short: "💾 Save a torrent's .torrent file",
+		Long: `💾 Save the .torrent file of a torrent
+
+Handy for backing up torrents before deleting them. The file is written to
+<name>.torrent in the current directory unless --output is given. Torrents
+added by magnet link can only be exported once their metadata is downloaded.
+
+Examples:
+  akira export abc123...                            # Write "<name>.torrent"
+  akira export abc123... --output backup.torrent    # Write to a specific file
+  akira export abc123... -o backup.torrent --force  # Overwrite an existing file`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExportCommand(ctx, cmd.OutOrStdout(), torrentService, args[0], output, force)
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "file to write (default \"<name>.torrent\")")
+	cmd.Flags().BoolVar(&force, "force", false, "overwrite the output file if it exists")
+
+	return cmd
+}
+
+// runExportCommand implements the export command functionality
+func runExportCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService,
+	hash, output string, force bool) error {
+
+	torrent, err := torrentService.FindTorrentByHash(ctx, hash)
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		output = torrentFileName(torrent.Name)
+	}
+	if _, err := os.Stat(output); err == nil && !force {
+		return fmt.Errorf("%s already exists (use --force to overwrite)", output)
+	}
+
+	data, err := torrentService.ExportTorrent(ctx, torrent)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	fmt.Fprintf(out, "💾 %s\n", cli.ColorSeeding.Sprint("Torrent exported"))
+	fmt.Fprintf(out, "   Name: %s\n", torrent.Name)
+	fmt.Fprintf(out, "   File: %s (%s)\n", output, cli.FormatBytes(int64(len(data))))
+
+	return nil
+}
+
+// torrentFileName returns a safe file name for a torrent's .torrent file
+func torrentFileName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>|`, r) || r < 32 {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+
+	if name == "" || name == "." || name == ".." {
+		name = "torrent"
+	}
+	return name + ".torrent"
+}
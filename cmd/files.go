@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// NewFilesCommand creates the files command
+func NewFilesCommand(ctx context.Context, torrentService *core.TorrentService) *cobra.Command {
+	var skipPatterns []string
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "files <hash>",
+		Short: "📁 List and skip torrent files",
+		Long: `📁 List the files of a torrent and skip unwanted ones
+
+Shows every file with its priority, size and progress. With --skip-pattern,
+files matching any pattern are set to "do not download" first.
+
+Patterns are globs matched case-insensitively against the file name and its
+path within the torrent (e.g. "*sample*", "*.nfo"). Prefix a pattern with
+"re:" to use a regular expression instead. QBITTORRENT_SKIP_PATTERNS applies
+the same patterns automatically to every torrent you add.
+
+Examples:
+  akira files abc123...                                  # List files
+  akira files abc123... --skip-pattern "*sample*"        # Skip sample videos
+  akira files abc123... --skip-pattern "*.nfo" --skip-pattern "*.txt"
+  akira files abc123... --skip-pattern 're:^extras/'     # Skip a folder
+  akira files abc123... --json                           # Export as JSON`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runFilesCommand(ctx, cmd.OutOrStdout(), torrentService, args[0], skipPatterns, jsonOutput)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&skipPatterns, "skip-pattern", nil, "don't download files matching this pattern (repeatable)")
+	cmd.Flags().BoolVarP(&jsonOutput, "json", "j", false, "output in JSON format")
+
+	return cmd
+}
+
+// runFilesCommand implements the files command functionality
+func runFilesCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService, hash string,
+	skipPatterns []string, jsonOutput bool) error {
+
+	torrent, err := torrentService.FindTorrentByHash(ctx, hash)
+	if err != nil {
+		return err
+	}
+
+	if len(skipPatterns) > 0 {
+		skipped, err := torrentService.SkipMatchingFiles(ctx, torrent.Hash, skipPatterns)
+		if err != nil {
+			return err
+		}
+
+		if !jsonOutput {
+			if len(skipped) == 0 {
+				fmt.Fprintf(out, "✨ No new files matched the skip patterns\n\n")
+			} else {
+				fmt.Fprintf(out, "⏭️  %s\n", cli.ColorPaused.Sprintf("Skipped %d file(s)", len(skipped)))
+				for _, file := range skipped {
+					fmt.Fprintf(out, "   • %s (%s)\n", file.Name, cli.FormatBytes(file.Size))
+				}
+				fmt.Fprintln(out)
+			}
+		}
+	}
+
+	files, err := torrentService.GetTorrentFiles(ctx, torrent.Hash)
+	if err != nil {
+		return err
+	}
+
+	return cli.PrintTorrentFiles(out, torrent.Name, files, jsonOutput)
+}
+
+// NewFilePriorityCommand creates the file-priority command
+func NewFilePriorityCommand(ctx context.Context, torrentService *core.TorrentService) *cobra.Command {
+	var fileIndexes []int
+	var priorityName string
+
+	cmd := &cobra.Command{
+		Use:   "file-priority <hash>",
+		Short: "🎚️  Set the priority of torrent files",
+		Long: `🎚️  Set the download priority of individual files in a torrent
+
+File indexes are the numbers in the first column of 'akira files <hash>'.
+Priorities: skip (don't download), normal, high, maximal.
+
+Examples:
+  akira file-priority abc123... --files 0,1,2 --priority skip     # Don't download three files
+  akira file-priority abc123... --files 4 --priority high         # Download one file first`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runFilePriorityCommand(ctx, cmd.OutOrStdout(), torrentService, args[0], fileIndexes, priorityName)
+		},
+	}
+
+	cmd.Flags().IntSliceVar(&fileIndexes, "files", nil, "comma-separated file indexes")
+	cmd.Flags().StringVar(&priorityName, "priority", "", "priority to set (skip, normal, high, maximal)")
+	cmd.MarkFlagRequired("files")
+	cmd.MarkFlagRequired("priority")
+
+	return cmd
+}
+
+// runFilePriorityCommand implements the file-priority command functionality
+func runFilePriorityCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService, hash string,
+	fileIndexes []int, priorityName string) error {
+
+	priority, err := qbittorrent.ParseFilePriority(priorityName)
+	if err != nil {
+		return err
+	}
+
+	torrent, err := torrentService.FindTorrentByHash(ctx, hash)
+	if err != nil {
+		return err
+	}
+
+	updated, err := torrentService.SetFilePriority(ctx, torrent.Hash, fileIndexes, priority)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "✅ %s\n", cli.ColorSeeding.Sprintf("Set %d file(s) to %s", len(updated), cli.GetFilePriorityName(priority)))
+	for _, file := range updated {
+		fmt.Fprintf(out, "   • [%d] %s (%s)\n", file.Index, file.Name, cli.FormatBytes(file.Size))
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/config"
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// torrentInfo is everything the info command shows about a torrent
+type torrentInfo struct {
+	Torrent    *qbittorrent.Torrent           `json:"torrent"`
+	Properties *qbittorrent.TorrentProperties `json:"properties"`
+	Trackers   []qbittorrent.TorrentTracker   `json:"trackers"`
+	Seeding    *core.SeedingTorrentStatus     `json:"seeding,omitempty"`
+}
+
+// NewInfoCommand creates the info command
+func NewInfoCommand(ctx context.Context, cfg *config.Config, torrentService *core.TorrentService,
+	seedingService *core.SeedingService) *cobra.Command {
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "info <hash>",
+		Short: "🔎 Show details of a torrent",
+		Long: `🔎 Show everything about a single torrent
+
+Shows the torrent's state, progress, size, ratio, transfer totals, pieces,
+save path and trackers, plus its seeding schedule if Akira tracks it.
+
+Examples:
+  akira info abc123...          # Show torrent details
+  akira info abc123... --json   # Details as JSON for scripts`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runInfoCommand(ctx, cmd.OutOrStdout(), cfg.UI, torrentService, seedingService, args[0], jsonOutput)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&jsonOutput, "json", "j", false, "output in JSON format")
+
+	return cmd
+}
+
+// runInfoCommand implements the info command functionality
+func runInfoCommand(ctx context.Context, out io.Writer, ui config.UIConfig, torrentService *core.TorrentService,
+	seedingService *core.SeedingService, hash string, jsonOutput bool) error {
+
+	torrent, err := torrentService.FindTorrentByHash(ctx, hash)
+	if err != nil {
+		return err
+	}
+
+	properties, err := torrentService.GetTorrentProperties(ctx, torrent.Hash)
+	if err != nil {
+		return err
+	}
+
+	trackers, err := torrentService.GetTorrentTrackers(ctx, torrent.Hash)
+	if err != nil {
+		return err
+	}
+
+	info := torrentInfo{Torrent: torrent, Properties: properties, Trackers: trackers}
+	if seedingService.IsRunning() {
+		if status, err := seedingService.GetSeedingStatus(ctx); err == nil {
+			info.Seeding = status.Details[torrent.Hash]
+		}
+	}
+
+	if jsonOutput {
+		if info.Trackers == nil {
+			info.Trackers = []qbittorrent.TorrentTracker{}
+		}
+		jsonData, err := json.MarshalIndent(info, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Fprintln(out, string(jsonData))
+		return nil
+	}
+
+	printTorrentInfo(out, ui, info)
+	return nil
+}
+
+// printTorrentInfo prints the human-readable info panel
+func printTorrentInfo(out io.Writer, ui config.UIConfig, info torrentInfo) {
+	torrent, properties := info.Torrent, info.Properties
+	state := string(torrent.State)
+
+	fmt.Fprintf(out, "🔎 %s\n", cli.ColorHeader.Sprint(torrent.Name))
+	fmt.Fprintln(out, strings.Repeat("─", 80))
+
+	fmt.Fprintf(out, "   Hash: %s\n", torrent.Hash)
+	fmt.Fprintf(out, "   State: %s %s\n", cli.GetStateIcon(state), cli.GetStateColor(state).Sprint(torrent.GetStateDisplayName()))
+	fmt.Fprintf(out, "   Progress: %s\n", cli.CreateProgressBar(torrent.Progress, 30))
+	if torrent.Category != "" {
+		fmt.Fprintf(out, "   Category: %s\n", torrent.Category)
+	}
+	if tags := torrent.GetTags(); len(tags) > 0 {
+		fmt.Fprintf(out, "   Tags: %s\n", strings.Join(tags, ", "))
+	}
+	fmt.Fprintf(out, "   Save Path: %s\n", properties.SavePath)
+
+	fmt.Fprintf(out, "\n📦 %s\n", cli.ColorHeader.Sprint("Size"))
+	fmt.Fprintf(out, "   Selected: %s of %s\n", cli.FormatBytes(torrent.Size), cli.FormatBytes(properties.TotalSize))
+	fmt.Fprintf(out, "   Pieces: %d / %d × %s\n", properties.PiecesHave, properties.PiecesNum, cli.FormatBytes(properties.PieceSize))
+	if torrent.Progress < 1 {
+		fmt.Fprintf(out, "   Remaining: %s (ETA %s)\n", cli.FormatBytes(torrent.AmountLeft), torrent.GetFormattedETA())
+	}
+
+	fmt.Fprintf(out, "\n🔄 %s\n", cli.ColorHeader.Sprint("Transfer"))
+	fmt.Fprintf(out, "   Downloaded: %s (%s this session)\n",
+		cli.FormatBytes(properties.TotalDownloaded), cli.FormatBytes(properties.TotalDownloadedSession))
+	fmt.Fprintf(out, "   Uploaded: %s (%s this session)\n",
+		cli.FormatBytes(properties.TotalUploaded), cli.FormatBytes(properties.TotalUploadedSession))
+	fmt.Fprintf(out, "   Ratio: %.2f\n", properties.ShareRatio)
+	fmt.Fprintf(out, "   Speed: ↓ %s  ↑ %s\n", cli.FormatSpeed(properties.DlSpeed), cli.FormatSpeed(properties.UpSpeed))
+	fmt.Fprintf(out, "   Limits: ↓ %s  ↑ %s\n",
+		qbittorrent.FormatSpeedLimit(properties.DlLimit), qbittorrent.FormatSpeedLimit(properties.UpLimit))
+	fmt.Fprintf(out, "   Peers: %d seeds (%d total), %d peers (%d total)\n",
+		properties.Seeds, properties.SeedsTotal, properties.Peers, properties.PeersTotal)
+	if properties.TotalWasted > 0 {
+		fmt.Fprintf(out, "   Wasted: %s\n", cli.FormatBytes(properties.TotalWasted))
+	}
+
+	fmt.Fprintf(out, "\n🕒 %s\n", cli.ColorHeader.Sprint("Time"))
+	if properties.AdditionDate > 0 {
+		fmt.Fprintf(out, "   Added: %s\n", ui.FormatTime(time.Unix(properties.AdditionDate, 0)))
+	}
+	if properties.CompletionDate > 0 {
+		fmt.Fprintf(out, "   Completed: %s\n", ui.FormatTime(time.Unix(properties.CompletionDate, 0)))
+	}
+	fmt.Fprintf(out, "   Active: %s\n", cli.FormatDuration(properties.TimeElapsed))
+	if properties.SeedingTime > 0 {
+		fmt.Fprintf(out, "   Seeding: %s\n", cli.FormatDuration(properties.SeedingTime))
+	}
+
+	fmt.Fprintf(out, "\n📡 %s\n", cli.ColorHeader.Sprintf("Trackers (%d)", len(info.Trackers)))
+	for _, tracker := range info.Trackers {
+		status := tracker.GetStatusName()
+		switch tracker.Status {
+		case qbittorrent.TrackerStatusWorking:
+			status = cli.ColorSeeding.Sprint(status)
+		case qbittorrent.TrackerStatusNotWorking:
+			status = cli.ColorError.Sprint(status)
+		}
+
+		line := fmt.Sprintf("   • %s [%s]", cli.TruncateString(tracker.URL, 60), status)
+		if tracker.Msg != "" {
+			line += " " + tracker.Msg
+		}
+		fmt.Fprintln(out, line)
+	}
+
+	if seeding := info.Seeding; seeding != nil {
+		fmt.Fprintf(out, "\n🌱 %s\n", cli.ColorHeader.Sprint("Seeding Tracking"))
+		if seeding.DownloadDuration > 0 {
+			fmt.Fprintf(out, "   Download Time: %s\n", formatDuration(seeding.DownloadDuration))
+		}
+		if seeding.SeedingDuration > 0 {
+			fmt.Fprintf(out, "   Seeding Time: %s\n", formatDuration(seeding.SeedingDuration))
+		}
+		if seeding.SeedingLimit > 0 {
+			fmt.Fprintf(out, "   Seeding Limit: %s\n", formatDuration(seeding.SeedingLimit))
+		}
+		if seeding.RatioLimit > 0 {
+			fmt.Fprintf(out, "   Ratio Limit: %.2f\n", seeding.RatioLimit)
+		}
+		switch {
+		case seeding.AutoStopped:
+			fmt.Fprintf(out, "   Status: %s\n", cli.ColorSeeding.Sprint(seedingStoppedLabel(seeding.StopReason)))
+		case seeding.IsOverdue:
+			fmt.Fprintf(out, "   Status: %s\n", cli.ColorError.Sprint("⏰ Overdue"))
+		case !seeding.SeedingStopTime.IsZero():
+			fmt.Fprintf(out, "   Status: %s\n", cli.ColorDownloading.Sprintf("🌱 Stops at %s (%s left)",
+				ui.FormatTime(seeding.SeedingStopTime), formatDuration(seeding.TimeRemaining)))
+		default:
+			fmt.Fprintf(out, "   Status: %s\n", cli.ColorDownloading.Sprint("📥 Waiting for download to complete"))
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// NewLimitCommand creates the limit command
+func NewLimitCommand(ctx context.Context, torrentService *core.TorrentService) *cobra.Command {
+	var hashes []string
+	var upload, download string
+	var global bool
+
+	cmd := &cobra.Command{
+		Use:   "limit",
+		Short: "🚦 Set torrent or global speed limits",
+		Long: `🚦 Set upload and download speed limits
+
+Limits are byte rates per second with an optional K, M or G suffix (powers of
+1024). Use 0 to remove a limit. Only the limits given are changed.
+
+Examples:
+  akira limit --hash abc123... --upload 1M --download 5M   # Limit one torrent
+  akira limit --hash abc123... --hash def456... --upload 0  # Remove the upload limit of two torrents
+  akira limit --global --download 10M                       # Limit the whole client
+  akira limit --global --upload 0 --download 0              # Remove the global limits`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var uploadLimit, downloadLimit *int64
+			if cmd.Flags().Changed("upload") {
+				limit, err := parseLimitFlag("upload", upload)
+				if err != nil {
+					return err
+				}
+				uploadLimit = &limit
+			}
+			if cmd.Flags().Changed("download") {
+				limit, err := parseLimitFlag("download", download)
+				if err != nil {
+					return err
+				}
+				downloadLimit = &limit
+			}
+			return runLimitCommand(ctx, cmd.OutOrStdout(), torrentService, hashes, global, uploadLimit, downloadLimit)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&hashes, "hash", nil, "torrent hash to limit (repeatable)")
+	cmd.Flags().StringVar(&upload, "upload", "", "upload limit, e.g. 500K or 1M (0 for unlimited)")
+	cmd.Flags().StringVar(&download, "download", "", "download limit, e.g. 5M or 1G (0 for unlimited)")
+	cmd.Flags().BoolVar(&global, "global", false, "set the client-wide limits instead of per-torrent limits")
+
+	return cmd
+}
+
+// parseLimitFlag parses a speed limit flag value, rejecting negative rates
+func parseLimitFlag(name, value string) (int64, error) {
+	if strings.HasPrefix(strings.TrimSpace(value), "-") {
+		return 0, validationError(fmt.Errorf("--%s must not be negative", name))
+	}
+
+	limit, err := qbittorrent.ParseSpeedLimit(value)
+	if err != nil {
+		return 0, validationError(fmt.Errorf("invalid --%s: %w", name, err))
+	}
+	return limit, nil
+}
+
+// runLimitCommand implements the limit command functionality. A nil limit is left unchanged.
+func runLimitCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService, hashes []string,
+	global bool, uploadLimit, downloadLimit *int64) error {
+
+	if len(hashes) == 0 && !global {
+		return validationError(fmt.Errorf("must specify --hash or --global"))
+	}
+	if len(hashes) > 0 && global {
+		return validationError(fmt.Errorf("can only specify one of: --hash or --global"))
+	}
+	if uploadLimit == nil && downloadLimit == nil {
+		return validationError(fmt.Errorf("must specify --upload and/or --download"))
+	}
+
+	if global {
+		if uploadLimit != nil {
+			if err := torrentService.SetGlobalUploadLimit(ctx, *uploadLimit); err != nil {
+				return err
+			}
+		}
+		if downloadLimit != nil {
+			if err := torrentService.SetGlobalDownloadLimit(ctx, *downloadLimit); err != nil {
+				return err
+			}
+		}
+
+		fmt.Fprintf(out, "🚦 %s\n", cli.ColorSeeding.Sprint("Global speed limits updated"))
+		printLimits(out, uploadLimit, downloadLimit)
+		return nil
+	}
+
+	var torrents []qbittorrent.Torrent
+	for _, hash := range hashes {
+		torrent, err := torrentService.FindTorrentByHash(ctx, hash)
+		if err != nil {
+			return fmt.Errorf("failed to find torrent: %w", err)
+		}
+		torrents = append(torrents, *torrent)
+	}
+
+	torrentHashes := make([]string, len(torrents))
+	for i, torrent := range torrents {
+		torrentHashes[i] = torrent.Hash
+	}
+
+	if uploadLimit != nil {
+		if err := torrentService.SetTorrentUploadLimit(ctx, torrentHashes, *uploadLimit); err != nil {
+			return err
+		}
+	}
+	if downloadLimit != nil {
+		if err := torrentService.SetTorrentDownloadLimit(ctx, torrentHashes, *downloadLimit); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(out, "🚦 %s\n", cli.ColorSeeding.Sprintf("Speed limits updated for %d torrent(s)", len(torrents)))
+	printLimits(out, uploadLimit, downloadLimit)
+	for _, torrent := range torrents {
+		fmt.Fprintf(out, "   • %s\n", cli.TruncateString(torrent.Name, 60))
+	}
+
+	return nil
+}
+
+// printLimits prints the limits that were changed
+func printLimits(out io.Writer, uploadLimit, downloadLimit *int64) {
+	if uploadLimit != nil {
+		fmt.Fprintf(out, "   Upload: %s\n", qbittorrent.FormatSpeedLimit(*uploadLimit))
+	}
+	if downloadLimit != nil {
+		fmt.Fprintf(out, "   Download: %s\n", qbittorrent.FormatSpeedLimit(*downloadLimit))
+	}
+}
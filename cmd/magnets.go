@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/core"
+)
+
+// NewMagnetsCommand creates the magnets command that exports magnet URIs for backup
+func NewMagnetsCommand(ctx context.Context, torrentService *core.TorrentService) *cobra.Command {
+	var category string
+	var outputFile string
+
+	cmd := &cobra.Command{
+		Use:   "magnets",
+		Short: "🧲 Export magnet URIs for backup",
+		Long: `🧲 Export magnet URIs for backup
+
+Prints one magnet URI per line, sorted by torrent name, so the list can be
+saved and re-added later, e.g. if qBittorrent's configuration is lost.
+Nothing but the magnet URIs is written to stdout, making it safe to redirect.
+
+Examples:
+  akira magnets                               # Print all magnet URIs
+  akira magnets --category movies             # Only movies
+  akira magnets > magnets.txt                 # Save a backup
+  akira magnets --output magnets.txt          # Same, with a summary
+  akira add-batch --file magnets.txt          # Restore`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMagnetsCommand(ctx, cmd.OutOrStdout(), torrentService, category, outputFile)
+		},
+	}
+
+	cmd.Flags().StringVar(&category, "category", "", "only export torrents in this category ("+categoryList(torrentService)+")")
+	cmd.Flags().StringVarP(&outputFile, "output", "o", "", "write the magnet URIs to a file instead of stdout")
+
+	return cmd
+}
+
+// runMagnetsCommand implements the magnets command functionality
+func runMagnetsCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService, category, outputFile string) error {
+	filter := &core.TorrentFilter{SortBy: core.SortByName}
+	if category != "" {
+		if err := cli.ValidateCategory(category, torrentService.Categories()); err != nil {
+			return err
+		}
+		filter.Category = strings.ToLower(category)
+	}
+
+	torrents, err := torrentService.GetTorrents(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("failed to get torrents: %w", err)
+	}
+
+	var magnets strings.Builder
+	exported, missing := 0, 0
+	for _, torrent := range torrents {
+		if torrent.MagnetURI == "" {
+			missing++
+			continue
+		}
+		magnets.WriteString(torrent.MagnetURI)
+		magnets.WriteString("\n")
+		exported++
+	}
+
+	if outputFile == "" {
+		_, err := io.WriteString(out, magnets.String())
+		if missing > 0 {
+			fmt.Fprintf(os.Stderr, "⚠️  %d torrent(s) have no magnet URI and were not exported\n", missing)
+		}
+		return err
+	}
+
+	if err := os.WriteFile(outputFile, []byte(magnets.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write magnets file: %w", err)
+	}
+
+	fmt.Fprintf(out, "🧲 %s\n", cli.ColorSeeding.Sprintf("Exported %d magnet URI(s) to %s", exported, outputFile))
+	if missing > 0 {
+		fmt.Fprintf(out, "⚠️  %d torrent(s) have no magnet URI and were not exported\n", missing)
+	}
+	return nil
+}
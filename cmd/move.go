@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/config"
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// moveUsageWarningPercent is the disk usage above which a move prints a warning
+const moveUsageWarningPercent = 90.0
+
+// NewMoveCommand creates the move command
+func NewMoveCommand(ctx context.Context, cfg *config.Config, torrentService *core.TorrentService, diskService *core.DiskService) *cobra.Command {
+	var hashes []string
+	var location string
+	var skipPathCheck bool
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "move",
+		Short: "🚚 Move torrent data to another location",
+		Long: `🚚 Move the data of torrents to another directory
+
+Before moving, the destination is checked: it must exist and have enough free
+space for the torrents, and a warning is shown when the move would fill its
+disk past 90%. Torrents already on the destination's disk don't need extra
+space. While qBittorrent copies the data the torrents show as Moving.
+
+Examples:
+  akira move --hash abc123... --path /mnt/archive/movies        # Move one torrent
+  akira move --hash abc123... --hash def456... --path /mnt/big  # Move several torrents
+  akira move --hash abc123... --path /mnt/full --force          # Move despite too little space`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMoveCommand(ctx, cmd.OutOrStdout(), torrentService, diskService, hashes, location, skipPathCheck, force)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&hashes, "hash", nil, "torrent hash to move (repeatable)")
+	cmd.Flags().StringVarP(&location, "path", "p", "", "destination directory")
+	cmd.Flags().BoolVar(&skipPathCheck, "skip-path-check", cfg.QBittorrent.Remote,
+		"don't check the destination locally (default true when QBITTORRENT_REMOTE is set)")
+	cmd.Flags().BoolVar(&force, "force", false, "move even if the destination seems to lack free space")
+	cmd.MarkFlagRequired("hash")
+	cmd.MarkFlagRequired("path")
+
+	return cmd
+}
+
+// runMoveCommand implements the move command functionality
+func runMoveCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService, diskService *core.DiskService,
+	hashes []string, location string, skipPathCheck, force bool) error {
+
+	var torrents []qbittorrent.Torrent
+	for _, hash := range hashes {
+		torrent, err := torrentService.FindTorrentByHash(ctx, hash)
+		if err != nil {
+			return fmt.Errorf("failed to find torrent: %w", err)
+		}
+		torrents = append(torrents, *torrent)
+	}
+
+	if skipPathCheck {
+		fmt.Fprintf(out, "📁 Skipping local check for '%s' (validated by qBittorrent)\n\n", location)
+	} else {
+		if err := checkMoveDestination(ctx, out, diskService, torrents, location, force); err != nil {
+			return err
+		}
+	}
+
+	torrentHashes := make([]string, len(torrents))
+	for i, torrent := range torrents {
+		torrentHashes[i] = torrent.Hash
+	}
+
+	if err := torrentService.SetTorrentLocation(ctx, torrentHashes, location); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "🚚 %s\n", cli.ColorSeeding.Sprintf("Moving %d torrent(s) to %s", len(torrents), location))
+	for _, torrent := range torrents {
+		fmt.Fprintf(out, "   • %s (%s)\n", cli.TruncateString(torrent.Name, 60), cli.FormatBytes(torrent.Size))
+	}
+	fmt.Fprintf(out, "\n💡 The torrents show as Moving until qBittorrent has copied their data\n")
+
+	return nil
+}
+
+// checkMoveDestination verifies that location exists and has room for the torrents.
+// Lack of space is an error unless force is set; a nearly full disk is only a warning.
+func checkMoveDestination(ctx context.Context, out io.Writer, diskService *core.DiskService,
+	torrents []qbittorrent.Torrent, location string, force bool) error {
+
+	fmt.Fprintf(out, "📁 %s\n", cli.ColorHeader.Sprint("Checking destination..."))
+
+	info, err := os.Stat(location)
+	if err != nil {
+		return fmt.Errorf("destination does not exist or is not accessible: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("destination %s is not a directory", location)
+	}
+
+	destination, err := diskService.GetDiskSpace(ctx, location)
+	if err != nil {
+		return fmt.Errorf("failed to check free space at destination: %w", err)
+	}
+
+	// Data already on the destination's disk is renamed in place and needs no extra space.
+	// qBittorrent reports a single disk for every path, so the device can't be compared then.
+	sameDiskKnown := destination.DeviceID != "" && destination.DeviceID != config.DiskSpaceSourceQBittorrent
+	var required int64
+	for _, torrent := range torrents {
+		if sameDiskKnown && torrent.SavePath != "" {
+			if source, err := diskService.GetDiskSpace(ctx, filepath.Clean(torrent.SavePath)); err == nil &&
+				source.DeviceID == destination.DeviceID {
+				continue
+			}
+		}
+		required += torrent.Size
+	}
+
+	if required > destination.Free {
+		message := fmt.Sprintf("not enough free space at %s: need %s, %s free",
+			location, cli.FormatBytes(required), cli.FormatBytes(destination.Free))
+		if !force {
+			return fmt.Errorf("%s (use --force to move anyway)", message)
+		}
+		fmt.Fprintf(out, "⚠️  %s\n", cli.ColorPaused.Sprint(message))
+	}
+
+	if destination.HasCapacity() {
+		usedAfter := float64(destination.Used+required) / float64(destination.Total) * 100
+		if usedAfter > moveUsageWarningPercent {
+			fmt.Fprintf(out, "⚠️  %s\n", cli.ColorPaused.Sprintf("Destination disk will be %.1f%% full after the move", usedAfter))
+		}
+	}
+
+	fmt.Fprintf(out, "✅ Destination has %s free, %s needed\n\n", cli.FormatBytes(destination.Free), cli.FormatBytes(required))
+	return nil
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// NewReannounceCommand creates the reannounce command
+func NewReannounceCommand(ctx context.Context, torrentService *core.TorrentService) *cobra.Command {
+	var hashes []string
+	var stalled bool
+
+	cmd := &cobra.Command{
+		Use:   "reannounce",
+		Short: "📣 Reannounce torrents to their trackers",
+		Long: `📣 Force torrents to reannounce to their trackers
+
+Reannouncing asks the trackers for fresh peers right away instead of waiting
+for the next scheduled announce, which often gets stalled torrents moving again.
+
+Examples:
+  akira reannounce --hash abc123...                   # Reannounce one torrent
+  akira reannounce --hash abc123... --hash def456...  # Reannounce several torrents
+  akira reannounce --stalled                          # Reannounce every stalled torrent`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReannounceCommand(ctx, cmd.OutOrStdout(), torrentService, hashes, stalled)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&hashes, "hash", nil, "torrent hash to reannounce (repeatable)")
+	cmd.Flags().BoolVar(&stalled, "stalled", false, "reannounce all stalled downloads and uploads")
+
+	return cmd
+}
+
+// runReannounceCommand implements the reannounce command functionality
+func runReannounceCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService, hashes []string, stalled bool) error {
+	if len(hashes) == 0 && !stalled {
+		return validationError(fmt.Errorf("must specify --hash or --stalled"))
+	}
+	if len(hashes) > 0 && stalled {
+		return validationError(fmt.Errorf("can only specify one of: --hash or --stalled"))
+	}
+
+	var torrents []qbittorrent.Torrent
+	if stalled {
+		found, err := torrentService.GetTorrents(ctx, &core.TorrentFilter{
+			States: []qbittorrent.TorrentState{qbittorrent.StateStalledDL, qbittorrent.StateStalledUP},
+			SortBy: core.SortByName,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get stalled torrents: %w", err)
+		}
+		if len(found) == 0 {
+			fmt.Fprintln(out, "✨ No stalled torrents to reannounce")
+			return nil
+		}
+		torrents = found
+	} else {
+		for _, hash := range hashes {
+			torrent, err := torrentService.FindTorrentByHash(ctx, hash)
+			if err != nil {
+				return fmt.Errorf("failed to find torrent: %w", err)
+			}
+			torrents = append(torrents, *torrent)
+		}
+	}
+
+	torrentHashes := make([]string, len(torrents))
+	for i, torrent := range torrents {
+		torrentHashes[i] = torrent.Hash
+	}
+
+	if err := torrentService.ReannounceTorrents(ctx, torrentHashes); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "📣 %s\n", cli.ColorSeeding.Sprintf("Reannounced %d torrent(s)", len(torrents)))
+	for _, torrent := range torrents {
+		fmt.Fprintf(out, "   • %s (%s)\n", cli.TruncateString(torrent.Name, 60), torrent.GetStateDisplayName())
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// NewRecheckCommand creates the recheck command
+func NewRecheckCommand(ctx context.Context, torrentService *core.TorrentService) *cobra.Command {
+	var hashes []string
+	var errored bool
+
+	cmd := &cobra.Command{
+		Use:   "recheck",
+		Short: "🔁 Recheck torrent data",
+		Long: `🔁 Force qBittorrent to recheck the downloaded data of torrents
+
+Rechecking verifies the files on disk against the torrent's pieces. It is the
+first thing to try when a torrent is errored or reports missing files, e.g.
+after moving files or remounting a disk.
+
+Examples:
+  akira recheck --hash abc123...                   # Recheck one torrent
+  akira recheck --hash abc123... --hash def456...  # Recheck several torrents
+  akira recheck --errored                          # Recheck every errored torrent`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRecheckCommand(ctx, cmd.OutOrStdout(), torrentService, hashes, errored)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&hashes, "hash", nil, "torrent hash to recheck (repeatable)")
+	cmd.Flags().BoolVar(&errored, "errored", false, "recheck all errored torrents and torrents with missing files")
+
+	return cmd
+}
+
+// runRecheckCommand implements the recheck command functionality
+func runRecheckCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService, hashes []string, errored bool) error {
+	if len(hashes) == 0 && !errored {
+		return validationError(fmt.Errorf("must specify --hash or --errored"))
+	}
+	if len(hashes) > 0 && errored {
+		return validationError(fmt.Errorf("can only specify one of: --hash or --errored"))
+	}
+
+	var torrents []qbittorrent.Torrent
+	if errored {
+		found, err := torrentService.GetErroredTorrents(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get errored torrents: %w", err)
+		}
+		if len(found) == 0 {
+			fmt.Fprintln(out, "✨ No errored torrents to recheck")
+			return nil
+		}
+		torrents = found
+	} else {
+		for _, hash := range hashes {
+			torrent, err := torrentService.FindTorrentByHash(ctx, hash)
+			if err != nil {
+				return fmt.Errorf("failed to find torrent: %w", err)
+			}
+			torrents = append(torrents, *torrent)
+		}
+	}
+
+	torrentHashes := make([]string, len(torrents))
+	for i, torrent := range torrents {
+		torrentHashes[i] = torrent.Hash
+	}
+
+	if err := torrentService.RecheckTorrents(ctx, torrentHashes); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "🔁 %s\n", cli.ColorSeeding.Sprintf("Recheck started for %d torrent(s)", len(torrents)))
+	for _, torrent := range torrents {
+		fmt.Fprintf(out, "   • %s\n", cli.TruncateString(torrent.Name, 60))
+	}
+	fmt.Fprintf(out, "\n💡 Run 'akira list' or open the TUI to follow the recheck progress\n")
+
+	return nil
+}
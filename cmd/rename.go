@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/core"
+)
+
+// NewRenameCommand creates the rename command
+func NewRenameCommand(ctx context.Context, torrentService *core.TorrentService) *cobra.Command {
+	var filePath string
+	var folderPath string
+
+	cmd := &cobra.Command{
+		Use:   "rename <hash> <new-name>",
+		Short: "✏️  Rename a torrent or its files",
+		Long: `✏️  Rename a torrent, or a file or folder inside it
+
+Without flags the torrent's display name is changed; files on disk keep their
+names. With --file or --folder the given path (as shown by 'akira files') is
+renamed on disk instead. A new name without a slash keeps the file or folder
+in its current directory.
+
+Examples:
+  akira rename abc123... "Big Buck Bunny (2008)"                          # Rename the torrent
+  akira rename abc123... movie.mkv --file "Some.Release.1080p/x.mkv"      # Rename a file
+  akira rename abc123... Extras --folder "Some.Release.1080p/Featurettes" # Rename a folder`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRenameCommand(ctx, cmd.OutOrStdout(), torrentService, args[0], args[1], filePath, folderPath)
+		},
+	}
+
+	cmd.Flags().StringVar(&filePath, "file", "", "rename this file inside the torrent instead of the torrent")
+	cmd.Flags().StringVar(&folderPath, "folder", "", "rename this folder inside the torrent instead of the torrent")
+
+	return cmd
+}
+
+// runRenameCommand implements the rename command functionality
+func runRenameCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService,
+	hash, newName, filePath, folderPath string) error {
+
+	if filePath != "" && folderPath != "" {
+		return validationError(fmt.Errorf("can only specify one of: --file or --folder"))
+	}
+	if strings.TrimSpace(newName) == "" {
+		return validationError(fmt.Errorf("new name cannot be empty"))
+	}
+
+	torrent, err := torrentService.FindTorrentByHash(ctx, hash)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case filePath != "":
+		newPath := renameTarget(filePath, newName)
+		if err := torrentService.RenameFile(ctx, torrent.Hash, filePath, newPath); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "✏️  %s\n", cli.ColorSeeding.Sprint("File renamed"))
+		fmt.Fprintf(out, "   %s → %s\n", filePath, newPath)
+	case folderPath != "":
+		newPath := renameTarget(strings.TrimSuffix(folderPath, "/"), newName)
+		if err := torrentService.RenameFolder(ctx, torrent.Hash, folderPath, newPath); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "✏️  %s\n", cli.ColorSeeding.Sprint("Folder renamed"))
+		fmt.Fprintf(out, "   %s → %s\n", folderPath, newPath)
+	default:
+		if err := torrentService.RenameTorrent(ctx, torrent.Hash, newName); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "✏️  %s\n", cli.ColorSeeding.Sprint("Torrent renamed"))
+		fmt.Fprintf(out, "   %s → %s\n", torrent.Name, strings.TrimSpace(newName))
+	}
+
+	return nil
+}
+
+// renameTarget returns the new path for oldPath. A bare name stays in oldPath's directory.
+func renameTarget(oldPath, newName string) string {
+	newName = strings.TrimSpace(newName)
+	if strings.Contains(newName, "/") {
+		return newName
+	}
+	return path.Join(path.Dir(oldPath), newName)
+}
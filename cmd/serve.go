@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/config"
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/server"
+)
+
+// NewServeCommand creates the serve command that runs the HTTP server
+func NewServeCommand(ctx context.Context, cfg *config.Config, torrentService *core.TorrentService,
+	diskService *core.DiskService, seedingService *core.SeedingService) *cobra.Command {
+	var addr string
+	var interval time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "📡 Serve live updates and a JSON API over HTTP",
+		Long: `📡 Serve live updates and a JSON API over HTTP
+
+Runs an HTTP server for external dashboards and custom frontends. Endpoints:
+- GET /events - Server-Sent Events stream of torrent and disk changes
+- GET /torrents - List torrents (?category=, ?tag=, ?state=, ?search=)
+- POST /torrents - Add a magnet: {"magnet_uri": "...", "category": "...", "paused": false}
+- DELETE /torrents/{hash} - Delete a torrent (?delete_files=true also deletes its files)
+- GET /disk - Disk space of the configured paths
+- GET /seeding - Seeding status of tracked torrents
+
+When SERVER_AUTH_TOKEN is set, every request must send it in an
+"Authorization: Bearer <token>" header.
+
+Every event is a JSON object with "type", "time" and "payload" fields. Types:
+- torrent_added, torrent_removed, torrent_state_changed, torrent_completed
+- seeding_stopped, torrent_auto_deleted
+- disk_health_changed
+
+Examples:
+  akira serve                                # Listen on SERVER_ADDR (default 127.0.0.1:8090)
+  akira serve --addr :9000 --interval 2s     # Custom address and poll interval
+  curl -N http://127.0.0.1:8090/events       # Watch the event stream
+  curl http://127.0.0.1:8090/torrents        # List torrents as JSON`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runServeCommand(ctx, cmd.OutOrStdout(), torrentService, diskService, seedingService, addr, cfg.Server.AuthToken, interval)
+		},
+	}
+
+	cmd.Flags().StringVar(&addr, "addr", cfg.Server.Addr, "address to listen on")
+	cmd.Flags().DurationVar(&interval, "interval", cfg.Server.EventInterval, "how often to check torrents and disks for changes")
+
+	return cmd
+}
+
+// runServeCommand implements the serve command functionality
+func runServeCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService, diskService *core.DiskService,
+	seedingService *core.SeedingService, addr, authToken string, interval time.Duration) error {
+
+	if interval <= 0 {
+		return validationError(fmt.Errorf("--interval must be greater than 0"))
+	}
+
+	serveCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Wire services to the event bus
+	bus := core.NewEventBus()
+	seedingService.SetEventBus(bus)
+	defer seedingService.SetEventBus(nil)
+
+	monitor := core.NewEventMonitor(bus, torrentService, diskService, interval)
+	go monitor.Run(serveCtx)
+
+	fmt.Fprintf(out, "📡 %s\n", cli.ColorHeader.Sprint("Akira HTTP server"))
+	fmt.Fprintf(out, "   Events: http://%s/events\n", addr)
+	fmt.Fprintf(out, "   API: http://%s/torrents, /disk, /seeding\n", addr)
+	if authToken != "" {
+		fmt.Fprintf(out, "   Auth: bearer token required\n")
+	} else {
+		fmt.Fprintf(out, "   Auth: %s\n", cli.ColorPaused.Sprint("none (set SERVER_AUTH_TOKEN to require a token)"))
+	}
+	fmt.Fprintf(out, "   Poll Interval: %s\n\n", interval)
+	fmt.Fprintf(out, "💡 Press Ctrl+C to stop\n")
+
+	if err := server.NewServer(addr, authToken, bus, torrentService, diskService, seedingService).Run(serveCtx); err != nil {
+		return err
+	}
+	MarkSessionRan()
+	return nil
+}
@@ -0,0 +1,18 @@
+package cmd
+
+import "sync/atomic"
+
+// sessionRan is set once the TUI, the daemon or the API server has actually
+// run, as opposed to a one-off command, --version or a mistyped command
+var sessionRan atomic.Bool
+
+// MarkSessionRan records that a long-running session ran in this process
+func MarkSessionRan() {
+	sessionRan.Store(true)
+}
+
+// SessionRan reports whether a long-running session ran in this process, so
+// that SEEDING_STOP_ON_EXIT only applies after one
+func SessionRan() bool {
+	return sessionRan.Load()
+}
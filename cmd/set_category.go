@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// NewSetCategoryCommand creates the set-category command
+func NewSetCategoryCommand(ctx context.Context, torrentService *core.TorrentService) *cobra.Command {
+	var hash string
+	var namePattern string
+	var category string
+
+	cmd := &cobra.Command{
+		Use:   "set-category",
+		Short: "🏷️  Change the category of torrents",
+		Long: `🏷️  Change the category of torrents after they were added
+
+Select torrents by hash or by name pattern (a case-insensitive regex, like the
+delete command). The category is created in qBittorrent first if it doesn't
+exist there yet.
+
+Examples:
+  akira set-category --hash abc123... --category movies    # Move one torrent
+  akira set-category --name "S01E" --category series       # Move all matching torrents`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSetCategoryCommand(ctx, cmd.OutOrStdout(), torrentService, hash, namePattern, category)
+		},
+	}
+
+	cmd.Flags().StringVar(&hash, "hash", "", "specific torrent hash")
+	cmd.Flags().StringVar(&namePattern, "name", "", "torrents matching name pattern")
+	cmd.Flags().StringVar(&category, "category", "", "new category ("+categoryList(torrentService)+")")
+	cmd.MarkFlagRequired("category")
+
+	return cmd
+}
+
+// runSetCategoryCommand implements the set-category command functionality
+func runSetCategoryCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService,
+	hash, namePattern, category string) error {
+
+	if hash == "" && namePattern == "" {
+		return validationError(fmt.Errorf("must specify one of: --hash or --name"))
+	}
+	if hash != "" && namePattern != "" {
+		return validationError(fmt.Errorf("can only specify one of: --hash or --name"))
+	}
+
+	var torrents []qbittorrent.Torrent
+	if hash != "" {
+		torrent, err := torrentService.FindTorrentByHash(ctx, hash)
+		if err != nil {
+			return fmt.Errorf("failed to find torrent: %w", err)
+		}
+		torrents = []qbittorrent.Torrent{*torrent}
+	} else {
+		matches, err := torrentService.FindTorrentsByPattern(ctx, namePattern)
+		if err != nil {
+			return fmt.Errorf("failed to search torrents: %w", err)
+		}
+		if len(matches) == 0 {
+			return fmt.Errorf("no torrents found matching pattern '%s'", namePattern)
+		}
+		torrents = matches
+	}
+
+	hashes := make([]string, len(torrents))
+	for i, torrent := range torrents {
+		hashes[i] = torrent.Hash
+	}
+
+	if err := torrentService.SetCategory(ctx, hashes, category); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "✅ %s\n", cli.ColorSeeding.Sprintf("Moved %d torrent(s) to category '%s'", len(torrents), strings.ToLower(category)))
+	for _, torrent := range torrents {
+		from := torrent.Category
+		if from == "" {
+			from = "none"
+		}
+		fmt.Fprintf(out, "   • %s (was: %s)\n", cli.TruncateString(torrent.Name, 60), from)
+	}
+
+	return nil
+}
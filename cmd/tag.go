@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// NewTagCommand creates the tag command with its subcommands
+func NewTagCommand(ctx context.Context, torrentService *core.TorrentService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tag",
+		Short: "🔖 Manage torrent tags",
+		Long: `🔖 Manage torrent tags
+
+Tags are free-form labels stored in qBittorrent. Unlike categories, a torrent
+can have any number of them. Use 'akira list --tag <tag>' to filter by tag.
+
+Examples:
+  akira tag add --hash abc123... --tags foo,bar     # Tag a torrent
+  akira tag remove --hash abc123... --tags foo      # Remove a tag from a torrent
+  akira tag list                                    # Show all tags`,
+	}
+
+	cmd.AddCommand(
+		newTagEditCommand(ctx, torrentService, true),
+		newTagEditCommand(ctx, torrentService, false),
+		newTagListCommand(ctx, torrentService),
+	)
+
+	return cmd
+}
+
+// newTagEditCommand creates the tag add or tag remove subcommand
+func newTagEditCommand(ctx context.Context, torrentService *core.TorrentService, add bool) *cobra.Command {
+	var hashes []string
+	var tags string
+
+	use, short, example := "remove", "➖ Remove tags from torrents", "akira tag remove --hash abc123... --tags foo"
+	if add {
+		use, short, example = "add", "➕ Add tags to torrents", "akira tag add --hash abc123... --tags foo,bar"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long: short + `
+
+Tags are given as a comma-separated list. Adding a tag that doesn't exist yet
+creates it; removing a tag from torrents keeps it available in qBittorrent.
+
+Examples:
+  ` + example,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTagEditCommand(ctx, cmd.OutOrStdout(), torrentService, hashes, qbittorrent.SplitTags(tags), add)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&hashes, "hash", nil, "torrent hash (repeatable)")
+	cmd.Flags().StringVar(&tags, "tags", "", "comma-separated tags, e.g. foo,bar")
+	cmd.MarkFlagRequired("hash")
+	cmd.MarkFlagRequired("tags")
+
+	return cmd
+}
+
+// newTagListCommand creates the tag list subcommand
+func newTagListCommand(ctx context.Context, torrentService *core.TorrentService) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "📋 List all tags",
+		Long: `📋 List all tags defined in qBittorrent
+
+Examples:
+  akira tag list`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTagListCommand(ctx, cmd.OutOrStdout(), torrentService)
+		},
+	}
+
+	return cmd
+}
+
+// runTagEditCommand implements the tag add and tag remove subcommands
+func runTagEditCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService,
+	hashes []string, tags []string, add bool) error {
+
+	if len(tags) == 0 {
+		return validationError(fmt.Errorf("--tags must contain at least one tag"))
+	}
+
+	var torrents []qbittorrent.Torrent
+	for _, hash := range hashes {
+		torrent, err := torrentService.FindTorrentByHash(ctx, hash)
+		if err != nil {
+			return fmt.Errorf("failed to find torrent: %w", err)
+		}
+		torrents = append(torrents, *torrent)
+	}
+
+	torrentHashes := make([]string, len(torrents))
+	for i, torrent := range torrents {
+		torrentHashes[i] = torrent.Hash
+	}
+
+	if add {
+		if err := torrentService.AddTags(ctx, torrentHashes, tags); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "🔖 %s\n", cli.ColorSeeding.Sprintf("Added %d tag(s) to %d torrent(s)", len(tags), len(torrents)))
+	} else {
+		if err := torrentService.RemoveTags(ctx, torrentHashes, tags); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "🔖 %s\n", cli.ColorSeeding.Sprintf("Removed %d tag(s) from %d torrent(s)", len(tags), len(torrents)))
+	}
+
+	for _, torrent := range torrents {
+		fmt.Fprintf(out, "   • %s\n", cli.TruncateString(torrent.Name, 60))
+	}
+
+	return nil
+}
+
+// runTagListCommand implements the tag list subcommand
+func runTagListCommand(ctx context.Context, out io.Writer, torrentService *core.TorrentService) error {
+	tags, err := torrentService.GetAllTags(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(tags) == 0 {
+		fmt.Fprintln(out, "✨ No tags defined")
+		return nil
+	}
+
+	fmt.Fprintf(out, "🔖 %s\n", cli.ColorHeader.Sprintf("%d tag(s)", len(tags)))
+	for _, tag := range tags {
+		fmt.Fprintf(out, "   • %s\n", tag)
+	}
+
+	return nil
+}
@@ -97,6 +97,9 @@ func (b *Bot) handleInteractionCreate(s *discordgo.Session, i *discordgo.Interac
 	case discordgo.InteractionMessageComponent:
 		// Handle button clicks and select menu selections
 		b.handleComponentInteraction(s, i)
+	case discordgo.InteractionModalSubmit:
+		// Handle submitted modals
+		b.handleModalSubmit(s, i)
 	default:
 		// Ignore other interaction types
 		return
@@ -109,6 +112,8 @@ func (b *Bot) handleSlashCommand(s *discordgo.Session, i *discordgo.InteractionC
 	switch i.ApplicationCommandData().Name {
 	case "torrents":
 		commands.HandleTorrentsCommand(s, i, b.torrentService)
+	case "list":
+		commands.HandleListCommand(s, i, b.torrentService)
 	case "add":
 		commands.HandleAddCommand(s, i, b.torrentService, b.seedingService, b.config)
 	case "delete":
@@ -119,12 +124,12 @@ func (b *Bot) handleSlashCommand(s *discordgo.Session, i *discordgo.InteractionC
 		commands.HandleDiskCommand(s, i, b.diskService)
 	case "logs":
 		commands.HandleLogsCommand(s, i)
-	case "seeding-status":
-		commands.HandleSeedingStatusCommand(s, i, b.seedingService)
+	case "seeding":
+		commands.HandleSeedingCommand(s, i, b.seedingService)
 	case "stop-seeding":
 		commands.HandleStopSeedingCommand(s, i, b.seedingService)
 	case "help":
-		commands.HandleHelpCommand(s, i)
+		commands.HandleHelpCommand(s, i, b.config)
 	default:
 		b.handleUnknownCommand(s, i)
 	}
@@ -138,15 +143,15 @@ func (b *Bot) handleComponentInteraction(s *discordgo.Session, i *discordgo.Inte
 	case "delete_category_select":
 		commands.HandleDeleteCategorySelect(s, i, b.torrentService, b.seedingService)
 	case "delete_torrent_select":
-		commands.HandleDeleteTorrentSelect(s, i, b.torrentService, b.seedingService)
-	case "delete_confirm":
-		commands.HandleDeleteConfirm(s, i, b.torrentService, b.seedingService)
-	case "delete_cancel":
-		commands.HandleDeleteCancel(s, i, b.torrentService, b.seedingService)
+		commands.HandleDeleteTorrentSelect(s, i, b.torrentService, b.seedingService, b.config)
 	default:
 		// Handle other component interactions if needed
 		if strings.HasPrefix(data.CustomID, "delete_confirm|") {
 			commands.HandleDeleteConfirm(s, i, b.torrentService, b.seedingService)
+		} else if strings.HasPrefix(data.CustomID, "delete_cancel|") {
+			commands.HandleDeleteCancel(s, i, b.torrentService, b.seedingService)
+		} else if strings.HasPrefix(data.CustomID, "page_") {
+			commands.HandlePageButton(s, i, b.torrentService)
 		} else {
 			b.logger.Warn("Unknown component interaction", map[string]interface{}{
 				"custom_id": data.CustomID,
@@ -155,6 +160,29 @@ func (b *Bot) handleComponentInteraction(s *discordgo.Session, i *discordgo.Inte
 	}
 }
 
+// handleModalSubmit handles submitted modals
+func (b *Bot) handleModalSubmit(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	customID := i.ModalSubmitData().CustomID
+
+	switch {
+	case commands.IsAddModal(customID):
+		commands.HandleAddModalSubmit(s, i, b.torrentService, b.seedingService, b.config)
+	default:
+		b.logger.WithField("custom_id", customID).Warn("Unknown modal submission")
+	}
+}
+
+// categoryChoices builds the /add category choices from the configured categories
+func (b *Bot) categoryChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := []*discordgo.ApplicationCommandOptionChoice{
+		{Name: "Default", Value: "default"},
+	}
+	for _, category := range b.config.Categories() {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: category.Label, Value: category.Name})
+	}
+	return choices
+}
+
 // RegisterCommands registers slash commands with Discord
 func (b *Bot) RegisterCommands() error {
 	commands := []*discordgo.ApplicationCommand{
@@ -182,6 +210,25 @@ func (b *Bot) RegisterCommands() error {
 				},
 			},
 		},
+		{
+			Name:        "list",
+			Description: "List torrents by name, 10 per page",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        "category",
+					Description: "Only list torrents in this category",
+					Required:    false,
+					Choices:     b.categoryChoices(),
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionInteger,
+					Name:        "page",
+					Description: "Page number (default: 1)",
+					Required:    false,
+				},
+			},
+		},
 		{
 			Name:        "add",
 			Description: "Add a magnet link or torrent file",
@@ -189,20 +236,15 @@ func (b *Bot) RegisterCommands() error {
 				{
 					Type:        discordgo.ApplicationCommandOptionString,
 					Name:        "magnet",
-					Description: "Magnet link to add",
-					Required:    true,
+					Description: "Magnet link to add (leave empty to paste it into a form)",
+					Required:    false,
 				},
 				{
 					Type:        discordgo.ApplicationCommandOptionString,
 					Name:        "category",
 					Description: "Category for the torrent",
 					Required:    false,
-					Choices: []*discordgo.ApplicationCommandOptionChoice{
-						{Name: "Default", Value: "default"},
-						{Name: "Movies", Value: "movies"},
-						{Name: "Series", Value: "series"},
-						{Name: "Anime", Value: "anime"},
-					},
+					Choices:     b.categoryChoices(),
 				},
 			},
 		},
@@ -240,7 +282,7 @@ func (b *Bot) RegisterCommands() error {
 			},
 		},
 		{
-			Name:        "seeding-status",
+			Name:        "seeding",
 			Description: "Show seeding status and statistics",
 		},
 		{
@@ -322,6 +364,22 @@ func (b *Bot) Start() error {
 	return nil
 }
 
+// StartNotifications posts torrent completions and critical disk alerts from
+// alerter to the configured notification channel until the bot is stopped.
+// It does nothing when no DISCORD_NOTIFICATION_CHANNEL_ID is configured.
+func (b *Bot) StartNotifications(alerter *core.DiskAlerter) {
+	channelID := b.config.Discord.NotificationChannelID
+	if channelID == "" {
+		return
+	}
+
+	notifier := NewNotifier(b.session, channelID)
+	b.seedingService.OnTorrentCompleted(notifier.NotifyCompletion)
+	alerter.OnAlert(notifier.NotifyDiskAlert)
+
+	go notifier.Run(b.ctx)
+}
+
 // Stop gracefully stops the Discord bot
 func (b *Bot) Stop() error {
 	b.logger.Info("Stopping Discord bot")
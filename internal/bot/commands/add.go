@@ -2,17 +2,24 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/raainshe/akira/internal/cli"
 	"github.com/raainshe/akira/internal/config"
 	"github.com/raainshe/akira/internal/core"
 	"github.com/raainshe/akira/internal/qbittorrent"
 )
 
-// HandleAddCommand handles the /add Discord command
+// addModalPrefix starts the custom ID of the /add modal; the chosen category follows it
+const addModalPrefix = "add_modal|"
+
+// HandleAddCommand handles the /add Discord command. With a magnet option the
+// torrent is added right away; otherwise a modal asks for the magnet URI, with
+// the category chosen in the command's category option.
 func HandleAddCommand(s *discordgo.Session, i *discordgo.InteractionCreate, torrentService *core.TorrentService, seedingService *core.SeedingService, config *config.Config) {
 	// Get command options
 	data := i.ApplicationCommandData()
@@ -24,20 +31,94 @@ func HandleAddCommand(s *discordgo.Session, i *discordgo.InteractionCreate, torr
 	for _, option := range data.Options {
 		switch option.Name {
 		case "magnet":
-			magnetURI = option.StringValue()
+			magnetURI = strings.TrimSpace(option.StringValue())
 		case "category":
 			category = option.StringValue()
 		}
 	}
 
-	// Validate magnet URI
 	if magnetURI == "" {
-		respondWithError(s, i, "Magnet URI is required")
+		showAddModal(s, i, category)
 		return
 	}
 
-	if !isValidMagnetURI(magnetURI) {
-		respondWithError(s, i, "Invalid magnet URI format")
+	if err := cli.ValidateMagnetURI(magnetURI); err != nil {
+		respondWithError(s, i, err.Error())
+		return
+	}
+
+	addMagnet(s, i, torrentService, seedingService, config, magnetURI, category)
+}
+
+// showAddModal opens the modal asking for the magnet URI
+func showAddModal(s *discordgo.Session, i *discordgo.InteractionCreate, category string) {
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseModal,
+		Data: &discordgo.InteractionResponseData{
+			CustomID: addModalPrefix + category,
+			Title:    truncateString("📥 Add Torrent ("+category+")", 45),
+			Components: []discordgo.MessageComponent{
+				discordgo.ActionsRow{
+					Components: []discordgo.MessageComponent{
+						discordgo.TextInput{
+							CustomID:    "magnet",
+							Label:       "Magnet URI",
+							Style:       discordgo.TextInputParagraph,
+							Placeholder: "magnet:?xt=urn:btih:...",
+							Required:    true,
+							MaxLength:   4000,
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		fmt.Printf("Failed to open add modal: %v\n", err)
+	}
+}
+
+// IsAddModal reports whether a modal submission comes from the /add modal
+func IsAddModal(customID string) bool {
+	return strings.HasPrefix(customID, addModalPrefix)
+}
+
+// HandleAddModalSubmit adds the magnet entered in the /add modal. Invalid
+// magnets are reported in an ephemeral message so the modal can be retried.
+func HandleAddModalSubmit(s *discordgo.Session, i *discordgo.InteractionCreate, torrentService *core.TorrentService, seedingService *core.SeedingService, config *config.Config) {
+	data := i.ModalSubmitData()
+	category := strings.TrimPrefix(data.CustomID, addModalPrefix)
+
+	var magnetURI string
+	for _, row := range data.Components {
+		actionsRow, ok := row.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+		for _, component := range actionsRow.Components {
+			if input, ok := component.(*discordgo.TextInput); ok && input.CustomID == "magnet" {
+				magnetURI = strings.TrimSpace(input.Value)
+			}
+		}
+	}
+
+	if err := cli.ValidateMagnetURI(magnetURI); err != nil {
+		respondWithError(s, i, err.Error())
+		return
+	}
+
+	addMagnet(s, i, torrentService, seedingService, config, magnetURI, category)
+}
+
+// addMagnet adds a validated magnet, starts seeding tracking and shows its
+// live progress. The response is deferred first because adding waits for
+// qBittorrent to list the torrent, which can exceed Discord's 3 second limit.
+func addMagnet(s *discordgo.Session, i *discordgo.InteractionCreate, torrentService *core.TorrentService, seedingService *core.SeedingService, config *config.Config, magnetURI, category string) {
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		fmt.Printf("Failed to defer add response: %v\n", err)
 		return
 	}
 
@@ -52,10 +133,18 @@ func HandleAddCommand(s *discordgo.Session, i *discordgo.InteractionCreate, torr
 	torrent, err := torrentService.AddMagnet(ctx, request)
 	if err != nil {
 		// Check if it's a qBittorrent API error
-		if apiErr, ok := err.(*qbittorrent.APIError); ok {
-			respondWithError(s, i, fmt.Sprintf("qBittorrent Error: %s", apiErr.Details))
-		} else {
-			respondWithError(s, i, fmt.Sprintf("Failed to add torrent: %v", err))
+		var apiErr *qbittorrent.APIError
+		var existsErr *core.TorrentExistsError
+		switch {
+		case errors.Is(err, core.ErrInvalidMagnet), errors.Is(err, core.ErrInvalidCategory):
+			followUpWithError(s, i, err.Error())
+		case errors.As(err, &existsErr):
+			editWithEmbed(s, i, createWarningEmbed("⚠️ Torrent Already Added",
+				fmt.Sprintf("**%s** (%s)", existsErr.Name, existsErr.State)))
+		case errors.As(err, &apiErr):
+			editWithEmbed(s, i, createErrorEmbed("❌ Failed to Add Torrent", fmt.Sprintf("qBittorrent Error: %s", apiErr.Details)))
+		default:
+			editWithEmbed(s, i, createErrorEmbed("❌ Failed to Add Torrent", err.Error()))
 		}
 		return
 	}
@@ -65,6 +154,9 @@ func HandleAddCommand(s *discordgo.Session, i *discordgo.InteractionCreate, torr
 	if torrent != nil {
 		// We have torrent information - show initial progress
 		content = formatTorrentProgress(torrent, 0, 0) // 0 elapsed, 0 remaining for initial
+		if err := seedingService.StartTracking(ctx, torrent.Hash, torrent.Name); err != nil {
+			content += fmt.Sprintf("\n\n⚠️ Failed to start seeding tracking: %v", err)
+		}
 	} else {
 		// No torrent info available - show basic confirmation
 		content = fmt.Sprintf("✅ **Torrent Added Successfully!**\n\n"+
@@ -76,20 +168,7 @@ func HandleAddCommand(s *discordgo.Session, i *discordgo.InteractionCreate, torr
 			category)
 	}
 
-	embed := createSuccessEmbed("📥 Torrent Added", content)
-
-	// Send initial response
-	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseChannelMessageWithSource,
-		Data: &discordgo.InteractionResponseData{
-			Embeds: []*discordgo.MessageEmbed{embed},
-		},
-	})
-
-	if err != nil {
-		fmt.Printf("Failed to send add response: %v\n", err)
-		return
-	}
+	editWithEmbed(s, i, createSuccessEmbed("📥 Torrent Added", content))
 
 	// If we have torrent info, start automatic progress tracking
 	if torrent != nil {
@@ -100,9 +179,29 @@ func HandleAddCommand(s *discordgo.Session, i *discordgo.InteractionCreate, torr
 	}
 }
 
-// isValidMagnetURI checks if a string is a valid magnet URI
-func isValidMagnetURI(uri string) bool {
-	return len(uri) > 20 && uri[:20] == "magnet:?xt=urn:btih:"
+// editWithEmbed replaces a deferred response with an embed
+func editWithEmbed(s *discordgo.Session, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed) {
+	_, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
+	})
+	if err != nil {
+		fmt.Printf("Failed to update add response: %v\n", err)
+	}
+}
+
+// followUpWithError removes a deferred response and reports the error in an
+// ephemeral follow-up that only the user sees
+func followUpWithError(s *discordgo.Session, i *discordgo.InteractionCreate, message string) {
+	if err := s.InteractionResponseDelete(i.Interaction); err != nil {
+		fmt.Printf("Failed to delete deferred response: %v\n", err)
+	}
+	_, err := s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+		Content: "❌ " + message,
+		Flags:   discordgo.MessageFlagsEphemeral,
+	})
+	if err != nil {
+		fmt.Printf("Failed to send error follow-up: %v\n", err)
+	}
 }
 
 // trackTorrentProgress automatically tracks torrent progress until completion
@@ -142,6 +241,10 @@ func trackTorrentProgress(s *discordgo.Session, i *discordgo.InteractionCreate,
 
 			// Get updated torrent info
 			torrent, err := torrentService.FindTorrentByHash(ctx, hash)
+			if err != nil && !errors.Is(err, core.ErrTorrentNotFound) {
+				// qBittorrent is unreachable for now, try again on the next tick
+				continue
+			}
 			if err != nil {
 				// Torrent might have been deleted
 				finalContent := fmt.Sprintf("❌ **Torrent not found**\n\n"+
@@ -190,7 +293,7 @@ func trackTorrentProgress(s *discordgo.Session, i *discordgo.InteractionCreate,
 					}
 
 					// Calculate seeding duration based on download time and multiplier
-					seedingDuration := time.Duration(float64(downloadDuration) * config.Seeding.TimeMultiplier)
+					seedingDuration := time.Duration(float64(downloadDuration) * seedingService.GetTimeMultiplier(*torrent))
 
 					// Update message to show seeding management info
 					content := formatTorrentProgress(torrent, elapsed, 0)
@@ -200,7 +303,7 @@ func trackTorrentProgress(s *discordgo.Session, i *discordgo.InteractionCreate,
 						"**Auto-stop Time:** %s",
 						formatDuration(downloadDuration),
 						formatDuration(seedingDuration),
-						time.Now().Add(seedingDuration).Format("2006-01-02 15:04:05"))
+						config.UI.FormatTime(time.Now().Add(seedingDuration)))
 
 					embed := createSuccessEmbed("🌱 Seeding Management Active", content)
 					s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
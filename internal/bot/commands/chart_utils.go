@@ -84,6 +84,10 @@ func generateMultiDiskPieChart(diskSummary *core.DiskSummary) ([]byte, error) {
 		return nil, fmt.Errorf("no disk information available")
 	}
 
+	if !mainDiskInfo.HasCapacity() {
+		return nil, fmt.Errorf("no capacity reported for %s", mainPath)
+	}
+
 	// Calculate available space
 	available := mainDiskInfo.Total - mainDiskInfo.Used
 
@@ -2,10 +2,12 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/raainshe/akira/internal/config"
 	"github.com/raainshe/akira/internal/core"
 	"github.com/raainshe/akira/internal/qbittorrent"
 )
@@ -13,37 +15,34 @@ import (
 // HandleDeleteCommand handles the /delete Discord command
 func HandleDeleteCommand(s *discordgo.Session, i *discordgo.InteractionCreate, torrentService *core.TorrentService, seedingService *core.SeedingService) {
 	// Show category selection first
-	showCategorySelection(s, i)
+	showCategorySelection(s, i, torrentService.Categories())
 }
 
 // showCategorySelection shows the category selection menu
-func showCategorySelection(s *discordgo.Session, i *discordgo.InteractionCreate) {
+func showCategorySelection(s *discordgo.Session, i *discordgo.InteractionCreate, categories []config.CategoryOption) {
+	// Build menu options and the category overview from the configured categories
+	var options []discordgo.SelectMenuOption
+	var categoryLines []string
+	for _, category := range categories {
+		options = append(options, discordgo.SelectMenuOption{
+			Label:       category.DisplayName(),
+			Value:       category.Name,
+			Description: fmt.Sprintf("Delete torrents from the %s category", category.Name),
+		})
+		categoryLines = append(categoryLines, fmt.Sprintf("• %s **%s** - %s", category.Emoji, category.Label, category.Description))
+	}
+	options = append(options, discordgo.SelectMenuOption{
+		Label:       "🌐 All Categories",
+		Value:       "all",
+		Description: "Delete torrents from all categories",
+	})
+	categoryLines = append(categoryLines, "• 🌐 **All Categories** - All torrents")
+
 	// Create category selection menu
 	selectMenu := discordgo.SelectMenu{
 		CustomID:    "delete_category_select",
 		Placeholder: "Select a category to delete torrents from",
-		Options: []discordgo.SelectMenuOption{
-			{
-				Label:       "🎬 Movies",
-				Value:       "movies",
-				Description: "Delete torrents from the movies category",
-			},
-			{
-				Label:       "📺 Series",
-				Value:       "series",
-				Description: "Delete torrents from the series category",
-			},
-			{
-				Label:       "🌸 Anime",
-				Value:       "anime",
-				Description: "Delete torrents from the anime category",
-			},
-			{
-				Label:       "🌐 All Categories",
-				Value:       "all",
-				Description: "Delete torrents from all categories",
-			},
-		},
+		Options:     options,
 	}
 
 	// Create the action row
@@ -54,7 +53,9 @@ func showCategorySelection(s *discordgo.Session, i *discordgo.InteractionCreate)
 	// Create embed explaining the process
 	embed := createInfoEmbed(
 		"🗑️ Delete Torrents - Category Selection",
-		"First, select which category of torrents you want to delete from.\n\n**Available Categories:**\n• 🎬 **Movies** - Movie torrents\n• 📺 **Series** - TV series torrents\n• 🌸 **Anime** - Anime torrents\n• 🌐 **All Categories** - All torrents\n\nAfter selecting a category, you'll see a list of torrents to choose from.",
+		"First, select which category of torrents you want to delete from.\n\n**Available Categories:**\n"+
+			strings.Join(categoryLines, "\n")+
+			"\n\nAfter selecting a category, you'll see a list of torrents to choose from.",
 	)
 
 	// Send initial response with category selection
@@ -72,7 +73,7 @@ func showCategorySelection(s *discordgo.Session, i *discordgo.InteractionCreate)
 }
 
 // HandleDeleteTorrentSelect handles the torrent selection from the select menu
-func HandleDeleteTorrentSelect(s *discordgo.Session, i *discordgo.InteractionCreate, torrentService *core.TorrentService, seedingService *core.SeedingService) {
+func HandleDeleteTorrentSelect(s *discordgo.Session, i *discordgo.InteractionCreate, torrentService *core.TorrentService, seedingService *core.SeedingService, config *config.Config) {
 	// Parse the selected values
 	data := i.MessageComponentData()
 	if len(data.Values) == 0 {
@@ -113,7 +114,8 @@ func HandleDeleteTorrentSelect(s *discordgo.Session, i *discordgo.InteractionCre
 		for _, torrent := range allTorrents {
 			if torrent.Hash == hash {
 				selectedNames = append(selectedNames, torrent.Name)
-				content.WriteString(fmt.Sprintf("• **%s**\n", torrent.Name))
+				// Shorten names so 25 selected torrents fit in the embed
+				content.WriteString(fmt.Sprintf("• **%s**\n", truncateString(torrent.Name, 80)))
 				content.WriteString(fmt.Sprintf("  Size: %s | State: %s\n", formatBytes(int64(torrent.Size)), string(torrent.State)))
 				break
 			}
@@ -124,20 +126,28 @@ func HandleDeleteTorrentSelect(s *discordgo.Session, i *discordgo.InteractionCre
 	content.WriteString("• Permanently delete the torrent from qBittorrent\n")
 	content.WriteString("• Permanently delete all downloaded files\n")
 	content.WriteString("• Stop tracking in seeding service\n\n")
-	content.WriteString("**Are you sure you want to proceed?**")
+	content.WriteString("**Are you sure you want to proceed?**\n")
+	content.WriteString(fmt.Sprintf("*Only you can confirm, within %s.*", formatDuration(config.Discord.DeleteConfirmTimeout)))
+
+	// Keep the selection server-side; the buttons only carry its token, and
+	// only the user who selected the torrents can use them
+	token, err := pendingDeletes.add(interactionUserID(i), selectedHashes, config.Discord.DeleteConfirmTimeout)
+	if err != nil {
+		respondWithError(s, i, fmt.Sprintf("Failed to prepare deletion: %v", err))
+		return
+	}
 
 	// Create confirmation buttons
-	// Store only the hashes to stay within Discord's 100 character custom ID limit
 	confirmButton := discordgo.Button{
 		Label:    "✅ Yes, Delete Everything",
 		Style:    discordgo.DangerButton,
-		CustomID: fmt.Sprintf("delete_confirm|%s", strings.Join(selectedHashes, ",")),
+		CustomID: "delete_confirm|" + token,
 	}
 
 	cancelButton := discordgo.Button{
 		Label:    "❌ Cancel",
 		Style:    discordgo.SecondaryButton,
-		CustomID: "delete_cancel",
+		CustomID: "delete_cancel|" + token,
 	}
 
 	actionRow := discordgo.ActionsRow{
@@ -163,20 +173,12 @@ func HandleDeleteTorrentSelect(s *discordgo.Session, i *discordgo.InteractionCre
 
 // HandleDeleteConfirm handles the final confirmation to delete torrents
 func HandleDeleteConfirm(s *discordgo.Session, i *discordgo.InteractionCreate, torrentService *core.TorrentService, seedingService *core.SeedingService) {
-	// Parse the selected torrents from custom ID
-	data := i.MessageComponentData()
-	parts := strings.Split(data.CustomID, "|")
-	if len(parts) < 2 {
-		respondWithError(s, i, "Invalid confirmation data")
-		return
-	}
-
-	// Parse the comma-separated hashes
-	selectedHashes := strings.Split(parts[1], ",")
-	if len(selectedHashes) == 0 {
-		respondWithError(s, i, "No torrents selected for deletion")
+	// Look up the pending deletion from the token in the custom ID
+	deletion, ok := takePendingDelete(s, i)
+	if !ok {
 		return
 	}
+	selectedHashes := deletion.hashes
 
 	// Get torrent names for the success message
 	ctx := context.Background()
@@ -253,6 +255,10 @@ func HandleDeleteConfirm(s *discordgo.Session, i *discordgo.InteractionCreate, t
 
 // HandleDeleteCancel handles cancellation of the delete operation
 func HandleDeleteCancel(s *discordgo.Session, i *discordgo.InteractionCreate, torrentService *core.TorrentService, seedingService *core.SeedingService) {
+	if _, ok := takePendingDelete(s, i); !ok {
+		return
+	}
+
 	embed := createInfoEmbed("❌ Deletion Cancelled", "The torrent deletion operation has been cancelled. No torrents were deleted.")
 
 	// Respond to the component interaction with cancellation and remove components
@@ -281,7 +287,7 @@ func HandleDeleteCategorySelect(s *discordgo.Session, i *discordgo.InteractionCr
 	selectedCategory := data.Values[0]
 
 	// Validate category
-	validCategories := []string{"movies", "series", "anime", "all"}
+	validCategories := append(config.CategoryNames(torrentService.Categories()), "all")
 	isValid := false
 	for _, valid := range validCategories {
 		if selectedCategory == valid {
@@ -339,19 +345,13 @@ func HandleDeleteCategorySelect(s *discordgo.Session, i *discordgo.InteractionCr
 
 	for i, torrent := range torrentsToShow {
 		// Truncate name if too long for Discord
-		name := torrent.Name
-		if len(name) > 100 {
-			name = name[:97] + "..."
-		}
+		name := truncateString(torrent.Name, 100)
 
 		// Create a unique value that includes hash and index
 		value := fmt.Sprintf("%s|%d", torrent.Hash, i)
 
 		// Create description with size and state
-		description := fmt.Sprintf("%s | %s", formatBytes(int64(torrent.Size)), string(torrent.State))
-		if len(description) > 100 {
-			description = description[:97] + "..."
-		}
+		description := truncateString(fmt.Sprintf("%s | %s", formatBytes(int64(torrent.Size)), string(torrent.State)), 100)
 
 		options = append(options, discordgo.SelectMenuOption{
 			Label:       name,
@@ -400,3 +400,31 @@ func HandleDeleteCategorySelect(s *discordgo.Session, i *discordgo.InteractionCr
 		respondWithError(s, i, fmt.Sprintf("Failed to show torrents: %v", err))
 	}
 }
+
+// takePendingDelete removes and returns the pending deletion for the token in
+// the clicked button's custom ID. Clicks from other users get an ephemeral
+// error, and expired confirmations have their buttons removed.
+func takePendingDelete(s *discordgo.Session, i *discordgo.InteractionCreate) (*pendingDelete, bool) {
+	_, token, _ := strings.Cut(i.MessageComponentData().CustomID, "|")
+
+	deletion, err := pendingDeletes.take(token, interactionUserID(i))
+	switch {
+	case errors.Is(err, errDeleteNotOwner):
+		respondWithError(s, i, "Only the user who selected these torrents can confirm or cancel their deletion")
+		return nil, false
+	case err != nil:
+		embed := createInfoEmbed("⌛ Deletion Expired", "This confirmation has expired. No torrents were deleted; use `/delete` to start again.")
+		err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseUpdateMessage,
+			Data: &discordgo.InteractionResponseData{
+				Embeds:     []*discordgo.MessageEmbed{embed},
+				Components: []discordgo.MessageComponent{},
+			},
+		})
+		if err != nil {
+			fmt.Printf("Failed to send expired confirmation response: %v\n", err)
+		}
+		return nil, false
+	}
+	return deletion, true
+}
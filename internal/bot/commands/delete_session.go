@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	// errDeleteExpired means the confirmation timed out, was already used or cancelled
+	errDeleteExpired = errors.New("delete confirmation expired")
+
+	// errDeleteNotOwner means someone other than the initiating user clicked the button
+	errDeleteNotOwner = errors.New("delete confirmation belongs to another user")
+)
+
+// pendingDelete is a deletion waiting for confirmation
+type pendingDelete struct {
+	userID  string // User who selected the torrents
+	hashes  []string
+	expires time.Time
+}
+
+// pendingDeletes holds the deletions waiting for confirmation, keyed by the
+// token in the confirm and cancel button custom IDs
+var pendingDeletes = &deleteSessions{sessions: make(map[string]*pendingDelete)}
+
+// deleteSessions stores pending deletions in memory, so the buttons only carry
+// a short token and a confirmation can only be used once by its own user
+type deleteSessions struct {
+	mutex    sync.Mutex
+	sessions map[string]*pendingDelete
+}
+
+// add stores a deletion for userID that expires after timeout and returns its
+// token. Expired deletions that were never clicked are dropped at the same time.
+func (d *deleteSessions) add(userID string, hashes []string, timeout time.Duration) (string, error) {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate confirmation token: %w", err)
+	}
+	token := hex.EncodeToString(buf)
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.removeExpiredLocked(time.Now())
+	d.sessions[token] = &pendingDelete{
+		userID:  userID,
+		hashes:  hashes,
+		expires: time.Now().Add(timeout),
+	}
+	return token, nil
+}
+
+// take removes and returns the deletion for token if it belongs to userID.
+// A click from another user leaves the deletion in place.
+func (d *deleteSessions) take(token, userID string) (*pendingDelete, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	session, ok := d.sessions[token]
+	if !ok {
+		return nil, errDeleteExpired
+	}
+	if time.Now().After(session.expires) {
+		delete(d.sessions, token)
+		return nil, errDeleteExpired
+	}
+	if session.userID != userID {
+		return nil, errDeleteNotOwner
+	}
+
+	delete(d.sessions, token)
+	return session, nil
+}
+
+// removeExpiredLocked drops the deletions that expired before now. The caller
+// must hold the mutex.
+func (d *deleteSessions) removeExpiredLocked(now time.Time) {
+	for token, session := range d.sessions {
+		if now.After(session.expires) {
+			delete(d.sessions, token)
+		}
+	}
+}
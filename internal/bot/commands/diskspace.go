@@ -4,20 +4,30 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/raainshe/akira/internal/core"
 )
 
-// HandleDiskCommand handles the /disk Discord command
+// HandleDiskCommand handles the /disk Discord command. The response is
+// deferred first since checking every configured path can be slow.
 func HandleDiskCommand(s *discordgo.Session, i *discordgo.InteractionCreate, diskService *core.DiskService) {
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		fmt.Printf("Failed to defer disk response: %v\n", err)
+		return
+	}
+
 	ctx := context.Background()
 
 	// Get disk space for all configured paths
 	diskSummary, err := diskService.GetAllDiskSpaces(ctx)
 	if err != nil {
-		respondWithError(s, i, fmt.Sprintf("Failed to get disk information: %v", err))
+		editWithError(s, i, fmt.Sprintf("Failed to get disk information: %v", err))
 		return
 	}
 
@@ -36,8 +46,8 @@ func HandleDiskCommand(s *discordgo.Session, i *discordgo.InteractionCreate, dis
 	embed := createInfoEmbed("💾 Disk Usage", content)
 
 	// Prepare response data
-	responseData := &discordgo.InteractionResponseData{
-		Embeds: []*discordgo.MessageEmbed{embed},
+	responseData := &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
 	}
 
 	// Add chart image if available
@@ -51,12 +61,8 @@ func HandleDiskCommand(s *discordgo.Session, i *discordgo.InteractionCreate, dis
 		}
 	}
 
-	// Send response
-	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseChannelMessageWithSource,
-		Data: responseData,
-	})
-
+	// Replace the deferred response
+	_, err = s.InteractionResponseEdit(i.Interaction, responseData)
 	if err != nil {
 		fmt.Printf("Failed to send disk response: %v\n", err)
 	}
@@ -77,16 +83,16 @@ func formatDiskSummary(summary *core.DiskSummary) string {
 	builder.WriteString(fmt.Sprintf("Total Free: %s\n", formatBytes(summary.TotalFree)))
 	builder.WriteString(fmt.Sprintf("Worst Health: %s\n\n", getHealthEmoji(summary.WorstHealth)))
 
-	// Individual paths
+	// Individual paths, in a stable order
+	paths := make([]string, 0, len(summary.Paths))
+	for path := range summary.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
 	builder.WriteString("**Individual Paths:**\n")
-	for path, diskInfo := range summary.Paths {
-		usageBar := getUsageBar(diskInfo.UsedPercent)
-		builder.WriteString(fmt.Sprintf("**%s**\n", path))
-		builder.WriteString(fmt.Sprintf("%s\n", usageBar))
-		builder.WriteString(fmt.Sprintf("Used: %s / %s (%.1f%%)\n\n",
-			formatBytes(diskInfo.Used),
-			formatBytes(diskInfo.Total),
-			diskInfo.UsedPercent))
+	for _, path := range paths {
+		builder.WriteString(formatDiskUsage(summary.Paths[path]))
 	}
 
 	// Warnings if any
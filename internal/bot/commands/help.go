@@ -2,34 +2,39 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/raainshe/akira/internal/config"
 )
 
 // HandleHelpCommand handles the /help Discord command
-func HandleHelpCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
+func HandleHelpCommand(s *discordgo.Session, i *discordgo.InteractionCreate, cfg *config.Config) {
 	content := "**🤖 Akira Torrent Manager - Discord Bot Commands**\n\n" +
 		"**📋 Torrent Management:**\n" +
 		"• `/torrents [filter] [page]` - List torrents with filtering and pagination\n" +
-		"• `/add <magnet> [category]` - Add a magnet link with **automatic live progress tracking**\n" +
+		"• `/list [category] [page]` - List torrents by name with page buttons\n" +
+		"• `/add [magnet] [category]` - Add a magnet link with **automatic live progress tracking**; without a magnet a form opens to paste it\n" +
 		"• `/delete` - **Interactive torrent deletion** - Select from list, confirm deletion\n" +
 		"• `/progress <torrent> [duration]` - Show live progress for a specific torrent\n\n" +
 		"**💾 System Information:**\n" +
 		"• `/disk` - Show disk usage with **interactive pie chart visualization**\n" +
 		"• `/logs [level] [lines]` - Show recent application logs\n" +
-		"• `/seeding-status` - Show seeding service status and statistics\n\n" +
+		"• `/seeding` - Show seeding service status and statistics\n\n" +
 		"**🌱 Seeding Management:**\n" +
 		"• `/stop-seeding <torrent>` - Stop tracking a specific torrent for seeding\n\n" +
 		"**📖 Usage Examples:**\n" +
 		"• `/torrents filter:downloading` - Show only downloading torrents\n" +
+		"• `/list category:movies` - Page through the movies\n" +
 		"• `/add magnet:?xt=urn:btih:... category:movies` - Add movie torrent with live tracking\n" +
+		"• `/add category:movies` - Open a form to paste a long magnet link\n" +
 		"• `/delete` - Opens interactive selection menu for torrent deletion\n" +
 		"• `/progress \"My Movie\" duration:120` - Track progress for 2 minutes\n" +
 		"• `/logs level:error lines:20` - Show last 20 error logs\n\n" +
 		"**🔧 Filter Options:**\n" +
 		"• **torrents filter:** all, downloading, seeding, paused\n" +
 		"• **logs level:** all, error, warning, info, debug\n" +
-		"• **category:** default, movies, series, anime\n\n" +
+		"• **category:** default, " + strings.Join(config.CategoryNames(cfg.Categories()), ", ") + "\n\n" +
 		"**💡 Tips:**\n" +
 		"• Use partial names for torrent queries\n" +
 		"• Hash queries are case-insensitive\n" +
@@ -37,7 +42,7 @@ func HandleHelpCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
 		"• **Delete command now uses interactive selection** - no more manual typing!\n" +
 		"• **Disk command shows beautiful pie chart** with used/available space visualization\n" +
 		"• **Automatic seeding management** starts when torrents complete\n" +
-		"• **Seeding duration** = Download time × SEEDING_TIME_MULTIPLIER\n" +
+		"• **Seeding duration** = Download time × SEEDING_TIME_MULTIPLIER (or the category's SEEDING_CATEGORY_MULTIPLIERS entry)\n" +
 		"• Progress tracking updates every 5 seconds\n" +
 		"• Live tracking continues until completion or 30 minutes\n" +
 		"• Logs show newest entries first\n" +
@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+const (
+	// listPageSize is how many torrents /list shows per page
+	listPageSize = 10
+
+	// listFetchTimeout bounds fetching the torrents of a /list page
+	listFetchTimeout = 30 * time.Second
+)
+
+// HandleListCommand handles the /list Discord command. The response is
+// deferred first so that a slow qBittorrent doesn't exceed Discord's 3 second
+// limit for answering an interaction.
+func HandleListCommand(s *discordgo.Session, i *discordgo.InteractionCreate, torrentService *core.TorrentService) {
+	category := ""
+	page := 1
+	for _, option := range i.ApplicationCommandData().Options {
+		switch option.Name {
+		case "category":
+			category = strings.ToLower(option.StringValue())
+		case "page":
+			page = int(option.IntValue())
+		}
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		fmt.Printf("Failed to defer list response: %v\n", err)
+		return
+	}
+
+	editListPage(s, i, torrentService, category, page)
+}
+
+// HandlePageButton handles the page_N buttons of /list. The category is kept
+// in the custom ID as page_N|category. Each button click is a new interaction
+// with a fresh token, so paging keeps working after the 15 minutes the
+// original /list interaction token is valid for.
+func HandlePageButton(s *discordgo.Session, i *discordgo.InteractionCreate, torrentService *core.TorrentService) {
+	pageStr, category, _ := strings.Cut(strings.TrimPrefix(i.MessageComponentData().CustomID, "page_"), "|")
+	page, err := strconv.Atoi(pageStr)
+	if err != nil {
+		respondWithError(s, i, "Invalid page number")
+		return
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	})
+	if err != nil {
+		fmt.Printf("Failed to defer page update: %v\n", err)
+		return
+	}
+
+	editListPage(s, i, torrentService, category, page)
+}
+
+// editListPage fetches a page of torrents and shows it in the deferred response
+func editListPage(s *discordgo.Session, i *discordgo.InteractionCreate, torrentService *core.TorrentService, category string, page int) {
+	ctx, cancel := context.WithTimeout(context.Background(), listFetchTimeout)
+	defer cancel()
+
+	torrents, err := torrentService.GetTorrents(ctx, &core.TorrentFilter{Category: category})
+	if err != nil {
+		editWithError(s, i, fmt.Sprintf("Failed to get torrents: %v", err))
+		return
+	}
+
+	// Sort by name so pages stay stable between clicks
+	sort.Slice(torrents, func(a, b int) bool {
+		return strings.ToLower(torrents[a].Name) < strings.ToLower(torrents[b].Name)
+	})
+
+	totalPages := max((len(torrents)+listPageSize-1)/listPageSize, 1)
+	page = min(max(page, 1), totalPages)
+
+	start := (page - 1) * listPageSize
+	end := min(start+listPageSize, len(torrents))
+	var pageTorrents []qbittorrent.Torrent
+	if start < end {
+		pageTorrents = torrents[start:end]
+	}
+
+	title := fmt.Sprintf("📋 Torrent List (%d)", len(torrents))
+	if category != "" {
+		title = fmt.Sprintf("📋 Torrent List - %s (%d)", category, len(torrents))
+	}
+	embed := createInfoEmbed(title, formatTorrentList(pageTorrents, page, totalPages))
+	components := createPaginationComponents(page, totalPages, category)
+	if components == nil {
+		components = []discordgo.MessageComponent{}
+	}
+
+	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &[]*discordgo.MessageEmbed{embed},
+		Components: &components,
+	})
+	if err != nil {
+		fmt.Printf("Failed to update list response: %v\n", err)
+	}
+}
+
+// editWithError replaces a deferred response with an error message
+func editWithError(s *discordgo.Session, i *discordgo.InteractionCreate, message string) {
+	content := "❌ " + message
+	_, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content:    &content,
+		Embeds:     &[]*discordgo.MessageEmbed{},
+		Components: &[]discordgo.MessageComponent{},
+	})
+	if err != nil {
+		fmt.Printf("Failed to send error response: %v\n", err)
+	}
+}
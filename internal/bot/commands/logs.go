@@ -1,13 +1,13 @@
 package commands
 
 import (
-	"bufio"
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
 
 	"github.com/bwmarrin/discordgo"
+
+	"github.com/raainshe/akira/internal/logging"
 )
 
 // HandleLogsCommand handles the /logs Discord command
@@ -34,14 +34,14 @@ func HandleLogsCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	}
 
 	// Get logs
-	logLines, err := getRecentLogs(level, lines)
+	entries, err := getRecentLogs(level, lines)
 	if err != nil {
 		respondWithError(s, i, fmt.Sprintf("Failed to get logs: %v", err))
 		return
 	}
 
 	// Format response
-	content := formatLogs(logLines, level)
+	content := formatLogs(entries, level)
 
 	// Create embed
 	embed := createInfoEmbed("📋 Recent Logs", content)
@@ -59,57 +59,27 @@ func HandleLogsCommand(s *discordgo.Session, i *discordgo.InteractionCreate) {
 	}
 }
 
-// getRecentLogs reads recent log lines from bot_activity.log
-func getRecentLogs(level string, maxLines int) ([]string, error) {
+// getRecentLogs reads recent log entries from bot_activity.log, newest first
+func getRecentLogs(level string, maxLines int) ([]logging.LogEntry, error) {
 	// Try to find the log file
 	logFile := "bot_activity.log"
 	if _, err := os.Stat(logFile); os.IsNotExist(err) {
 		// Try in logs directory
 		logFile = filepath.Join("logs", "bot_activity.log")
 		if _, err := os.Stat(logFile); os.IsNotExist(err) {
-			return []string{"No log file found. Bot activity logs will appear here once the bot is running."}, nil
+			return []logging.LogEntry{{Message: "No log file found. Bot activity logs will appear here once the bot is running."}}, nil
 		}
 	}
 
-	// Open log file
-	file, err := os.Open(logFile)
+	entries, err := logging.ReadEntries(logFile, logging.ReadOptions{Level: level, Tail: maxLines})
 	if err != nil {
-		return nil, fmt.Errorf("failed to open log file: %w", err)
-	}
-	defer file.Close()
-
-	// Read all lines
-	var allLines []string
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		allLines = append(allLines, scanner.Text())
-	}
-
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("failed to read log file: %w", err)
+		return nil, err
 	}
 
 	// Reverse to get newest first
-	for i, j := 0, len(allLines)-1; i < j; i, j = i+1, j-1 {
-		allLines[i], allLines[j] = allLines[j], allLines[i]
-	}
-
-	// Filter by level if needed
-	var filteredLines []string
-	if level != "all" {
-		for _, line := range allLines {
-			if strings.Contains(strings.ToLower(line), strings.ToLower(level)) {
-				filteredLines = append(filteredLines, line)
-			}
-		}
-	} else {
-		filteredLines = allLines
-	}
-
-	// Limit to requested number of lines
-	if len(filteredLines) > maxLines {
-		filteredLines = filteredLines[:maxLines]
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
 	}
 
-	return filteredLines, nil
+	return entries, nil
 }
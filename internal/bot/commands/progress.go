@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -118,6 +119,10 @@ func updateProgressLive(s *discordgo.Session, i *discordgo.InteractionCreate, to
 
 			// Get updated torrent info
 			torrent, err := torrentService.FindTorrentByHash(ctx, hash)
+			if err != nil && !errors.Is(err, core.ErrTorrentNotFound) {
+				// qBittorrent is unreachable for now, try again on the next tick
+				continue
+			}
 			if err != nil {
 				// Torrent might have been deleted
 				finalContent := "❌ **Torrent not found**\n\nThe torrent may have been deleted or is no longer available."
@@ -156,7 +161,7 @@ func formatTorrentProgress(torrent *qbittorrent.Torrent, elapsed, remaining int)
 	// Calculate progress percentage (cap at 100%)
 	progress := "0%"
 	if torrent.Size > 0 {
-		percentage := float64(torrent.Downloaded) / float64(torrent.Size) * 100
+		percentage := float64(torrent.GetCompletedBytes()) / float64(torrent.Size) * 100
 		if percentage > 100 {
 			percentage = 100
 		}
@@ -176,8 +181,8 @@ func formatTorrentProgress(torrent *qbittorrent.Torrent, elapsed, remaining int)
 
 	// Format ETA
 	eta := "Unknown"
-	if torrent.Eta > 0 {
-		eta = formatDuration(time.Duration(torrent.Eta) * time.Second)
+	if etaSeconds := torrent.GetEstimatedETA(); etaSeconds > 0 {
+		eta = formatDuration(time.Duration(etaSeconds) * time.Second)
 	}
 
 	// Format ratio
@@ -187,7 +192,7 @@ func formatTorrentProgress(torrent *qbittorrent.Torrent, elapsed, remaining int)
 	}
 
 	// Create progress bar
-	progressBar := createProgressBar(float64(torrent.Downloaded), float64(torrent.Size))
+	progressBar := createProgressBar(float64(torrent.GetCompletedBytes()), float64(torrent.Size))
 
 	content := fmt.Sprintf("📥 **%s**\n\n"+
 		"%s\n\n"+
@@ -200,6 +205,7 @@ func formatTorrentProgress(torrent *qbittorrent.Torrent, elapsed, remaining int)
 		"**State:** %s\n\n"+
 		"**Size:** %s\n"+
 		"**Downloaded:** %s\n"+
+		"**Remaining:** %s\n"+
 		"**Uploaded:** %s",
 		torrent.Name,
 		progressBar,
@@ -211,7 +217,8 @@ func formatTorrentProgress(torrent *qbittorrent.Torrent, elapsed, remaining int)
 		torrent.NumLeechs, torrent.NumIncomplete, torrent.NumSeeds,
 		getStateEmoji(torrent.State)+" "+string(torrent.State),
 		formatBytes(torrent.Size),
-		formatBytes(torrent.Downloaded),
+		formatBytes(torrent.GetCompletedBytes()),
+		formatBytes(torrent.GetRemainingBytes()),
 		formatBytes(torrent.Uploaded))
 
 	// Add tracking info if provided
@@ -9,14 +9,23 @@ import (
 	"github.com/raainshe/akira/internal/core"
 )
 
-// HandleSeedingStatusCommand handles the /seeding-status Discord command
-func HandleSeedingStatusCommand(s *discordgo.Session, i *discordgo.InteractionCreate, seedingService *core.SeedingService) {
+// HandleSeedingCommand handles the /seeding Discord command. The response is
+// deferred first since the status needs the torrent list from qBittorrent.
+func HandleSeedingCommand(s *discordgo.Session, i *discordgo.InteractionCreate, seedingService *core.SeedingService) {
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		fmt.Printf("Failed to defer seeding status response: %v\n", err)
+		return
+	}
+
 	ctx := context.Background()
 
 	// Get seeding status
 	status, err := seedingService.GetSeedingStatus(ctx)
 	if err != nil {
-		respondWithError(s, i, fmt.Sprintf("Failed to get seeding status: %v", err))
+		editWithError(s, i, fmt.Sprintf("Failed to get seeding status: %v", err))
 		return
 	}
 
@@ -26,14 +35,10 @@ func HandleSeedingStatusCommand(s *discordgo.Session, i *discordgo.InteractionCr
 	// Create embed
 	embed := createInfoEmbed("🌱 Seeding Status", content)
 
-	// Send response
-	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseChannelMessageWithSource,
-		Data: &discordgo.InteractionResponseData{
-			Embeds: []*discordgo.MessageEmbed{embed},
-		},
+	// Replace the deferred response
+	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{embed},
 	})
-
 	if err != nil {
 		fmt.Printf("Failed to send seeding status response: %v\n", err)
 	}
@@ -97,6 +102,10 @@ func HandleStopSeedingCommand(s *discordgo.Session, i *discordgo.InteractionCrea
 		respondWithError(s, i, fmt.Sprintf("Failed to stop tracking torrent: %v", err))
 		return
 	}
+	if err := seedingService.UntagTracked(ctx, matchingHash); err != nil {
+		// Log error but don't fail the command, tracking already stopped
+		fmt.Printf("Warning: Failed to remove tracked tag from torrent %s: %v\n", matchingHash, err)
+	}
 
 	// Create success response
 	content := fmt.Sprintf("✅ **Stopped Tracking Torrent**\n\n"+
@@ -81,7 +81,7 @@ func HandleTorrentsCommand(s *discordgo.Session, i *discordgo.InteractionCreate,
 	// Add pagination components if needed
 	var components []discordgo.MessageComponent
 	if totalPages > 1 {
-		components = createPaginationComponents(page, totalPages)
+		components = createPaginationComponents(page, totalPages, "")
 	}
 
 	// Send response
@@ -158,7 +158,7 @@ func HandleTorrentsPagination(s *discordgo.Session, i *discordgo.InteractionCrea
 	// Add pagination components if needed
 	var components []discordgo.MessageComponent
 	if totalPages > 1 {
-		components = createPaginationComponents(page, totalPages)
+		components = createPaginationComponents(page, totalPages, "")
 	}
 
 	// Update the message instead of creating a new response
@@ -2,12 +2,13 @@ package commands
 
 import (
 	"fmt"
-	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/logging"
 	"github.com/raainshe/akira/internal/qbittorrent"
 )
 
@@ -46,6 +47,16 @@ func createWarningEmbed(title, description string) *discordgo.MessageEmbed {
 	return createEmbed(title, description, 0xFFA500) // Orange
 }
 
+// NewSuccessEmbed creates a success embed for messages sent outside of commands
+func NewSuccessEmbed(title, description string) *discordgo.MessageEmbed {
+	return createSuccessEmbed(title, description)
+}
+
+// NewWarningEmbed creates a warning embed for messages sent outside of commands
+func NewWarningEmbed(title, description string) *discordgo.MessageEmbed {
+	return createWarningEmbed(title, description)
+}
+
 // formatTorrentList formats torrents for Discord display
 func formatTorrentList(torrents []qbittorrent.Torrent, page, totalPages int) string {
 	if len(torrents) == 0 {
@@ -57,15 +68,12 @@ func formatTorrentList(torrents []qbittorrent.Torrent, page, totalPages int) str
 
 	for i, torrent := range torrents {
 		// Truncate name if too long
-		name := torrent.Name
-		if len(name) > 50 {
-			name = name[:47] + "..."
-		}
+		name := truncateString(torrent.Name, 50)
 
 		// Format progress
 		progress := "0%"
 		if torrent.Size > 0 {
-			progress = fmt.Sprintf("%.1f%%", float64(torrent.Downloaded)/float64(torrent.Size)*100)
+			progress = fmt.Sprintf("%.1f%%", float64(torrent.GetCompletedBytes())/float64(torrent.Size)*100)
 		}
 
 		// Format speed
@@ -91,11 +99,15 @@ func formatTorrentList(torrents []qbittorrent.Torrent, page, totalPages int) str
 func formatDiskUsage(diskInfo *core.DiskInfo) string {
 	var builder strings.Builder
 
+	if !diskInfo.HasCapacity() {
+		return fmt.Sprintf("**%s**\nUsed: N/A (no capacity reported)\n\n", diskInfo.Path)
+	}
+
 	// Calculate usage percentage
 	usagePercent := diskInfo.UsedPercent
 
-	// Choose color based on usage
-	usageBar := getUsageBar(usagePercent)
+	// Choose color based on health
+	usageBar := getUsageBar(usagePercent, diskInfo.Health)
 
 	builder.WriteString(fmt.Sprintf("**%s**\n", diskInfo.Path))
 	builder.WriteString(fmt.Sprintf("%s\n", usageBar))
@@ -117,15 +129,20 @@ func formatSeedingStatus(status core.SeedingStatus) string {
 	builder.WriteString(fmt.Sprintf("**Overdue Seeding:** %d\n\n", status.OverdueSeeding))
 
 	if len(status.Details) > 0 {
-		builder.WriteString("**Tracked Torrents:**\n")
-		count := 0
+		// List the torrents by name so the message doesn't reshuffle
+		torrents := make([]*core.SeedingTorrentStatus, 0, len(status.Details))
 		for _, torrent := range status.Details {
+			torrents = append(torrents, torrent)
+		}
+		sort.Slice(torrents, func(a, b int) bool { return torrents[a].Name < torrents[b].Name })
+
+		builder.WriteString("**Tracked Torrents:**\n")
+		for count, torrent := range torrents {
 			if count >= 10 { // Limit to 10 torrents
-				builder.WriteString(fmt.Sprintf("... and %d more\n", len(status.Details)-10))
+				builder.WriteString(fmt.Sprintf("... and %d more\n", len(torrents)-10))
 				break
 			}
-			builder.WriteString(fmt.Sprintf("• %s (%s)\n", torrent.Name, formatBytes(int64(torrent.SeedingDuration))))
-			count++
+			builder.WriteString(fmt.Sprintf("• %s (seeded %s)\n", truncateString(torrent.Name, 60), formatDuration(torrent.SeedingDuration)))
 		}
 	}
 
@@ -133,7 +150,7 @@ func formatSeedingStatus(status core.SeedingStatus) string {
 }
 
 // formatLogs formats logs for Discord display
-func formatLogs(logs []string, level string) string {
+func formatLogs(logs []logging.LogEntry, level string) string {
 	if len(logs) == 0 {
 		return "No logs found."
 	}
@@ -145,20 +162,17 @@ func formatLogs(logs []string, level string) string {
 	maxChars := 3500 // Leave some buffer
 	currentChars := len(builder.String())
 
-	// Parse and format each log line
-	for i, log := range logs {
+	// Format each log entry
+	for i, entry := range logs {
 		if i >= 20 { // Limit to 20 log lines
 			builder.WriteString(fmt.Sprintf("... and %d more lines\n", len(logs)-20))
 			break
 		}
 
-		// Parse the log line and format it cleanly
-		formattedLine := parseAndFormatLogLine(log)
+		formattedLine := formatLogEntry(entry)
 
 		// Truncate if too long
-		if len(formattedLine) > 200 {
-			formattedLine = formattedLine[:197] + "..."
-		}
+		formattedLine = truncateString(formattedLine, 200)
 
 		// Check if adding this line would exceed Discord's limit
 		if currentChars+len(formattedLine)+1 > maxChars {
@@ -173,197 +187,58 @@ func formatLogs(logs []string, level string) string {
 	return builder.String()
 }
 
-// parseAndFormatLogLine parses a logrus JSON log line and formats it for Discord
-func parseAndFormatLogLine(logLine string) string {
-	// Remove ANSI color codes
-	cleanLine := removeANSICodes(logLine)
-
-	// Try to parse as JSON first (structured logrus output)
-	if strings.Contains(cleanLine, `"level"`) && strings.Contains(cleanLine, `"msg"`) {
-		return parseStructuredLog(cleanLine)
+// formatLogEntry formats a parsed log entry for Discord
+func formatLogEntry(entry logging.LogEntry) string {
+	// Lines the reader could not parse only carry a message
+	if entry.Level == "" {
+		return "📝 " + entry.Message
 	}
 
-	// If not structured, try to clean up common logrus text format
-	return cleanLogrusText(cleanLine)
-}
-
-// removeANSICodes removes ANSI escape sequences from the log line
-func removeANSICodes(line string) string {
-	// Remove ANSI color codes like [31m, [0m, etc.
-	var result strings.Builder
-	inEscape := false
-
-	for i := 0; i < len(line); i++ {
-		if line[i] == '\x1b' && i+1 < len(line) && line[i+1] == '[' {
-			inEscape = true
-			i++ // Skip the [
-			continue
-		}
-
-		if inEscape {
-			if line[i] == 'm' {
-				inEscape = false
-			}
-			continue
-		}
-
-		result.WriteByte(line[i])
-	}
-
-	return result.String()
-}
-
-// parseStructuredLog parses a structured logrus JSON log
-func parseStructuredLog(jsonLine string) string {
-	// Simple JSON parsing for common logrus fields
-	// We'll extract the key fields and format them nicely
-
-	// Extract level
-	level := extractJSONField(jsonLine, "level")
-	if level == "" {
-		level = "INFO"
-	}
-
-	// Extract message
-	msg := extractJSONField(jsonLine, "msg")
-	if msg == "" {
-		msg = "No message"
-	}
-
-	// Extract time
-	time := extractJSONField(jsonLine, "time")
-	if time == "" {
-		time = "Unknown time"
-	}
-
-	// Extract component
-	component := extractJSONField(jsonLine, "component")
-
-	// Extract error if present
-	error := extractJSONField(jsonLine, "error")
-
-	// Format the log line
 	var result strings.Builder
 
 	// Add level emoji
-	switch strings.ToUpper(level) {
-	case "ERROR":
+	switch entry.Level {
+	case "error", "fatal", "panic":
 		result.WriteString("❌ ")
-	case "WARN", "WARNING":
+	case "warning":
 		result.WriteString("⚠️ ")
-	case "INFO":
+	case "info":
 		result.WriteString("ℹ️ ")
-	case "DEBUG":
+	case "debug", "trace":
 		result.WriteString("🔍 ")
 	default:
 		result.WriteString("📝 ")
 	}
 
 	// Add level and time
-	result.WriteString(fmt.Sprintf("**%s** | %s", strings.ToUpper(level), time))
+	timeStr := "Unknown time"
+	if !entry.Time.IsZero() {
+		timeStr = entry.Time.Format("2006-01-02 15:04:05")
+	}
+	result.WriteString(fmt.Sprintf("**%s** | %s", strings.ToUpper(entry.Level), timeStr))
 
 	// Add component if present
-	if component != "" {
-		result.WriteString(fmt.Sprintf(" | **%s**", component))
+	if entry.Component != "" {
+		result.WriteString(fmt.Sprintf(" | **%s**", entry.Component))
 	}
 
 	result.WriteString("\n")
 
 	// Add message
+	msg := entry.Message
+	if msg == "" {
+		msg = "No message"
+	}
 	result.WriteString(fmt.Sprintf("**Message:** %s", msg))
 
 	// Add error if present
-	if error != "" {
-		result.WriteString(fmt.Sprintf("\n**Error:** %s", error))
+	if err, ok := entry.Fields["error"]; ok {
+		result.WriteString(fmt.Sprintf("\n**Error:** %v", err))
 	}
 
 	return result.String()
 }
 
-// cleanLogrusText cleans up logrus text format logs
-func cleanLogrusText(line string) string {
-	// Remove common logrus prefixes and clean up the format
-	// Example: time="2025-09-02T21:50:57+02:00" level=error msg="Authentication failed"
-
-	// Remove time prefix if present
-	if strings.HasPrefix(line, "time=") {
-		// Find the end of the time field
-		if idx := strings.Index(line, "level="); idx != -1 {
-			line = line[idx:]
-		}
-	}
-
-	// Clean up level field
-	if strings.HasPrefix(line, "level=") {
-		// Extract level
-		levelEnd := strings.Index(line, " ")
-		if levelEnd != -1 {
-			level := line[6:levelEnd] // "level=" is 6 chars
-			line = line[levelEnd+1:]
-
-			// Add level emoji
-			var levelEmoji string
-			switch strings.ToUpper(level) {
-			case "ERROR":
-				levelEmoji = "❌ "
-			case "WARN", "WARNING":
-				levelEmoji = "⚠️ "
-			case "INFO":
-				levelEmoji = "ℹ️ "
-			case "DEBUG":
-				levelEmoji = "🔍 "
-			default:
-				levelEmoji = "📝 "
-			}
-
-			line = levelEmoji + "**" + strings.ToUpper(level) + "** " + line
-		}
-	}
-
-	// Clean up msg field
-	if strings.HasPrefix(line, "msg=") {
-		line = strings.Replace(line, "msg=", "**Message:** ", 1)
-	}
-
-	// Clean up component field
-	if strings.Contains(line, "component=") {
-		// Extract component
-		if idx := strings.Index(line, "component="); idx != -1 {
-			componentStart := idx + 10 // "component=" is 10 chars
-			componentEnd := strings.Index(line[componentStart:], " ")
-			if componentEnd == -1 {
-				componentEnd = len(line) - componentStart
-			}
-			component := line[componentStart : componentStart+componentEnd]
-
-			// Replace component= with formatted version
-			line = strings.Replace(line, "component="+component, "**Component:** "+component, 1)
-		}
-	}
-
-	// Clean up error field
-	if strings.Contains(line, "error=") {
-		line = strings.Replace(line, "error=", "**Error:** ", 1)
-	}
-
-	return line
-}
-
-// extractJSONField extracts a field value from a JSON string
-func extractJSONField(json, field string) string {
-	// Simple JSON field extraction
-	fieldPattern := fmt.Sprintf(`"%s"\s*:\s*"([^"]*)"`, field)
-
-	// Use regex to find the field value
-	re := regexp.MustCompile(fieldPattern)
-	matches := re.FindStringSubmatch(json)
-	if len(matches) > 1 {
-		return matches[1]
-	}
-
-	return ""
-}
-
 // formatBytes formats bytes to human readable format
 func formatBytes(bytes int64) string {
 	const unit = 1024
@@ -405,7 +280,7 @@ func getServiceStatusEmoji(isRunning bool) string {
 }
 
 // getUsageBar creates a visual usage bar
-func getUsageBar(percent float64) string {
+func getUsageBar(percent float64, health core.DiskHealthStatus) string {
 	const barLength = 20
 	filled := int(percent / 100 * barLength)
 	if filled > barLength {
@@ -415,21 +290,28 @@ func getUsageBar(percent float64) string {
 	bar := strings.Repeat("█", filled) + strings.Repeat("░", barLength-filled)
 
 	// Add color indicator
-	if percent >= 90 {
+	switch health {
+	case core.DiskHealthDanger:
 		return "🔴 " + bar
-	} else if percent >= 70 {
+	case core.DiskHealthCritical:
+		return "🟠 " + bar
+	case core.DiskHealthWarning:
 		return "🟡 " + bar
-	} else {
+	default:
 		return "🟢 " + bar
 	}
 }
 
-// truncateString truncates string to fit Discord limits
+// truncateString truncates string to fit Discord limits, which count characters rather than bytes
 func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
 		return s
 	}
-	return s[:maxLen-3] + "..."
+	if maxLen <= 3 {
+		return string(runes[:maxLen])
+	}
+	return string(runes[:maxLen-3]) + "..."
 }
 
 // respondWithError sends an error response to Discord
@@ -446,12 +328,30 @@ func respondWithError(s *discordgo.Session, i *discordgo.InteractionCreate, mess
 	}
 }
 
-// createPaginationComponents creates pagination buttons
-func createPaginationComponents(currentPage, totalPages int) []discordgo.MessageComponent {
+// interactionUserID returns the ID of the user who triggered the interaction,
+// which is on the member in guilds and on the user in DMs
+func interactionUserID(i *discordgo.InteractionCreate) string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
+
+// createPaginationComponents creates page_N pagination buttons. A non-empty
+// filter is appended to the custom IDs as page_N|filter.
+func createPaginationComponents(currentPage, totalPages int, filter string) []discordgo.MessageComponent {
 	if totalPages <= 1 {
 		return nil
 	}
 
+	suffix := ""
+	if filter != "" {
+		suffix = "|" + filter
+	}
+
 	var components []discordgo.MessageComponent
 	row := discordgo.ActionsRow{}
 
@@ -460,7 +360,7 @@ func createPaginationComponents(currentPage, totalPages int) []discordgo.Message
 		row.Components = append(row.Components, discordgo.Button{
 			Label:    "◀️ Previous",
 			Style:    discordgo.SecondaryButton,
-			CustomID: fmt.Sprintf("page_%d", currentPage-1),
+			CustomID: fmt.Sprintf("page_%d%s", currentPage-1, suffix),
 		})
 	}
 
@@ -477,7 +377,7 @@ func createPaginationComponents(currentPage, totalPages int) []discordgo.Message
 		row.Components = append(row.Components, discordgo.Button{
 			Label:    "Next ▶️",
 			Style:    discordgo.SecondaryButton,
-			CustomID: fmt.Sprintf("page_%d", currentPage+1),
+			CustomID: fmt.Sprintf("page_%d%s", currentPage+1, suffix),
 		})
 	}
 
@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncateString(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		maxLen int
+		want   string
+	}{
+		{name: "short ASCII", input: "Ubuntu", maxLen: 100, want: "Ubuntu"},
+		{name: "long ASCII", input: "Ubuntu 24.04 Desktop", maxLen: 10, want: "Ubuntu ..."},
+		{name: "CJK", input: "進撃の巨人 第1話", maxLen: 6, want: "進撃の..."},
+		{name: "emoji", input: "🎬🎥📺🍿🎞️", maxLen: 5, want: "🎬🎥..."},
+		{name: "tiny limit", input: "🎬🎥📺", maxLen: 1, want: "🎬"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncateString(tt.input, tt.maxLen)
+			if got != tt.want {
+				t.Errorf("truncateString(%q, %d) = %q, want %q", tt.input, tt.maxLen, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("truncateString(%q, %d) = %q is not valid UTF-8", tt.input, tt.maxLen, got)
+			}
+		})
+	}
+}
+
+func TestTruncateStringDiscordLimit(t *testing.T) {
+	// Select menu labels allow 100 characters; a long CJK name must fit without
+	// being cut in the middle of a character
+	name := strings.Repeat("巨人", 80)
+	got := truncateString(name, 100)
+	if n := utf8.RuneCountInString(got); n != 100 {
+		t.Errorf("truncateString of %d runes to 100 has %d runes", utf8.RuneCountInString(name), n)
+	}
+	if !utf8.ValidString(got) || !strings.HasSuffix(got, "...") {
+		t.Errorf("truncateString = %q, want valid UTF-8 ending in ...", got)
+	}
+}
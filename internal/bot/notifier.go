@@ -0,0 +1,165 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/raainshe/akira/internal/bot/commands"
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/logging"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+const (
+	// notificationInterval is the minimum time between two notification messages
+	notificationInterval = 5 * time.Second
+
+	// notificationQueueSize bounds how many notifications may wait to be sent
+	notificationQueueSize = 100
+
+	// maxCompletionsPerMessage bounds how many completed torrents are listed in a single message
+	maxCompletionsPerMessage = 10
+)
+
+// Notifier posts torrent completions and critical disk alerts to a Discord
+// channel. Messages are rate limited; completions that arrive while waiting
+// are combined into a single message.
+type Notifier struct {
+	session   *discordgo.Session
+	channelID string
+	logger    *logging.Logger
+
+	completions chan core.TorrentCompletion
+	diskAlerts  chan core.DiskAlert
+}
+
+// NewNotifier creates a notifier posting to channelID
+func NewNotifier(session *discordgo.Session, channelID string) *Notifier {
+	return &Notifier{
+		session:     session,
+		channelID:   channelID,
+		logger:      logging.GetDiscordLogger(),
+		completions: make(chan core.TorrentCompletion, notificationQueueSize),
+		diskAlerts:  make(chan core.DiskAlert, notificationQueueSize),
+	}
+}
+
+// NotifyCompletion queues a completed torrent. It never blocks; the
+// notification is dropped when the queue is full.
+func (n *Notifier) NotifyCompletion(completion core.TorrentCompletion) {
+	select {
+	case n.completions <- completion:
+	default:
+		n.logger.WithField("name", completion.Name).Warn("Notification queue full, dropping completion")
+	}
+}
+
+// NotifyDiskAlert queues a disk alert when the disk became critical or worse.
+// It never blocks; the notification is dropped when the queue is full.
+func (n *Notifier) NotifyDiskAlert(alert core.DiskAlert) {
+	if alert.Health != core.DiskHealthCritical && alert.Health != core.DiskHealthDanger {
+		return
+	}
+
+	select {
+	case n.diskAlerts <- alert:
+	default:
+		n.logger.WithField("path", alert.Path).Warn("Notification queue full, dropping disk alert")
+	}
+}
+
+// Run sends queued notifications until the context is cancelled
+func (n *Notifier) Run(ctx context.Context) {
+	n.logger.WithField("channel_id", n.channelID).Info("Discord notifications started")
+	defer n.logger.Info("Discord notifications stopped")
+
+	var lastSent time.Time
+	for {
+		var embed *discordgo.MessageEmbed
+		select {
+		case <-ctx.Done():
+			return
+		case alert := <-n.diskAlerts:
+			embed = diskAlertEmbed(alert)
+		case completion := <-n.completions:
+			// Wait out the rate limit first so that bulk completions end up in one message
+			if err := sleepUntil(ctx, lastSent.Add(notificationInterval)); err != nil {
+				return
+			}
+			embed = completionEmbed(append([]core.TorrentCompletion{completion}, n.drainCompletions()...))
+		}
+
+		if err := sleepUntil(ctx, lastSent.Add(notificationInterval)); err != nil {
+			return
+		}
+		if _, err := n.session.ChannelMessageSendEmbed(n.channelID, embed); err != nil {
+			n.logger.WithError(err).WithField("channel_id", n.channelID).Error("Failed to send Discord notification")
+		}
+		lastSent = time.Now()
+	}
+}
+
+// drainCompletions returns the completions currently queued
+func (n *Notifier) drainCompletions() []core.TorrentCompletion {
+	var completions []core.TorrentCompletion
+	for {
+		select {
+		case completion := <-n.completions:
+			completions = append(completions, completion)
+		default:
+			return completions
+		}
+	}
+}
+
+// completionEmbed describes one or more completed torrents
+func completionEmbed(completions []core.TorrentCompletion) *discordgo.MessageEmbed {
+	if len(completions) == 1 {
+		c := completions[0]
+		description := fmt.Sprintf("**%s**\n\n📦 Size: %s\n⏱️ Downloaded in: %s",
+			c.Name, qbittorrent.FormatBytes(c.Size), c.DownloadDuration)
+		if c.Category != "" {
+			description += fmt.Sprintf("\n📁 Category: %s", c.Category)
+		}
+		return commands.NewSuccessEmbed("✅ Download Complete", description)
+	}
+
+	var builder strings.Builder
+	for i, c := range completions {
+		if i == maxCompletionsPerMessage {
+			fmt.Fprintf(&builder, "...and %d more", len(completions)-maxCompletionsPerMessage)
+			break
+		}
+		fmt.Fprintf(&builder, "• **%s** (%s)\n", c.Name, qbittorrent.FormatBytes(c.Size))
+	}
+	return commands.NewSuccessEmbed(fmt.Sprintf("✅ %d Downloads Complete", len(completions)), builder.String())
+}
+
+// diskAlertEmbed describes a disk running low on space
+func diskAlertEmbed(alert core.DiskAlert) *discordgo.MessageEmbed {
+	description := fmt.Sprintf("**%s** is now **%s** (was %s)\n\n💾 Free: %s of %s (%.1f%%)",
+		alert.Path, alert.Health, alert.OldHealth,
+		qbittorrent.FormatBytes(alert.Free), qbittorrent.FormatBytes(alert.Total), alert.FreePercent)
+	return commands.NewWarningEmbed("🚨 Low Disk Space", description)
+}
+
+// sleepUntil waits until the given time or until the context is done
+func sleepUntil(ctx context.Context, t time.Time) error {
+	wait := time.Until(t)
+	if wait <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -49,11 +49,14 @@ type AuthSession struct {
 
 // DiskSpaceInfo represents cached disk space information
 type DiskSpaceInfo struct {
-	Path      string    `json:"path"`
-	Total     int64     `json:"total"`
-	Used      int64     `json:"used"`
-	Free      int64     `json:"free"`
-	UpdatedAt time.Time `json:"updated_at"`
+	Path       string    `json:"path"`
+	Total      int64     `json:"total"`
+	Used       int64     `json:"used"`
+	Free       int64     `json:"free"`
+	DeviceID   string    `json:"device_id,omitempty"`
+	MountPoint string    `json:"mount_point,omitempty"`
+	Filesystem string    `json:"filesystem,omitempty"`
+	UpdatedAt  time.Time `json:"updated_at"`
 }
 
 // ServerStateInfo represents cached server state
@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// PersistedData is the last-known state saved between runs so a UI can show
+// something before its first fetch completes
+type PersistedData struct {
+	Torrents    []qbittorrent.Torrent    `json:"torrents"`
+	ServerState *qbittorrent.ServerState `json:"server_state,omitempty"`
+	SavedAt     time.Time                `json:"saved_at"` // When the data was fetched from qBittorrent
+}
+
+// Age returns how old the persisted data is
+func (d *PersistedData) Age() time.Duration {
+	return time.Since(d.SavedAt)
+}
+
+// SavePersistedData writes data to the configured persist file. It does nothing
+// when persistence is disabled.
+func (cm *CacheManager) SavePersistedData(data *PersistedData) error {
+	path := cm.config.PersistFile
+	if path == "" || data == nil {
+		return nil
+	}
+
+	content, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache data: %w", err)
+	}
+
+	// Write to a temporary file first so a crash never leaves a truncated cache
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".akira-cache-*")
+	if err != nil {
+		return fmt.Errorf("failed to create cache file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write cache file: %w", err)
+	}
+
+	cm.logger.WithFields(map[string]interface{}{
+		"path":     path,
+		"torrents": len(data.Torrents),
+	}).Debug("Persisted cache data")
+	return nil
+}
+
+// LoadPersistedData reads the data saved by SavePersistedData. It returns nil
+// without an error when persistence is disabled or nothing was saved yet.
+func (cm *CacheManager) LoadPersistedData() (*PersistedData, error) {
+	path := cm.config.PersistFile
+	if path == "" {
+		return nil, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read cache file: %w", err)
+	}
+
+	var data PersistedData
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse cache file %s: %w", path, err)
+	}
+
+	cm.logger.WithFields(map[string]interface{}{
+		"path":     path,
+		"torrents": len(data.Torrents),
+		"age":      data.Age().Round(time.Second).String(),
+	}).Debug("Loaded persisted cache data")
+	return &data, nil
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dateLayout is the plain date accepted by ParseTimeOrAge, taken as local midnight
+const dateLayout = "2006-01-02"
+
+// ParseAge parses a duration that may also use days and weeks, e.g. "7d",
+// "2w" or "1d12h". Anything time.ParseDuration accepts is accepted too.
+func ParseAge(value string) (time.Duration, error) {
+	s := strings.ToLower(strings.TrimSpace(value))
+	if s == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+
+	var total time.Duration
+	for s != "" {
+		// Split off the next number and its unit
+		i := 0
+		for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+			i++
+		}
+		j := i
+		for j < len(s) && !(s[j] >= '0' && s[j] <= '9' || s[j] == '.') {
+			j++
+		}
+		if i == 0 || j == i {
+			return 0, fmt.Errorf("invalid duration '%s'", value)
+		}
+
+		switch unit := s[i:j]; unit {
+		case "d", "w":
+			number, err := strconv.ParseFloat(s[:i], 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid duration '%s'", value)
+			}
+			day := 24 * time.Hour
+			if unit == "w" {
+				day *= 7
+			}
+			total += time.Duration(number * float64(day))
+		default:
+			part, err := time.ParseDuration(s[:j])
+			if err != nil {
+				return 0, fmt.Errorf("invalid duration '%s'", value)
+			}
+			total += part
+		}
+		s = s[j:]
+	}
+
+	return total, nil
+}
+
+// ParseTimeOrAge parses an RFC3339 timestamp, a date such as "2025-01-31", or
+// an age such as "7d" that is counted back from now
+func ParseTimeOrAge(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation(dateLayout, value, time.Local); err == nil {
+		return t, nil
+	}
+	if age, err := ParseAge(value); err == nil {
+		return now.Add(-age), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time '%s' (use an age like 7d or 36h, a date like 2025-01-31, or RFC3339)", value)
+}
+
+// FormatAge formats the time elapsed since the Unix timestamp, or "-" when it is unset
+func FormatAge(timestamp int64, now time.Time) string {
+	if timestamp <= 0 {
+		return "-"
+	}
+	seconds := int64(now.Sub(time.Unix(timestamp, 0)).Seconds())
+	if seconds < 1 {
+		return "0s"
+	}
+	return FormatDuration(seconds)
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseAge(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{input: "500ms", want: 500 * time.Millisecond},
+		{input: "30s", want: 30 * time.Second},
+		{input: "15m", want: 15 * time.Minute},
+		{input: "36h", want: 36 * time.Hour},
+		{input: "7d", want: 7 * 24 * time.Hour},
+		{input: "2w", want: 14 * 24 * time.Hour},
+		{input: "1.5h", want: 90 * time.Minute},
+		{input: "0.5d", want: 12 * time.Hour},
+		{input: "1.5w", want: 252 * time.Hour},
+		{input: "1d12h", want: 36 * time.Hour},
+		{input: "1w2d3h", want: 219 * time.Hour},
+		{input: " 7D ", want: 7 * 24 * time.Hour},
+		{input: "", wantErr: true},
+		{input: "d", wantErr: true},
+		{input: "7", wantErr: true},
+		{input: "-7d", wantErr: true},
+		{input: "7y", wantErr: true},
+		{input: "1.2.3d", wantErr: true},
+		{input: "seven days", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseAge(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseAge(%q) = %s, want an error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAge(%q) unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseAge(%q) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTimeOrAge(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{input: "2025-01-31T08:30:00Z", want: time.Date(2025, 1, 31, 8, 30, 0, 0, time.UTC)},
+		{input: "2025-01-31T08:30:00+02:00", want: time.Date(2025, 1, 31, 6, 30, 0, 0, time.UTC)},
+		{input: "2025-01-31", want: time.Date(2025, 1, 31, 0, 0, 0, 0, time.Local)},
+		{input: "7d", want: now.Add(-7 * 24 * time.Hour)},
+		{input: "36h", want: now.Add(-36 * time.Hour)},
+		{input: " 1w ", want: now.Add(-7 * 24 * time.Hour)},
+		{input: "", wantErr: true},
+		{input: "2025-13-01", wantErr: true},
+		{input: "2025-01-31 08:30", wantErr: true},
+		{input: "yesterday", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseTimeOrAge(tt.input, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseTimeOrAge(%q) = %s, want an error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTimeOrAge(%q) unexpected error: %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseTimeOrAge(%q) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TorrentTableOptions customizes the table printed by PrintTorrentTable.
+// The zero value prints the default columns followed by the summary.
+type TorrentTableOptions struct {
+	Columns   []string // Column names in display order, see TorrentColumnNames (default DefaultTorrentColumns)
+	NoSummary bool     // Leave out the summary printed after the table
+}
+
+// torrentColumn is a column that can be shown in the torrent table
+type torrentColumn struct {
+	header string
+	width  int
+	value  func(row *TorrentTableRow) string
+}
+
+// torrentColumns are the available torrent table columns by name
+var torrentColumns = map[string]torrentColumn{
+	"name":     {"Name", 40, func(row *TorrentTableRow) string { return TruncateString(row.Name, 37) }},
+	"size":     {"Size", 8, func(row *TorrentTableRow) string { return row.Size }},
+	"progress": {"Progress", 22, func(row *TorrentTableRow) string { return CreateProgressBar(row.Progress, 15) }},
+	"speed":    {"Speed", 10, func(row *TorrentTableRow) string { return row.Speed }},
+	"eta":      {"ETA", 10, func(row *TorrentTableRow) string { return row.ETA }},
+	"state":    {"State", 14, func(row *TorrentTableRow) string { return row.State }},
+	"ratio":    {"Ratio", 7, func(row *TorrentTableRow) string { return fmt.Sprintf("%.2f", row.Ratio) }},
+	"category": {"Category", 12, func(row *TorrentTableRow) string { return TruncateString(row.Category, 12) }},
+	"hash":     {"Hash", 40, func(row *TorrentTableRow) string { return row.Hash }},
+	"age":      {"Age", 10, func(row *TorrentTableRow) string { return row.Age }},
+}
+
+// TorrentColumnNames lists the available torrent table columns
+var TorrentColumnNames = []string{"name", "size", "progress", "speed", "eta", "state", "ratio", "category", "hash", "age"}
+
+// DefaultTorrentColumns are the columns shown when none are requested
+var DefaultTorrentColumns = []string{"name", "size", "progress", "speed", "eta", "state"}
+
+// ParseTorrentColumns parses a comma-separated list of column names, e.g.
+// "name,size,ratio". An empty list selects DefaultTorrentColumns.
+func ParseTorrentColumns(list string) ([]string, error) {
+	var columns []string
+	for _, name := range strings.Split(list, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if _, ok := torrentColumns[name]; !ok {
+			return nil, fmt.Errorf("unknown column '%s' (available: %s)", name, strings.Join(TorrentColumnNames, ", "))
+		}
+		columns = append(columns, name)
+	}
+
+	if len(columns) == 0 {
+		return DefaultTorrentColumns, nil
+	}
+	return columns, nil
+}
+
+// formatTableLine lays out one value per column, padding all but the last
+func formatTableLine(columns []torrentColumn, values []string) string {
+	var b strings.Builder
+	for i, column := range columns {
+		if i == len(columns)-1 {
+			b.WriteString(values[i])
+			break
+		}
+		fmt.Fprintf(&b, "%-*s ", column.width, values[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// GetFilePriorityName returns a human-readable name for a file priority
+func GetFilePriorityName(priority int) string {
+	switch priority {
+	case qbittorrent.FilePriorityDoNotDownload:
+		return "Skip"
+	case qbittorrent.FilePriorityNormal:
+		return "Normal"
+	case qbittorrent.FilePriorityHigh:
+		return "High"
+	case qbittorrent.FilePriorityMaximum:
+		return "Maximum"
+	default:
+		return fmt.Sprintf("%d", priority)
+	}
+}
+
+// PrintTorrentFiles prints the files of a torrent to w (stdout when nil)
+func PrintTorrentFiles(w io.Writer, torrentName string, files []qbittorrent.TorrentFile, jsonOutput bool) error {
+	w = writerOrStdout(w)
+
+	// JSON output
+	if jsonOutput {
+		if files == nil {
+			files = []qbittorrent.TorrentFile{}
+		}
+		jsonData, err := json.MarshalIndent(files, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Fprintln(w, string(jsonData))
+		return nil
+	}
+
+	fmt.Fprintf(w, "📁 %s\n\n", ColorHeader.Sprintf("Files of %s", torrentName))
+
+	if len(files) == 0 {
+		fmt.Fprintln(w, "⏳ No files yet (waiting for metadata)")
+		return nil
+	}
+
+	fmt.Fprintf(w, "%-5s %-8s %-10s %-20s %s\n",
+		ColorHeader.Sprint("#"),
+		ColorHeader.Sprint("Priority"),
+		ColorHeader.Sprint("Size"),
+		ColorHeader.Sprint("Progress"),
+		ColorHeader.Sprint("Name"))
+	fmt.Fprintln(w, strings.Repeat("─", 100))
+
+	var wantedSize int64
+	skipped, complete := 0, 0
+	for _, file := range files {
+		if file.Progress >= 1 {
+			complete++
+		}
+		priority := GetFilePriorityName(file.Priority)
+		if file.Priority == qbittorrent.FilePriorityDoNotDownload {
+			priority = ColorPaused.Sprintf("%-8s", priority)
+			skipped++
+		} else {
+			priority = fmt.Sprintf("%-8s", priority)
+			wantedSize += file.Size
+		}
+
+		fmt.Fprintf(w, "%-5d %s %-10s %-20s %s\n",
+			file.Index,
+			priority,
+			FormatBytes(file.Size),
+			CreateProgressBar(file.Progress, 15),
+			file.Name)
+	}
+
+	fmt.Fprintf(w, "\n📊 %d files • %d complete • %d skipped • %s to download\n",
+		len(files), complete, skipped, FormatBytes(wantedSize))
+	return nil
+}
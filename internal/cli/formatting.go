@@ -3,12 +3,16 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/url"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/fatih/color"
 
+	"github.com/raainshe/akira/internal/config"
+	"github.com/raainshe/akira/internal/core"
 	"github.com/raainshe/akira/internal/qbittorrent"
 )
 
@@ -40,6 +44,28 @@ type TorrentTableRow struct {
 	Ratio    float64 `json:"ratio,omitempty"`
 	Category string  `json:"category,omitempty"`
 	Hash     string  `json:"hash"`
+	Age      string  `json:"age"` // Time since the torrent was added
+}
+
+// writerOrStdout returns w, or os.Stdout when no writer is provided
+func writerOrStdout(w io.Writer) io.Writer {
+	if w == nil {
+		return os.Stdout
+	}
+	return w
+}
+
+// TruncateString shortens s to at most maxLen characters, ending it with "..." when cut.
+// Lengths are counted in runes so multibyte characters (CJK, emoji) are never split.
+func TruncateString(s string, maxLen int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
+		return s
+	}
+	if maxLen <= 3 {
+		return string(runes[:maxLen])
+	}
+	return string(runes[:maxLen-3]) + "..."
 }
 
 // FormatBytes converts bytes to human readable format
@@ -187,11 +213,9 @@ func GetStateName(state string) string {
 
 // ConvertTorrentToTableRow converts a qBittorrent torrent to table row
 func ConvertTorrentToTableRow(torrent *qbittorrent.Torrent) *TorrentTableRow {
-	// Calculate ETA
+	// Calculate ETA from the bytes left for the selected files
 	var eta string
-	if torrent.Dlspeed > 0 && torrent.Progress < 1.0 {
-		remainingBytes := torrent.Size - int64(float64(torrent.Size)*torrent.Progress)
-		etaSeconds := remainingBytes / torrent.Dlspeed
+	if etaSeconds := torrent.GetEstimatedETA(); etaSeconds > 0 {
 		eta = FormatDuration(etaSeconds)
 	} else {
 		eta = "∞"
@@ -212,15 +236,15 @@ func ConvertTorrentToTableRow(torrent *qbittorrent.Torrent) *TorrentTableRow {
 		Ratio:    torrent.Ratio,
 		Category: torrent.Category,
 		Hash:     torrent.Hash,
+		Age:      FormatAge(torrent.AddedOn, time.Now()),
 	}
 }
 
-// PrintTorrentTable prints a beautiful table of torrents
-func PrintTorrentTable(torrents []*qbittorrent.Torrent, jsonOutput bool) error {
-	if len(torrents) == 0 {
-		fmt.Println("📭 No torrents found")
-		return nil
-	}
+// PrintTorrentTable prints a beautiful table of torrents to w (stdout when nil),
+// or JSON or CSV depending on format. opts selects the table and CSV columns and
+// whether the table summary is shown; JSON output always contains every field.
+func PrintTorrentTable(w io.Writer, torrents []*qbittorrent.Torrent, format OutputFormat, opts TorrentTableOptions) error {
+	w = writerOrStdout(w)
 
 	// Convert torrents to table rows
 	rows := make([]*TorrentTableRow, len(torrents))
@@ -228,52 +252,68 @@ func PrintTorrentTable(torrents []*qbittorrent.Torrent, jsonOutput bool) error {
 		rows[i] = ConvertTorrentToTableRow(torrent)
 	}
 
+	// CSV output, a header line even without torrents
+	if format == OutputCSV {
+		return WriteTorrentCSV(w, rows, opts.Columns)
+	}
+
+	if len(torrents) == 0 {
+		fmt.Fprintln(w, "📭 No torrents found")
+		return nil
+	}
+
 	// JSON output
-	if jsonOutput {
+	if format == OutputJSON {
 		jsonData, err := json.MarshalIndent(rows, "", "  ")
 		if err != nil {
 			return fmt.Errorf("failed to marshal JSON: %w", err)
 		}
-		fmt.Println(string(jsonData))
+		fmt.Fprintln(w, string(jsonData))
 		return nil
 	}
 
+	// Resolve the requested columns
+	names := opts.Columns
+	if len(names) == 0 {
+		names = DefaultTorrentColumns
+	}
+	columns := make([]torrentColumn, 0, len(names))
+	ruleWidth := 0
+	for _, name := range names {
+		column, ok := torrentColumns[name]
+		if !ok {
+			return fmt.Errorf("unknown column '%s' (available: %s)", name, strings.Join(TorrentColumnNames, ", "))
+		}
+		columns = append(columns, column)
+		ruleWidth += column.width + 1
+	}
+
 	// Custom table output with colors
-	fmt.Printf("📊 %s\n\n", ColorHeader.Sprintf("Torrents"))
+	fmt.Fprintf(w, "📊 %s\n\n", ColorHeader.Sprintf("Torrents"))
 
 	// Print header
-	fmt.Printf("%-40s %-8s %-20s %-10s %-10s %s\n",
-		ColorHeader.Sprint("Name"),
-		ColorHeader.Sprint("Size"),
-		ColorHeader.Sprint("Progress"),
-		ColorHeader.Sprint("Speed"),
-		ColorHeader.Sprint("ETA"),
-		ColorHeader.Sprint("State"))
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = ColorHeader.Sprint(column.header)
+	}
+	fmt.Fprintln(w, formatTableLine(columns, headers))
 
-	fmt.Println(strings.Repeat("─", 100))
+	fmt.Fprintln(w, strings.Repeat("─", ruleWidth-1))
 
 	// Add rows with colors
+	values := make([]string, len(columns))
 	for _, row := range rows {
-		// Create progress bar
-		progressBar := CreateProgressBar(row.Progress, 15)
-
-		// Truncate name if too long
-		name := row.Name
-		if len(name) > 37 {
-			name = name[:34] + "..."
+		for i, column := range columns {
+			values[i] = column.value(row)
 		}
+		fmt.Fprintln(w, formatTableLine(columns, values))
+	}
 
-		// Print row with colors
-		fmt.Printf("%-40s %-8s %-20s %-10s %-10s %s\n",
-			name,
-			row.Size,
-			progressBar,
-			row.Speed,
-			row.ETA,
-			row.State)
+	if opts.NoSummary {
+		return nil
 	}
 
-	fmt.Println()
+	fmt.Fprintln(w)
 
 	// Print summary
 	downloading := 0
@@ -296,8 +336,8 @@ func PrintTorrentTable(torrents []*qbittorrent.Torrent, jsonOutput bool) error {
 	}
 
 	// Print summary
-	fmt.Printf("📊 %s\n", ColorHeader.Sprintf("Summary"))
-	fmt.Printf("📥 %s: %d  🌱 %s: %d  ⏸️  %s: %d  ❌ %s: %d  📋 %s: %d\n",
+	fmt.Fprintf(w, "📊 %s\n", ColorHeader.Sprintf("Summary"))
+	fmt.Fprintf(w, "📥 %s: %d  🌱 %s: %d  ⏸️  %s: %d  ❌ %s: %d  📋 %s: %d\n",
 		ColorDownloading.Sprint("Downloading"), downloading,
 		ColorSeeding.Sprint("Seeding"), seeding,
 		ColorPaused.Sprint("Paused"), paused,
@@ -309,16 +349,20 @@ func PrintTorrentTable(torrents []*qbittorrent.Torrent, jsonOutput bool) error {
 
 // DiskSpaceInfo represents disk space information for display
 type DiskSpaceInfo struct {
-	Path        string       `json:"path"`
-	Used        int64        `json:"used_bytes"`
-	Free        int64        `json:"free_bytes"`
-	Total       int64        `json:"total_bytes"`
-	UsedStr     string       `json:"used"`
-	FreeStr     string       `json:"free"`
-	TotalStr    string       `json:"total"`
-	Percentage  float64      `json:"percentage"`
-	HealthColor *color.Color `json:"-"`
-	HealthText  string       `json:"health"`
+	Path        string                `json:"path"`
+	Used        int64                 `json:"used_bytes"`
+	Free        int64                 `json:"free_bytes"`
+	Total       int64                 `json:"total_bytes"`
+	UsedStr     string                `json:"used"`
+	FreeStr     string                `json:"free"`
+	TotalStr    string                `json:"total"`
+	Percentage  float64               `json:"percentage"`
+	HealthColor *color.Color          `json:"-"`
+	HealthText  string                `json:"health"`
+	Health      core.DiskHealthStatus `json:"health_status"`        // Health by the configured thresholds
+	Filesystem  string                `json:"filesystem,omitempty"` // Filesystem type, e.g. ext4 or nfs
+	Paths       []string              `json:"paths,omitempty"`      // Configured paths on this disk
+	Categories  []string              `json:"categories,omitempty"` // Categories stored on this disk
 }
 
 // CreateDiskProgressBar creates a progress bar for disk usage
@@ -336,64 +380,75 @@ func CreateDiskProgressBar(percentage float64, width int) string {
 	return fmt.Sprintf("%s %.1f%%", bar, percentage)
 }
 
-// GetDiskHealthColor returns color based on disk usage percentage
-func GetDiskHealthColor(percentage float64) (*color.Color, string) {
-	switch {
-	case percentage >= 95.0:
-		return ColorError, "🔴 CRITICAL"
-	case percentage >= 90.0:
-		return color.New(color.FgRed), "🟠 LOW SPACE"
-	case percentage >= 80.0:
+// GetDiskHealthColor returns the color and label for a disk health status
+func GetDiskHealthColor(health core.DiskHealthStatus) (*color.Color, string) {
+	switch health {
+	case core.DiskHealthDanger:
+		return ColorError, "🔴 DANGER"
+	case core.DiskHealthCritical:
+		return color.New(color.FgRed), "🟠 CRITICAL"
+	case core.DiskHealthWarning:
 		return color.New(color.FgYellow), "🟡 WARNING"
-	case percentage >= 70.0:
-		return color.New(color.FgBlue), "🔵 MODERATE"
 	default:
 		return ColorSeeding, "🟢 HEALTHY"
 	}
 }
 
-// ConvertDiskSpaceInfo converts raw disk data to display format
-func ConvertDiskSpaceInfo(path string, used, free, total int64) *DiskSpaceInfo {
+// ConvertDiskSpaceInfo converts disk space information from the disk service to display format
+func ConvertDiskSpaceInfo(path string, diskInfo *core.DiskInfo) *DiskSpaceInfo {
 	percentage := 0.0
-	if total > 0 {
-		percentage = (float64(used) / float64(total)) * 100.0
+	if diskInfo.Total > 0 {
+		percentage = (float64(diskInfo.Used) / float64(diskInfo.Total)) * 100.0
 	}
 
-	healthColor, healthText := GetDiskHealthColor(percentage)
+	healthColor, healthText := GetDiskHealthColor(diskInfo.Health)
+	if diskInfo.Total <= 0 {
+		healthColor, healthText = color.New(color.FgHiBlack), "⚪ N/A"
+	}
 
 	return &DiskSpaceInfo{
 		Path:        path,
-		Used:        used,
-		Free:        free,
-		Total:       total,
-		UsedStr:     FormatBytes(used),
-		FreeStr:     FormatBytes(free),
-		TotalStr:    FormatBytes(total),
+		Used:        diskInfo.Used,
+		Free:        diskInfo.Free,
+		Total:       diskInfo.Total,
+		UsedStr:     FormatBytes(diskInfo.Used),
+		FreeStr:     FormatBytes(diskInfo.Free),
+		TotalStr:    FormatBytes(diskInfo.Total),
 		Percentage:  percentage,
 		HealthColor: healthColor,
 		HealthText:  healthText,
+		Health:      diskInfo.Health,
+		Filesystem:  diskInfo.Filesystem,
 	}
 }
 
-// PrintDiskSpaceInfo prints beautiful disk space information
-func PrintDiskSpaceInfo(diskInfos []*DiskSpaceInfo, jsonOutput bool) error {
+// PrintDiskSpaceInfo prints beautiful disk space information to w (stdout when nil),
+// or JSON or CSV depending on format
+func PrintDiskSpaceInfo(w io.Writer, diskInfos []*DiskSpaceInfo, format OutputFormat) error {
+	w = writerOrStdout(w)
+
+	// CSV output, a header line even without disks
+	if format == OutputCSV {
+		return WriteDiskSpaceCSV(w, diskInfos)
+	}
+
 	if len(diskInfos) == 0 {
-		fmt.Println("💾 No disk information available")
+		fmt.Fprintln(w, "💾 No disk information available")
 		return nil
 	}
 
 	// JSON output
-	if jsonOutput {
+	if format == OutputJSON {
 		jsonData, err := json.MarshalIndent(diskInfos, "", "  ")
 		if err != nil {
 			return fmt.Errorf("failed to marshal JSON: %w", err)
 		}
-		fmt.Println(string(jsonData))
+		fmt.Fprintln(w, string(jsonData))
 		return nil
 	}
 
 	// Progress bar output
-	fmt.Printf("💾 %s\n\n", ColorHeader.Sprintf("Disk Space Overview"))
+	fmt.Fprintf(w, "💾 %s\n\n", ColorHeader.Sprintf("Disk Space Overview"))
 
 	totalUsed := int64(0)
 	totalFree := int64(0)
@@ -403,30 +458,48 @@ func PrintDiskSpaceInfo(diskInfos []*DiskSpaceInfo, jsonOutput bool) error {
 
 	for _, info := range diskInfos {
 		// Print path header
-		fmt.Printf("📁 %s\n", ColorHeader.Sprint(info.Path))
+		fmt.Fprintf(w, "📁 %s\n", ColorHeader.Sprint(info.Path))
 
-		// Create progress bar
+		// Create progress bar (paths without a total size have no meaningful percentage)
 		progressBar := CreateDiskProgressBar(info.Percentage, 60)
+		if info.Total <= 0 {
+			progressBar = strings.Repeat(ProgressEmpty, 60) + " N/A"
+		}
 
 		// Print progress bar
-		fmt.Printf("%s\n", progressBar)
+		fmt.Fprintf(w, "%s\n", progressBar)
 
 		// Print details with health color
-		fmt.Printf("%s used • %s free • %s total • %s\n\n",
+		fmt.Fprintf(w, "%s used • %s free • %s total • %s\n",
 			info.UsedStr,
 			info.FreeStr,
 			info.TotalStr,
 			info.HealthColor.Sprint(info.HealthText))
 
+		// Filesystem type matters for moves and reflinks
+		if info.Filesystem != "" {
+			fmt.Fprintf(w, "🗄️  Filesystem: %s\n", info.Filesystem)
+		}
+
+		// Show which configured paths and categories share this disk
+		if len(info.Categories) > 0 {
+			fmt.Fprintf(w, "🏷️  Categories: %s\n", strings.Join(info.Categories, ", "))
+		}
+		if len(info.Paths) > 1 || (len(info.Paths) == 1 && info.Paths[0] != info.Path) {
+			fmt.Fprintf(w, "📂 Paths: %s\n", strings.Join(info.Paths, ", "))
+		}
+		fmt.Fprintln(w)
+
 		// Accumulate totals
 		totalUsed += info.Used
 		totalFree += info.Free
 		totalSpace += info.Total
 
 		// Count health status
-		if info.Percentage >= 95.0 {
+		switch info.Health {
+		case core.DiskHealthCritical, core.DiskHealthDanger:
 			criticalCount++
-		} else if info.Percentage >= 80.0 {
+		case core.DiskHealthWarning:
 			warningCount++
 		}
 	}
@@ -438,74 +511,136 @@ func PrintDiskSpaceInfo(diskInfos []*DiskSpaceInfo, jsonOutput bool) error {
 			overallPercentage = (float64(totalUsed) / float64(totalSpace)) * 100.0
 		}
 
-		// Get overall health status
-		_, _ = GetDiskHealthColor(overallPercentage)
-
-		fmt.Printf("📊 %s\n", ColorHeader.Sprintf("Summary"))
-		fmt.Printf("💾 Total: %s used • %s free • %s total (%.1f%%)\n",
+		fmt.Fprintf(w, "📊 %s\n", ColorHeader.Sprintf("Summary"))
+		fmt.Fprintf(w, "💾 Total: %s used • %s free • %s total (%.1f%%)\n",
 			FormatBytes(totalUsed),
 			FormatBytes(totalFree),
 			FormatBytes(totalSpace),
 			overallPercentage)
 
 		if criticalCount > 0 {
-			fmt.Printf("🔴 %s: %d critical paths\n", ColorError.Sprint("ATTENTION"), criticalCount)
+			fmt.Fprintf(w, "🔴 %s: %d critical paths\n", ColorError.Sprint("ATTENTION"), criticalCount)
 		}
 		if warningCount > 0 {
-			fmt.Printf("🟡 %s: %d paths need attention\n", color.New(color.FgYellow).Sprint("WARNING"), warningCount)
+			fmt.Fprintf(w, "🟡 %s: %d paths need attention\n", color.New(color.FgYellow).Sprint("WARNING"), warningCount)
 		}
 		if criticalCount == 0 && warningCount == 0 {
-			fmt.Printf("🟢 %s: All paths healthy\n", ColorSeeding.Sprint("STATUS"))
+			fmt.Fprintf(w, "🟢 %s: All paths healthy\n", ColorSeeding.Sprint("STATUS"))
 		}
 	}
 
 	return nil
 }
 
+// PrintDiskSummary prints the aggregate totals of the configured paths and the
+// paths that need attention to w (stdout when nil), or the summary as JSON.
+// configured lists every configured path, so the ones that could not be
+// checked can be pointed out.
+func PrintDiskSummary(w io.Writer, summary *core.DiskSummary, configured []string, format OutputFormat) error {
+	w = writerOrStdout(w)
+
+	if format == OutputCSV {
+		return fmt.Errorf("the disk summary supports table and json output")
+	}
+
+	// JSON output
+	if format == OutputJSON {
+		jsonData, err := json.MarshalIndent(summary, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Fprintln(w, string(jsonData))
+		return nil
+	}
+
+	fmt.Fprintf(w, "💾 %s\n\n", ColorHeader.Sprintf("Disk Space Summary"))
+
+	percentage := 0.0
+	if summary.TotalSpace > 0 {
+		percentage = (float64(summary.TotalUsed) / float64(summary.TotalSpace)) * 100.0
+	}
+	fmt.Fprintf(w, "💾 Total: %s used • %s free • %s total (%.1f%%)\n",
+		FormatBytes(summary.TotalUsed),
+		FormatBytes(summary.TotalFree),
+		FormatBytes(summary.TotalSpace),
+		percentage)
+
+	healthColor, healthText := GetDiskHealthColor(summary.WorstHealth)
+	fmt.Fprintf(w, "🩺 Worst Health: %s\n", healthColor.Sprint(healthText))
+	fmt.Fprintf(w, "📂 Paths Checked: %d of %d\n", len(summary.Paths), len(configured))
+
+	var unchecked []string
+	for _, path := range configured {
+		if _, ok := summary.Paths[path]; !ok {
+			unchecked = append(unchecked, path)
+		}
+	}
+	printDiskPathList(w, "❓", ColorPaused.Sprint("UNCHECKED"), unchecked)
+	printDiskPathList(w, "🔴", ColorError.Sprint("CRITICAL"), summary.CriticalPaths)
+	printDiskPathList(w, "🟡", color.New(color.FgYellow).Sprint("WARNING"), summary.WarningPaths)
+
+	if len(summary.CriticalPaths) == 0 && len(summary.WarningPaths) == 0 {
+		fmt.Fprintf(w, "\n🟢 %s: All paths healthy\n", ColorSeeding.Sprint("STATUS"))
+	}
+
+	return nil
+}
+
+// printDiskPathList prints a labelled list of paths, or nothing when there are none
+func printDiskPathList(w io.Writer, icon, label string, paths []string) {
+	if len(paths) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\n%s %s: %d path(s)\n", icon, label, len(paths))
+	for _, path := range paths {
+		fmt.Fprintf(w, "   • %s\n", path)
+	}
+}
+
 // ValidateMagnetURI validates a magnet URI format
 func ValidateMagnetURI(magnetURI string) error {
 	if magnetURI == "" {
-		return fmt.Errorf("magnet URI cannot be empty")
+		return fmt.Errorf("%w: magnet URI cannot be empty", core.ErrInvalidMagnet)
 	}
 
 	// Check if it starts with magnet:
 	if !strings.HasPrefix(magnetURI, "magnet:") {
-		return fmt.Errorf("invalid magnet URI: must start with 'magnet:'")
+		return fmt.Errorf("%w: must start with 'magnet:'", core.ErrInvalidMagnet)
 	}
 
 	// Parse as URL to validate structure
 	parsedURL, err := url.Parse(magnetURI)
 	if err != nil {
-		return fmt.Errorf("invalid magnet URI format: %w", err)
+		return fmt.Errorf("%w: %w", core.ErrInvalidMagnet, err)
 	}
 
 	// Check for required xt parameter (exact topic - the hash)
 	query := parsedURL.Query()
 	if !query.Has("xt") {
-		return fmt.Errorf("invalid magnet URI: missing 'xt' parameter (info hash)")
+		return fmt.Errorf("%w: missing 'xt' parameter (info hash)", core.ErrInvalidMagnet)
 	}
 
 	xt := query.Get("xt")
 	if !strings.HasPrefix(xt, "urn:btih:") {
-		return fmt.Errorf("invalid magnet URI: 'xt' parameter must start with 'urn:btih:'")
+		return fmt.Errorf("%w: 'xt' parameter must start with 'urn:btih:'", core.ErrInvalidMagnet)
 	}
 
 	// Extract hash and validate length
 	hash := strings.TrimPrefix(xt, "urn:btih:")
 	if len(hash) != 32 && len(hash) != 40 {
-		return fmt.Errorf("invalid magnet URI: info hash must be 32 or 40 characters (got %d)", len(hash))
+		return fmt.Errorf("%w: info hash must be 32 or 40 characters (got %d)", core.ErrInvalidMagnet, len(hash))
 	}
 
 	return nil
 }
 
-// ValidateCategory validates a torrent category
-func ValidateCategory(category string) error {
+// ValidateCategory validates a torrent category against the configured categories
+func ValidateCategory(category string, categories []config.CategoryOption) error {
 	if category == "" {
 		return nil // Empty category is allowed (uses default)
 	}
 
-	validCategories := []string{"series", "movies", "anime"}
+	validCategories := config.CategoryNames(categories)
 	categoryLower := strings.ToLower(category)
 
 	for _, valid := range validCategories {
@@ -514,7 +649,7 @@ func ValidateCategory(category string) error {
 		}
 	}
 
-	return fmt.Errorf("invalid category '%s'. Valid categories: %v", category, validCategories)
+	return fmt.Errorf("%w '%s'. Valid categories: %v", core.ErrInvalidCategory, category, validCategories)
 }
 
 // ExtractMagnetInfo extracts useful information from a magnet URI
@@ -560,86 +695,117 @@ type MagnetInfo struct {
 	OriginalURI string   `json:"original_uri"`
 }
 
-// PrintAddResult prints the result of adding a torrent
-func PrintAddResult(success bool, magnetInfo *MagnetInfo, category, customPath string, err error) {
+// PrintAddResult prints the result of adding a torrent to w (stdout when nil)
+//
+// When torrent is non-nil, the name, size, category and save path that
+// qBittorrent assigned are shown; otherwise the magnet info and requested
+// options are used.
+func PrintAddResult(w io.Writer, success bool, magnetInfo *MagnetInfo, torrent *qbittorrent.Torrent, category, customPath string, err error) {
+	w = writerOrStdout(w)
+
 	if !success {
-		fmt.Printf("❌ %s\n", ColorError.Sprintf("Failed to add torrent"))
+		fmt.Fprintf(w, "❌ %s\n", ColorError.Sprintf("Failed to add torrent"))
 		if err != nil {
-			fmt.Printf("   Error: %v\n", err)
+			fmt.Fprintf(w, "   Error: %v\n", err)
 		}
 		return
 	}
 
-	fmt.Printf("✅ %s\n\n", ColorSeeding.Sprintf("Torrent added successfully!"))
+	fmt.Fprintf(w, "✅ %s\n\n", ColorSeeding.Sprintf("Torrent added successfully!"))
 
 	// Show torrent details
-	fmt.Printf("📋 %s\n", ColorHeader.Sprintf("Torrent Details"))
-	fmt.Printf("   Name: %s\n", magnetInfo.DisplayName)
-	fmt.Printf("   Hash: %s\n", magnetInfo.Hash)
+	name := magnetInfo.DisplayName
+	hash := magnetInfo.Hash
+	savePath := customPath
+	if torrent != nil {
+		if torrent.Name != "" {
+			name = torrent.Name
+		}
+		if torrent.Hash != "" {
+			hash = torrent.Hash
+		}
+		if torrent.Category != "" {
+			category = torrent.Category
+		}
+		if torrent.SavePath != "" {
+			savePath = torrent.SavePath
+		}
+	}
+
+	fmt.Fprintf(w, "📋 %s\n", ColorHeader.Sprintf("Torrent Details"))
+	fmt.Fprintf(w, "   Name: %s\n", name)
+	fmt.Fprintf(w, "   Hash: %s\n", hash)
+
+	if torrent != nil {
+		if torrent.Size > 0 {
+			fmt.Fprintf(w, "   Size: %s\n", FormatBytes(torrent.Size))
+		} else {
+			fmt.Fprintf(w, "   Size: Unknown (waiting for metadata)\n")
+		}
+	}
 
 	if category != "" {
-		fmt.Printf("   Category: %s\n", category)
+		fmt.Fprintf(w, "   Category: %s\n", category)
 	}
 
-	if customPath != "" {
-		fmt.Printf("   Save Path: %s\n", customPath)
+	if savePath != "" {
+		fmt.Fprintf(w, "   Save Path: %s\n", savePath)
 	}
 
 	if len(magnetInfo.Trackers) > 0 {
-		fmt.Printf("   Trackers: %d found\n", len(magnetInfo.Trackers))
+		fmt.Fprintf(w, "   Trackers: %d found\n", len(magnetInfo.Trackers))
 		for i, tracker := range magnetInfo.Trackers {
 			if i >= 3 { // Show only first 3 trackers
-				fmt.Printf("   ... and %d more\n", len(magnetInfo.Trackers)-3)
+				fmt.Fprintf(w, "   ... and %d more\n", len(magnetInfo.Trackers)-3)
 				break
 			}
 			// Truncate long tracker URLs
-			if len(tracker) > 60 {
-				tracker = tracker[:57] + "..."
-			}
-			fmt.Printf("   • %s\n", tracker)
+			fmt.Fprintf(w, "   • %s\n", TruncateString(tracker, 60))
 		}
 	}
 
-	fmt.Printf("\n💡 Use '%s' to check download progress\n", ColorDownloading.Sprint("akira list"))
+	fmt.Fprintf(w, "\n💡 Use '%s' to check download progress\n", ColorDownloading.Sprint("akira list"))
 }
 
-// PrintDeleteConfirmation prints a confirmation prompt for torrent deletion
-func PrintDeleteConfirmation(torrents []*qbittorrent.Torrent, deleteFiles bool) bool {
+// PrintDeleteConfirmation prints a confirmation prompt for torrent deletion to w (stdout when nil)
+func PrintDeleteConfirmation(w io.Writer, torrents []*qbittorrent.Torrent, deleteFiles bool) bool {
+	w = writerOrStdout(w)
+
 	if len(torrents) == 0 {
-		fmt.Println("❌ No torrents found to delete")
+		fmt.Fprintln(w, "❌ No torrents found to delete")
 		return false
 	}
 
-	fmt.Printf("⚠️  %s\n\n", ColorError.Sprintf("DELETION CONFIRMATION"))
+	fmt.Fprintf(w, "⚠️  %s\n\n", ColorError.Sprintf("DELETION CONFIRMATION"))
 
 	if len(torrents) == 1 {
 		torrent := torrents[0]
-		fmt.Printf("📋 Torrent to delete:\n")
-		fmt.Printf("   Name: %s\n", torrent.Name)
-		fmt.Printf("   Hash: %s\n", torrent.Hash)
-		fmt.Printf("   Size: %s\n", FormatBytes(torrent.Size))
-		fmt.Printf("   State: %s %s\n", GetStateIcon(string(torrent.State)), GetStateName(string(torrent.State)))
+		fmt.Fprintf(w, "📋 Torrent to delete:\n")
+		fmt.Fprintf(w, "   Name: %s\n", torrent.Name)
+		fmt.Fprintf(w, "   Hash: %s\n", torrent.Hash)
+		fmt.Fprintf(w, "   Size: %s\n", FormatBytes(torrent.Size))
+		fmt.Fprintf(w, "   State: %s %s\n", GetStateIcon(string(torrent.State)), GetStateName(string(torrent.State)))
 	} else {
-		fmt.Printf("📋 %d torrents to delete:\n", len(torrents))
+		fmt.Fprintf(w, "📋 %d torrents to delete:\n", len(torrents))
 		for i, torrent := range torrents {
 			if i >= 5 { // Show only first 5
-				fmt.Printf("   ... and %d more torrents\n", len(torrents)-5)
+				fmt.Fprintf(w, "   ... and %d more torrents\n", len(torrents)-5)
 				break
 			}
-			fmt.Printf("   • %s (%s)\n", torrent.Name, FormatBytes(torrent.Size))
+			fmt.Fprintf(w, "   • %s (%s)\n", torrent.Name, FormatBytes(torrent.Size))
 		}
 	}
 
-	fmt.Printf("\n🗑️  Action: ")
+	fmt.Fprintf(w, "\n🗑️  Action: ")
 	if deleteFiles {
-		fmt.Printf("%s\n", ColorError.Sprint("DELETE TORRENTS AND FILES"))
-		fmt.Printf("   ⚠️  This will permanently delete all downloaded files!\n")
+		fmt.Fprintf(w, "%s\n", ColorError.Sprint("DELETE TORRENTS AND FILES"))
+		fmt.Fprintf(w, "   ⚠️  This will permanently delete all downloaded files!\n")
 	} else {
-		fmt.Printf("%s\n", ColorDownloading.Sprint("DELETE TORRENTS ONLY"))
-		fmt.Printf("   ℹ️  Downloaded files will be kept on disk\n")
+		fmt.Fprintf(w, "%s\n", ColorDownloading.Sprint("DELETE TORRENTS ONLY"))
+		fmt.Fprintf(w, "   ℹ️  Downloaded files will be kept on disk\n")
 	}
 
-	fmt.Printf("\n❓ Are you sure you want to continue? (y/N): ")
+	fmt.Fprintf(w, "\n❓ Are you sure you want to continue? (y/N): ")
 
 	var response string
 	fmt.Scanln(&response)
@@ -648,10 +814,12 @@ func PrintDeleteConfirmation(torrents []*qbittorrent.Torrent, deleteFiles bool)
 	return response == "y" || response == "yes"
 }
 
-// PrintDeleteResult prints the result of torrent deletion
-func PrintDeleteResult(successful []string, failed map[string]error, deleteFiles bool) {
+// PrintDeleteResult prints the result of torrent deletion to w (stdout when nil)
+func PrintDeleteResult(w io.Writer, successful []string, failed map[string]error, deleteFiles bool) {
+	w = writerOrStdout(w)
+
 	if len(successful) == 0 && len(failed) == 0 {
-		fmt.Println("❌ No torrents were processed")
+		fmt.Fprintln(w, "❌ No torrents were processed")
 		return
 	}
 
@@ -662,28 +830,28 @@ func PrintDeleteResult(successful []string, failed map[string]error, deleteFiles
 			actionText = "deleted (with files)"
 		}
 
-		fmt.Printf("✅ %s\n\n", ColorSeeding.Sprintf("Successfully %s %d torrent(s)", actionText, len(successful)))
+		fmt.Fprintf(w, "✅ %s\n\n", ColorSeeding.Sprintf("Successfully %s %d torrent(s)", actionText, len(successful)))
 
 		for i, hash := range successful {
 			if i >= 5 { // Show only first 5
-				fmt.Printf("   ... and %d more\n", len(successful)-5)
+				fmt.Fprintf(w, "   ... and %d more\n", len(successful)-5)
 				break
 			}
-			fmt.Printf("   ✓ %s\n", hash[:16]+"...") // Show first 16 chars of hash
+			fmt.Fprintf(w, "   ✓ %s\n", hash[:16]+"...") // Show first 16 chars of hash
 		}
 	}
 
 	// Print failed deletions
 	if len(failed) > 0 {
-		fmt.Printf("\n❌ %s\n\n", ColorError.Sprintf("Failed to delete %d torrent(s)", len(failed)))
+		fmt.Fprintf(w, "\n❌ %s\n\n", ColorError.Sprintf("Failed to delete %d torrent(s)", len(failed)))
 
 		i := 0
 		for hash, err := range failed {
 			if i >= 5 { // Show only first 5
-				fmt.Printf("   ... and %d more errors\n", len(failed)-5)
+				fmt.Fprintf(w, "   ... and %d more errors\n", len(failed)-5)
 				break
 			}
-			fmt.Printf("   ✗ %s: %v\n", hash[:16]+"...", err)
+			fmt.Fprintf(w, "   ✗ %s: %v\n", hash[:16]+"...", err)
 			i++
 		}
 	}
@@ -691,10 +859,10 @@ func PrintDeleteResult(successful []string, failed map[string]error, deleteFiles
 	// Summary
 	total := len(successful) + len(failed)
 	if len(successful) > 0 && len(failed) == 0 {
-		fmt.Printf("\n🎉 All %d torrent(s) deleted successfully!\n", total)
+		fmt.Fprintf(w, "\n🎉 All %d torrent(s) deleted successfully!\n", total)
 	} else if len(successful) == 0 && len(failed) > 0 {
-		fmt.Printf("\n💥 All %d deletion(s) failed\n", total)
+		fmt.Fprintf(w, "\n💥 All %d deletion(s) failed\n", total)
 	} else if len(successful) > 0 && len(failed) > 0 {
-		fmt.Printf("\n⚠️  Partial success: %d succeeded, %d failed\n", len(successful), len(failed))
+		fmt.Fprintf(w, "\n⚠️  Partial success: %d succeeded, %d failed\n", len(successful), len(failed))
 	}
 }
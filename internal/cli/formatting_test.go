@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+func TestTruncateString(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		maxLen int
+		want   string
+	}{
+		{name: "short ASCII", input: "Ubuntu", maxLen: 10, want: "Ubuntu"},
+		{name: "exact ASCII", input: "Ubuntu", maxLen: 6, want: "Ubuntu"},
+		{name: "long ASCII", input: "Ubuntu 24.04 Desktop", maxLen: 10, want: "Ubuntu ..."},
+		{name: "CJK fits in runes", input: "進撃の巨人", maxLen: 5, want: "進撃の巨人"},
+		{name: "CJK", input: "進撃の巨人 第1話", maxLen: 6, want: "進撃の..."},
+		{name: "emoji", input: "🎬🎥📺🍿🎞️", maxLen: 5, want: "🎬🎥..."},
+		{name: "mixed", input: "Movie 映画 🎬 2024", maxLen: 9, want: "Movie ..."},
+		{name: "cut right after CJK", input: "ab映画cdefgh", maxLen: 7, want: "ab映画..."},
+		{name: "tiny limit", input: "進撃の巨人", maxLen: 2, want: "進撃"},
+		{name: "zero limit", input: "進撃の巨人", maxLen: 0, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateString(tt.input, tt.maxLen)
+			if got != tt.want {
+				t.Errorf("TruncateString(%q, %d) = %q, want %q", tt.input, tt.maxLen, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("TruncateString(%q, %d) = %q is not valid UTF-8", tt.input, tt.maxLen, got)
+			}
+			if n := utf8.RuneCountInString(got); n > tt.maxLen {
+				t.Errorf("TruncateString(%q, %d) has %d runes, want at most %d", tt.input, tt.maxLen, n, tt.maxLen)
+			}
+		})
+	}
+}
+
+func TestConvertTorrentToTableRowPartialSelectionETA(t *testing.T) {
+	// 10 GiB torrent with only 4 GiB of files selected, 1 GiB of them left
+	torrent := &qbittorrent.Torrent{
+		Name:       "Partial",
+		TotalSize:  10 << 30,
+		Size:       4 << 30,
+		AmountLeft: 1 << 30,
+		Progress:   0.75,
+		Dlspeed:    1 << 20,
+		State:      qbittorrent.StateDownloading,
+	}
+
+	row := ConvertTorrentToTableRow(torrent)
+	if row.ETA != "17m 4s" {
+		t.Errorf("ETA = %q, want %q (1 GiB left at 1 MiB/s)", row.ETA, "17m 4s")
+	}
+	if row.Size != "4.0 GB" {
+		t.Errorf("Size = %q, want the selected size %q", row.Size, "4.0 GB")
+	}
+}
+
+func TestConvertDiskSpaceInfoZeroTotal(t *testing.T) {
+	info := ConvertDiskSpaceInfo("/mnt/empty", &core.DiskInfo{Path: "/mnt/empty", Health: core.DiskHealthGood})
+
+	if math.IsNaN(info.Percentage) || math.IsInf(info.Percentage, 0) || info.Percentage != 0 {
+		t.Errorf("Percentage = %v, want 0", info.Percentage)
+	}
+	if info.HealthText != "⚪ N/A" {
+		t.Errorf("HealthText = %q, want %q", info.HealthText, "⚪ N/A")
+	}
+
+	var buf bytes.Buffer
+	if err := PrintDiskSpaceInfo(&buf, []*DiskSpaceInfo{info}, OutputTable); err != nil {
+		t.Fatalf("PrintDiskSpaceInfo() error = %v", err)
+	}
+	if out := buf.String(); strings.Contains(out, "NaN") || !strings.Contains(out, "N/A") {
+		t.Errorf("PrintDiskSpaceInfo() output should show N/A without NaN, got:\n%s", out)
+	}
+}
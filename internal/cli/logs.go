@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+
+	"github.com/raainshe/akira/internal/logging"
+)
+
+// GetLogLevelColor returns the color used for a normalized log level
+func GetLogLevelColor(level string) *color.Color {
+	switch level {
+	case "error", "fatal", "panic":
+		return ColorError
+	case "warning":
+		return ColorPaused
+	case "info":
+		return ColorDownloading
+	default:
+		return color.New(color.FgHiBlack)
+	}
+}
+
+// FormatLogEntry formats a log entry as a single color-coded line
+func FormatLogEntry(entry logging.LogEntry) string {
+	// Lines the reader could not parse only carry a message
+	if entry.Level == "" {
+		return entry.Message
+	}
+
+	var b strings.Builder
+	if !entry.Time.IsZero() {
+		b.WriteString(entry.Time.Format("2006-01-02 15:04:05") + " ")
+	}
+	b.WriteString(GetLogLevelColor(entry.Level).Sprintf("%-7s", strings.ToUpper(entry.Level)))
+	if entry.Component != "" {
+		fmt.Fprintf(&b, " [%s]", entry.Component)
+	}
+	b.WriteString(" " + entry.Message)
+
+	// Append the remaining fields in a stable order
+	keys := make([]string, 0, len(entry.Fields))
+	for key := range entry.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&b, " %s=%v", ColorHeader.Sprint(key), entry.Fields[key])
+	}
+
+	return b.String()
+}
+
+// PrintLogEntries prints log entries to w (stdout when nil), one per line
+func PrintLogEntries(w io.Writer, entries []logging.LogEntry) {
+	w = writerOrStdout(w)
+
+	for _, entry := range entries {
+		fmt.Fprintln(w, FormatLogEntry(entry))
+	}
+}
@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// OutputFormat selects how list-style commands print their results
+type OutputFormat string
+
+const (
+	OutputTable OutputFormat = "table" // Colored table for people
+	OutputJSON  OutputFormat = "json"  // Indented JSON for scripts
+	OutputCSV   OutputFormat = "csv"   // Comma-separated values for spreadsheets
+)
+
+// OutputFormatNames lists the accepted --output values
+var OutputFormatNames = []string{string(OutputTable), string(OutputJSON), string(OutputCSV)}
+
+// ParseOutputFormat parses an --output value; an empty value selects the table
+func ParseOutputFormat(value string) (OutputFormat, error) {
+	switch format := OutputFormat(strings.ToLower(strings.TrimSpace(value))); format {
+	case "":
+		return OutputTable, nil
+	case OutputTable, OutputJSON, OutputCSV:
+		return format, nil
+	}
+	return "", fmt.Errorf("invalid output format '%s' (must be one of: %s)", value, strings.Join(OutputFormatNames, ", "))
+}
+
+// JSONOrTable returns OutputJSON when jsonOutput is set and OutputTable otherwise
+func JSONOrTable(jsonOutput bool) OutputFormat {
+	if jsonOutput {
+		return OutputJSON
+	}
+	return OutputTable
+}
+
+// torrentCSVValues are the unformatted values written for each torrent column in CSV output
+var torrentCSVValues = map[string]func(row *TorrentTableRow) string{
+	"name":     func(row *TorrentTableRow) string { return row.Name },
+	"size":     func(row *TorrentTableRow) string { return row.Size },
+	"progress": func(row *TorrentTableRow) string { return strconv.FormatFloat(row.Progress*100, 'f', 1, 64) },
+	"speed":    func(row *TorrentTableRow) string { return row.Speed },
+	"eta":      func(row *TorrentTableRow) string { return row.ETA },
+	"state":    func(row *TorrentTableRow) string { return row.State },
+	"ratio":    func(row *TorrentTableRow) string { return strconv.FormatFloat(row.Ratio, 'f', 2, 64) },
+	"category": func(row *TorrentTableRow) string { return row.Category },
+	"hash":     func(row *TorrentTableRow) string { return row.Hash },
+	"age":      func(row *TorrentTableRow) string { return row.Age },
+}
+
+// WriteTorrentCSV writes torrent rows as CSV with a header line. columns selects
+// the columns in order; without columns every column is written. Progress is
+// a percentage.
+func WriteTorrentCSV(w io.Writer, rows []*TorrentTableRow, columns []string) error {
+	if len(columns) == 0 {
+		columns = TorrentColumnNames
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(columns); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	record := make([]string, len(columns))
+	for _, row := range rows {
+		for i, name := range columns {
+			value, ok := torrentCSVValues[name]
+			if !ok {
+				return fmt.Errorf("unknown column '%s' (available: %s)", name, strings.Join(TorrentColumnNames, ", "))
+			}
+			record[i] = value(row)
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+// WriteDiskSpaceCSV writes disk space information as CSV with a header line.
+// Sizes are in bytes; paths and categories sharing a disk are separated by ';'.
+func WriteDiskSpaceCSV(w io.Writer, diskInfos []*DiskSpaceInfo) error {
+	writer := csv.NewWriter(w)
+	header := []string{"path", "used_bytes", "free_bytes", "total_bytes", "percentage", "health", "paths", "categories", "filesystem"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	for _, info := range diskInfos {
+		record := []string{
+			info.Path,
+			strconv.FormatInt(info.Used, 10),
+			strconv.FormatInt(info.Free, 10),
+			strconv.FormatInt(info.Total, 10),
+			strconv.FormatFloat(info.Percentage, 'f', 1, 64),
+			info.HealthText,
+			strings.Join(info.Paths, ";"),
+			strings.Join(info.Categories, ";"),
+			info.Filesystem,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
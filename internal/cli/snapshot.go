@@ -0,0 +1,199 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// TorrentChange describes how a single torrent differs between two snapshots
+type TorrentChange struct {
+	Hash          string                   `json:"hash"`
+	Name          string                   `json:"name"`
+	OldState      qbittorrent.TorrentState `json:"old_state,omitempty"`
+	NewState      qbittorrent.TorrentState `json:"new_state,omitempty"`
+	OldProgress   float64                  `json:"old_progress"`
+	NewProgress   float64                  `json:"new_progress"`
+	StateChanged  bool                     `json:"state_changed"`
+	ProgressDelta float64                  `json:"progress_delta"`
+}
+
+// SnapshotDiff holds the differences between two torrent snapshots
+type SnapshotDiff struct {
+	Added     []qbittorrent.Torrent `json:"added"`
+	Removed   []qbittorrent.Torrent `json:"removed"`
+	Completed []qbittorrent.Torrent `json:"completed"`
+	Changed   []TorrentChange       `json:"changed"`
+}
+
+// HasChanges returns true if the diff contains any differences
+func (d *SnapshotDiff) HasChanges() bool {
+	return len(d.Added) > 0 || len(d.Removed) > 0 || len(d.Completed) > 0 || len(d.Changed) > 0
+}
+
+// SaveSnapshot writes the full torrent JSON to a snapshot file
+func SaveSnapshot(path string, torrents []qbittorrent.Torrent) error {
+	if torrents == nil {
+		torrents = []qbittorrent.Torrent{}
+	}
+
+	jsonData, err := json.MarshalIndent(torrents, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	if err := os.WriteFile(path, jsonData, 0644); err != nil {
+		return fmt.Errorf("failed to write snapshot file: %w", err)
+	}
+
+	return nil
+}
+
+// LoadSnapshot reads torrents from a snapshot file created by SaveSnapshot
+func LoadSnapshot(path string) ([]qbittorrent.Torrent, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot file: %w", err)
+	}
+
+	var torrents []qbittorrent.Torrent
+	if err := json.Unmarshal(data, &torrents); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot file '%s': %w", path, err)
+	}
+
+	return torrents, nil
+}
+
+// DiffSnapshots compares two torrent lists and reports what changed from old to new
+func DiffSnapshots(oldTorrents, newTorrents []qbittorrent.Torrent) *SnapshotDiff {
+	diff := &SnapshotDiff{
+		Added:     []qbittorrent.Torrent{},
+		Removed:   []qbittorrent.Torrent{},
+		Completed: []qbittorrent.Torrent{},
+		Changed:   []TorrentChange{},
+	}
+
+	oldByHash := make(map[string]qbittorrent.Torrent, len(oldTorrents))
+	for _, torrent := range oldTorrents {
+		oldByHash[torrent.Hash] = torrent
+	}
+
+	newByHash := make(map[string]qbittorrent.Torrent, len(newTorrents))
+	for _, torrent := range newTorrents {
+		newByHash[torrent.Hash] = torrent
+
+		oldTorrent, existed := oldByHash[torrent.Hash]
+		if !existed {
+			diff.Added = append(diff.Added, torrent)
+			continue
+		}
+
+		if !oldTorrent.IsCompleted() && torrent.IsCompleted() {
+			diff.Completed = append(diff.Completed, torrent)
+		}
+
+		stateChanged := oldTorrent.State != torrent.State
+		progressDelta := torrent.Progress - oldTorrent.Progress
+		if stateChanged || progressDelta != 0 {
+			diff.Changed = append(diff.Changed, TorrentChange{
+				Hash:          torrent.Hash,
+				Name:          torrent.Name,
+				OldState:      oldTorrent.State,
+				NewState:      torrent.State,
+				OldProgress:   oldTorrent.Progress,
+				NewProgress:   torrent.Progress,
+				StateChanged:  stateChanged,
+				ProgressDelta: progressDelta,
+			})
+		}
+	}
+
+	for _, torrent := range oldTorrents {
+		if _, exists := newByHash[torrent.Hash]; !exists {
+			diff.Removed = append(diff.Removed, torrent)
+		}
+	}
+
+	// Keep output stable regardless of API ordering
+	sortByName := func(torrents []qbittorrent.Torrent) {
+		sort.SliceStable(torrents, func(i, j int) bool { return torrents[i].Name < torrents[j].Name })
+	}
+	sortByName(diff.Added)
+	sortByName(diff.Removed)
+	sortByName(diff.Completed)
+	sort.SliceStable(diff.Changed, func(i, j int) bool { return diff.Changed[i].Name < diff.Changed[j].Name })
+
+	return diff
+}
+
+// PrintSnapshotDiff prints a snapshot diff to w (stdout when nil)
+func PrintSnapshotDiff(w io.Writer, diff *SnapshotDiff, jsonOutput bool) error {
+	w = writerOrStdout(w)
+
+	// JSON output
+	if jsonOutput {
+		jsonData, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Fprintln(w, string(jsonData))
+		return nil
+	}
+
+	fmt.Fprintf(w, "🔀 %s\n\n", ColorHeader.Sprintf("Changes Since Snapshot"))
+
+	if !diff.HasChanges() {
+		fmt.Fprintln(w, "✨ No changes detected")
+		return nil
+	}
+
+	if len(diff.Added) > 0 {
+		fmt.Fprintf(w, "➕ %s\n", ColorSeeding.Sprintf("Added (%d)", len(diff.Added)))
+		for _, torrent := range diff.Added {
+			fmt.Fprintf(w, "   • %s (%s)\n", torrent.Name, FormatBytes(torrent.Size))
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(diff.Removed) > 0 {
+		fmt.Fprintf(w, "➖ %s\n", ColorError.Sprintf("Removed (%d)", len(diff.Removed)))
+		for _, torrent := range diff.Removed {
+			fmt.Fprintf(w, "   • %s (%s)\n", torrent.Name, FormatBytes(torrent.Size))
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(diff.Completed) > 0 {
+		fmt.Fprintf(w, "✅ %s\n", ColorCompleted.Sprintf("Completed (%d)", len(diff.Completed)))
+		for _, torrent := range diff.Completed {
+			fmt.Fprintf(w, "   • %s\n", torrent.Name)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if len(diff.Changed) > 0 {
+		fmt.Fprintf(w, "🔄 %s\n", ColorDownloading.Sprintf("Changed (%d)", len(diff.Changed)))
+		for _, change := range diff.Changed {
+			fmt.Fprintf(w, "   • %s\n", change.Name)
+			if change.StateChanged {
+				fmt.Fprintf(w, "     State: %s %s → %s %s\n",
+					GetStateIcon(string(change.OldState)), GetStateName(string(change.OldState)),
+					GetStateIcon(string(change.NewState)), GetStateName(string(change.NewState)))
+			}
+			if change.ProgressDelta != 0 {
+				fmt.Fprintf(w, "     Progress: %.1f%% → %.1f%% (%+.1f%%)\n",
+					change.OldProgress*100, change.NewProgress*100, change.ProgressDelta*100)
+			}
+		}
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintf(w, "📊 %d added • %d removed • %d completed • %d changed\n",
+		len(diff.Added), len(diff.Removed), len(diff.Completed), len(diff.Changed))
+
+	return nil
+}
@@ -2,7 +2,11 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"path"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -18,22 +22,51 @@ type Config struct {
 	Logging     LoggingConfig     `json:"logging"`
 	Seeding     SeedingConfig     `json:"seeding"`
 	Proxy       ProxyConfig       `json:"proxy"`
+	TUI         TUIConfig         `json:"tui"`
+	UI          UIConfig          `json:"ui"`
+	Server      ServerConfig      `json:"server"`
+	Disk        DiskConfig        `json:"disk"`
+	Metrics     MetricsConfig     `json:"metrics"`
+
+	// Webhook notified when a tracked torrent finishes downloading
+	CompletionWebhook CompletionWebhookConfig `json:"completion_webhook"`
+
+	// Tracker hosts whose torrents are private; torrents from any other tracker are public
+	PrivateTrackers []string `json:"private_trackers"`
+
+	// Torrent categories users can choose from, see Categories
+	CategoryOptions []CategoryOption `json:"categories"`
 }
 
 // DiscordConfig holds Discord bot configuration
 type DiscordConfig struct {
-	BotToken string   `json:"bot_token"`
-	GuildIDs []string `json:"guild_ids"`
+	BotToken              string        `json:"bot_token"`
+	GuildIDs              []string      `json:"guild_ids"`
+	NotificationChannelID string        `json:"notification_channel_id"` // channel completions and disk alerts are posted to, empty disables them
+	DeleteConfirmTimeout  time.Duration `json:"delete_confirm_timeout"`  // how long /delete confirmation buttons stay usable
 }
 
 // QBittorrentConfig holds qBittorrent client configuration
 type QBittorrentConfig struct {
-	URL                string          `json:"url"`
-	Username           string          `json:"username"`
-	Password           string          `json:"password"`
-	SavePaths          SavePathsConfig `json:"save_paths"`
-	DiskSpaceCheckPath string          `json:"disk_space_check_path"`
-	RequestTimeout     time.Duration   `json:"request_timeout"`
+	URL                  string          `json:"url"`
+	Username             string          `json:"username"`
+	Password             string          `json:"password"`
+	SavePaths            SavePathsConfig `json:"save_paths"`
+	DiskSpaceCheckPath   string          `json:"disk_space_check_path"`
+	DiskSpaceSource      string          `json:"disk_space_source"` // where disk space comes from: local or qbittorrent
+	RequestTimeout       time.Duration   `json:"request_timeout"`
+	MaxRetries           int             `json:"max_retries"`            // attempts per request when qBittorrent can't be reached
+	RetryDelay           time.Duration   `json:"retry_delay"`            // delay before the first retry, doubled for each further one
+	AutoCreateCategories bool            `json:"auto_create_categories"` // create missing categories in qBittorrent when adding
+	CreateSavePaths      bool            `json:"create_save_paths"`      // create missing category save paths when adding
+	Remote               bool            `json:"remote"`                 // qBittorrent runs on another host, so save paths can't be checked locally
+	StalledThreshold     time.Duration   `json:"stalled_threshold"`      // how long a download must be inactive before it's reported as stalled
+	SkipPatterns         []string        `json:"skip_patterns"`          // globs (or "re:" regexes) of files not to download, e.g. sample videos
+	CookieCache          bool            `json:"cookie_cache"`           // reuse the login session between invocations
+	SessionFile          string          `json:"session_file"`           // file the session cookie is cached in
+
+	// Save path templates keyed by category, e.g. "movies": "/data/{category}/{year}"
+	SavePathTemplates map[string]string `json:"save_path_templates,omitempty"`
 }
 
 // SavePathsConfig holds different category save paths
@@ -42,6 +75,59 @@ type SavePathsConfig struct {
 	Series  string `json:"series"`
 	Movies  string `json:"movies"`
 	Anime   string `json:"anime"`
+
+	// Save paths of the other configured categories, keyed by category name
+	Custom map[string]string `json:"custom,omitempty"`
+}
+
+// CategoryOption describes a torrent category and how to present it to users
+type CategoryOption struct {
+	Name        string `json:"name"`        // category name in qBittorrent
+	Label       string `json:"label"`       // human readable name
+	Emoji       string `json:"emoji"`       // icon shown next to the label
+	Description string `json:"description"` // short description for menus
+}
+
+// MaxCategories is the most categories that can be configured. Discord allows 25
+// choices per menu, and the menus add "Default" or "All Categories" to the list.
+const MaxCategories = 24
+
+// defaultCategoryNames are the categories used when CATEGORIES is not set
+var defaultCategoryNames = []string{"movies", "series", "anime"}
+
+// categoryNamePattern matches the category names accepted in CATEGORIES
+var categoryNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// builtinCategories are the labels and icons of the categories Akira knows about;
+// other configured categories get a generic icon and their capitalized name
+var builtinCategories = map[string]CategoryOption{
+	"movies":        {Name: "movies", Label: "Movies", Emoji: "🎬", Description: "Movie torrents"},
+	"series":        {Name: "series", Label: "Series", Emoji: "📺", Description: "TV series torrents"},
+	"anime":         {Name: "anime", Label: "Anime", Emoji: "🌸", Description: "Anime torrents"},
+	"music":         {Name: "music", Label: "Music", Emoji: "🎵", Description: "Music torrents"},
+	"documentaries": {Name: "documentaries", Label: "Documentaries", Emoji: "🎥", Description: "Documentary torrents"},
+	"books":         {Name: "books", Label: "Books", Emoji: "📚", Description: "Book torrents"},
+	"games":         {Name: "games", Label: "Games", Emoji: "🎮", Description: "Game torrents"},
+	"software":      {Name: "software", Label: "Software", Emoji: "💿", Description: "Software torrents"},
+}
+
+// NewCategoryOption returns the option for a category name, using the built-in
+// label and icon when there is one
+func NewCategoryOption(name string) CategoryOption {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if option, ok := builtinCategories[name]; ok {
+		return option
+	}
+	label := name
+	if label != "" {
+		label = strings.ToUpper(label[:1]) + label[1:]
+	}
+	return CategoryOption{Name: name, Label: label, Emoji: "📁", Description: label + " torrents"}
+}
+
+// DisplayName returns the category label prefixed with its emoji
+func (o CategoryOption) DisplayName() string {
+	return o.Emoji + " " + o.Label
 }
 
 // CacheConfig holds caching configuration
@@ -52,6 +138,7 @@ type CacheConfig struct {
 	DiskSpaceTTL      time.Duration `json:"disk_space_ttl"`
 	CleanupInterval   time.Duration `json:"cleanup_interval"`
 	MaxItems          int           `json:"max_items"`
+	PersistFile       string        `json:"persist_file"` // file the TUI keeps its last-known torrents in between runs, empty to disable
 }
 
 // LoggingConfig holds logging configuration
@@ -67,9 +154,147 @@ type LoggingConfig struct {
 
 // SeedingConfig holds automatic seeding management configuration
 type SeedingConfig struct {
+	Mode             string        `json:"mode"`               // how limits are enforced: managed or native
 	TimeMultiplier   float64       `json:"time_multiplier"`    // multiplier for seeding time (e.g., 10 means seed for 10x download time)
 	CheckInterval    time.Duration `json:"check_interval"`     // how often to check for torrents to stop seeding
 	TrackingDataFile string        `json:"tracking_data_file"` // file to store seeding tracking data
+	PauseStateFile   string        `json:"pause_state_file"`   // file marking auto-stopping as paused, shared between processes
+
+	AutoDeletePublicAfterComplete bool          `json:"auto_delete_public_after_complete"` // delete public-tracker torrents once complete
+	AutoDeletePublicDelay         time.Duration `json:"auto_delete_public_delay"`          // how long after completion public torrents are deleted
+	AutoDeleteKeepFiles           bool          `json:"auto_delete_keep_files"`            // keep downloaded files when auto-deleting
+	StopOnExit                    bool          `json:"stop_on_exit"`                      // pause all tracked seeding torrents when the TUI, daemon or API server exits
+	AutoTrackAll                  bool          `json:"auto_track_all"`                    // track torrents added outside Akira too
+	TrackedTag                    string        `json:"tracked_tag"`                       // qBittorrent tag added to tracked torrents, empty disables it
+
+	// Seeding time multipliers keyed by lowercase category, overriding TimeMultiplier
+	CategoryMultipliers map[string]float64 `json:"category_multipliers,omitempty"`
+
+	// Share ratio at which seeding stops (0 disables), and overrides keyed by lowercase category
+	RatioLimit          float64            `json:"ratio_limit"`
+	CategoryRatioLimits map[string]float64 `json:"category_ratio_limits,omitempty"`
+}
+
+// MultiplierForCategory returns the seeding time multiplier for a category. A
+// category listed in CategoryMultipliers uses its own multiplier; any other
+// category, including an empty one, uses TimeMultiplier.
+func (s SeedingConfig) MultiplierForCategory(category string) float64 {
+	if multiplier, ok := s.CategoryMultipliers[strings.ToLower(strings.TrimSpace(category))]; ok {
+		return multiplier
+	}
+	return s.TimeMultiplier
+}
+
+// RatioLimitForCategory returns the share ratio at which seeding stops for a
+// category, with the same precedence as MultiplierForCategory. Zero means
+// there is no ratio limit.
+func (s SeedingConfig) RatioLimitForCategory(category string) float64 {
+	if limit, ok := s.CategoryRatioLimits[strings.ToLower(strings.TrimSpace(category))]; ok {
+		return limit
+	}
+	return s.RatioLimit
+}
+
+// Seeding modes
+const (
+	SeedingModeManaged = "managed" // Akira checks the limits and stops torrents itself
+	SeedingModeNative  = "native"  // Akira sets qBittorrent's share limits and qBittorrent stops torrents
+)
+
+// Disk space sources
+const (
+	DiskSpaceSourceLocal       = "local"       // Measure the save paths on this machine
+	DiskSpaceSourceQBittorrent = "qbittorrent" // Use the free space qBittorrent reports
+)
+
+// Log orders supported by the TUI logs view
+const (
+	LogOrderNewestFirst = "newest"
+	LogOrderOldestFirst = "oldest"
+)
+
+// TUIConfig holds terminal UI preferences
+type TUIConfig struct {
+	LogOrder string `json:"log_order"` // initial order of the logs view: "newest" or "oldest"
+}
+
+// OldestLogsFirst returns true if the logs view should start in chronological order
+func (t TUIConfig) OldestLogsFirst() bool {
+	return t.LogOrder == LogOrderOldestFirst
+}
+
+// Default layouts for displayed timestamps
+const (
+	DefaultTimeFormat  = "2006-01-02 15:04:05 -07:00"
+	DefaultClockFormat = "15:04:05 -07:00"
+)
+
+// UIConfig holds display preferences shared by the CLI, TUI and Discord bot
+type UIConfig struct {
+	TimeZone   string `json:"time_zone"`   // IANA time zone for displayed timestamps, empty for local time
+	TimeFormat string `json:"time_format"` // Go layout for displayed dates and times
+
+	location *time.Location // loaded from TimeZone
+}
+
+// Location returns the time zone timestamps are displayed in
+func (u UIConfig) Location() *time.Location {
+	if u.location == nil {
+		return time.Local
+	}
+	return u.location
+}
+
+// FormatTime formats a date and time in the configured zone and layout
+func (u UIConfig) FormatTime(t time.Time) string {
+	layout := u.TimeFormat
+	if layout == "" {
+		layout = DefaultTimeFormat
+	}
+	return t.In(u.Location()).Format(layout)
+}
+
+// FormatClock formats the time of day in the configured zone, for compact views
+func (u UIConfig) FormatClock(t time.Time) string {
+	return t.In(u.Location()).Format(DefaultClockFormat)
+}
+
+// ServerConfig holds configuration for the 'akira serve' HTTP server
+type ServerConfig struct {
+	Addr          string        `json:"addr"`           // address the HTTP server listens on
+	EventInterval time.Duration `json:"event_interval"` // how often torrents and disks are polled for events
+	AuthToken     string        `json:"auth_token"`     // bearer token API clients must send, empty disables authentication
+}
+
+// CompletionWebhookConfig holds the webhook called when a tracked torrent completes
+type CompletionWebhookConfig struct {
+	URL        string            `json:"url"`         // URL the completion is POSTed to as JSON, empty disables the webhook
+	Headers    map[string]string `json:"headers"`     // extra request headers, e.g. Authorization
+	MaxRetries int               `json:"max_retries"` // retries after a failed delivery
+	RetryDelay time.Duration     `json:"retry_delay"` // delay before the first retry, doubled for each further one
+	Timeout    time.Duration     `json:"timeout"`     // timeout of a single delivery attempt
+}
+
+// MetricsConfig holds configuration for the Prometheus metrics endpoint
+type MetricsConfig struct {
+	Enabled bool   `json:"enabled"` // whether /metrics is served
+	Addr    string `json:"addr"`    // address the metrics server listens on
+}
+
+// DiskConfig holds disk health thresholds and low disk space alerting
+type DiskConfig struct {
+	Thresholds    DiskThresholds `json:"thresholds"`
+	AlertInterval time.Duration  `json:"alert_interval"` // how often the daemon checks disk health for alerts, 0 disables them
+	AlertCommand  string         `json:"alert_command"`  // shell command run when a disk becomes less healthy
+	AlertWebhook  string         `json:"alert_webhook"`  // URL a JSON alert is POSTed to when a disk becomes less healthy
+}
+
+// DiskThresholds are the free space percentages below which a disk is reported
+// in warning, critical or danger health
+type DiskThresholds struct {
+	Warning  float64 `json:"warning"`
+	Critical float64 `json:"critical"`
+	Danger   float64 `json:"danger"`
 }
 
 // ProxyConfig holds proxy configuration (optional)
@@ -97,18 +322,35 @@ func LoadConfig() (*Config, error) {
 	if guildID != "" {
 		config.Discord.GuildIDs = []string{guildID}
 	}
+	config.Discord.NotificationChannelID = getEnvOrDefault("DISCORD_NOTIFICATION_CHANNEL_ID", "")
+	config.Discord.DeleteConfirmTimeout = parseDurationOrDefault("DISCORD_DELETE_CONFIRM_TIMEOUT", 2*time.Minute)
 
 	// Load qBittorrent configuration
 	config.QBittorrent.URL = getEnvOrDefault("QBITTORRENT_URL", "http://localhost:8080")
 	config.QBittorrent.Username = getEnvOrDefault("QBITTORRENT_USERNAME", "admin")
 	config.QBittorrent.Password = getEnvOrDefault("QBITTORRENT_PASSWORD", "")
 	config.QBittorrent.RequestTimeout = parseDurationOrDefault("QBITTORRENT_REQUEST_TIMEOUT", 30*time.Second)
+	config.QBittorrent.MaxRetries = parseIntOrDefault("QBITTORRENT_MAX_RETRIES", 3)
+	config.QBittorrent.RetryDelay = parseDurationOrDefault("QBITTORRENT_RETRY_DELAY", 1*time.Second)
+	config.QBittorrent.AutoCreateCategories = parseBoolOrDefault("QBITTORRENT_AUTO_CREATE_CATEGORIES", false)
+	config.QBittorrent.CreateSavePaths = parseBoolOrDefault("QBITTORRENT_CREATE_SAVE_PATHS", false)
+	config.QBittorrent.Remote = parseBoolOrDefault("QBITTORRENT_REMOTE", false)
+	config.QBittorrent.StalledThreshold = parseDurationOrDefault("QBITTORRENT_STALLED_THRESHOLD", 5*time.Minute)
+	config.QBittorrent.SkipPatterns = parseListOrDefault("QBITTORRENT_SKIP_PATTERNS", nil)
+	config.QBittorrent.CookieCache = parseBoolOrDefault("QBITTORRENT_COOKIE_CACHE", true)
+	config.QBittorrent.SessionFile = getEnvOrDefault("QBITTORRENT_SESSION_FILE", "qbittorrent_session.json")
+
+	// Load categories
+	for _, name := range parseListOrDefault("CATEGORIES", defaultCategoryNames) {
+		config.CategoryOptions = append(config.CategoryOptions, NewCategoryOption(name))
+	}
 
 	// Load save paths
 	config.QBittorrent.SavePaths.Default = getEnvOrDefault("QBITTORRENT_DEFAULT_SAVE_PATH", "/downloads/default")
 	config.QBittorrent.SavePaths.Series = getEnvOrDefault("QBITTORRENT_SERIES_SAVE_PATH", "")
 	config.QBittorrent.SavePaths.Movies = getEnvOrDefault("QBITTORRENT_MOVIES_SAVE_PATH", "")
 	config.QBittorrent.SavePaths.Anime = getEnvOrDefault("QBITTORRENT_ANIME_SAVE_PATH", "")
+	config.QBittorrent.SavePathTemplates = parseSavePathTemplates("QBITTORRENT_SAVE_PATH_TEMPLATES")
 
 	// Use default path as fallback for category paths if not set
 	if config.QBittorrent.SavePaths.Series == "" {
@@ -121,7 +363,39 @@ func LoadConfig() (*Config, error) {
 		config.QBittorrent.SavePaths.Anime = config.QBittorrent.SavePaths.Default
 	}
 
+	// Other categories read QBITTORRENT_<CATEGORY>_SAVE_PATH, also falling back to the default path
+	for _, category := range config.CategoryOptions {
+		switch category.Name {
+		case "series", "movies", "anime":
+			continue
+		}
+		if config.QBittorrent.SavePaths.Custom == nil {
+			config.QBittorrent.SavePaths.Custom = make(map[string]string)
+		}
+		config.QBittorrent.SavePaths.Custom[category.Name] = getEnvOrDefault(CategorySavePathEnv(category.Name), config.QBittorrent.SavePaths.Default)
+	}
+
 	config.QBittorrent.DiskSpaceCheckPath = getEnvOrDefault("DISK_SPACE_CHECK_PATH", "/")
+	config.QBittorrent.DiskSpaceSource = strings.ToLower(getEnvOrDefault("DISK_SPACE_SOURCE", DiskSpaceSourceLocal))
+
+	// Load disk health configuration
+	config.Disk.Thresholds.Warning = parseFloat64OrDefault("DISK_WARNING_THRESHOLD", 20)
+	config.Disk.Thresholds.Critical = parseFloat64OrDefault("DISK_CRITICAL_THRESHOLD", 10)
+	config.Disk.Thresholds.Danger = parseFloat64OrDefault("DISK_DANGER_THRESHOLD", 5)
+	config.Disk.AlertInterval = parseDurationOrDefault("DISK_ALERT_INTERVAL", 5*time.Minute)
+	config.Disk.AlertCommand = getEnvOrDefault("DISK_ALERT_COMMAND", "")
+	config.Disk.AlertWebhook = getEnvOrDefault("DISK_ALERT_WEBHOOK_URL", "")
+
+	// Load completion webhook configuration
+	config.CompletionWebhook.URL = getEnvOrDefault("COMPLETION_WEBHOOK_URL", "")
+	webhookHeaders, err := parseHeaders("COMPLETION_WEBHOOK_HEADERS")
+	if err != nil {
+		return nil, err
+	}
+	config.CompletionWebhook.Headers = webhookHeaders
+	config.CompletionWebhook.MaxRetries = parseIntOrDefault("COMPLETION_WEBHOOK_MAX_RETRIES", 3)
+	config.CompletionWebhook.RetryDelay = parseDurationOrDefault("COMPLETION_WEBHOOK_RETRY_DELAY", 5*time.Second)
+	config.CompletionWebhook.Timeout = parseDurationOrDefault("COMPLETION_WEBHOOK_TIMEOUT", 10*time.Second)
 
 	// Load cache configuration
 	config.Cache.TorrentListTTL = parseDurationOrDefault("CACHE_TORRENT_LIST_TTL", 30*time.Second)
@@ -130,6 +404,7 @@ func LoadConfig() (*Config, error) {
 	config.Cache.DiskSpaceTTL = parseDurationOrDefault("CACHE_DISK_SPACE_TTL", 5*time.Minute)
 	config.Cache.CleanupInterval = parseDurationOrDefault("CACHE_CLEANUP_INTERVAL", 10*time.Minute)
 	config.Cache.MaxItems = parseIntOrDefault("CACHE_MAX_ITEMS", 1000)
+	config.Cache.PersistFile = getEnvOrDefault("CACHE_PERSIST_FILE", "akira_cache.json")
 
 	// Load logging configuration
 	config.Logging.Level = getEnvOrDefault("LOG_LEVEL", "info")
@@ -141,9 +416,29 @@ func LoadConfig() (*Config, error) {
 	config.Logging.ToStdout = parseBoolOrDefault("LOG_TO_STDOUT", true)
 
 	// Load seeding configuration
+	config.Seeding.Mode = strings.ToLower(getEnvOrDefault("SEEDING_MODE", SeedingModeManaged))
 	config.Seeding.TimeMultiplier = parseFloat64OrDefault("SEEDING_TIME_MULTIPLIER", 10.0)
 	config.Seeding.CheckInterval = parseDurationOrDefault("SEEDING_CHECK_INTERVAL", 5*time.Minute)
 	config.Seeding.TrackingDataFile = getEnvOrDefault("SEEDING_TRACKING_DATA_FILE", "seeding_tracking.json")
+	config.Seeding.PauseStateFile = getEnvOrDefault("SEEDING_PAUSE_STATE_FILE", "seeding_paused.json")
+	config.Seeding.AutoDeletePublicAfterComplete = parseBoolOrDefault("SEEDING_AUTO_DELETE_PUBLIC", false)
+	config.Seeding.AutoDeletePublicDelay = parseDurationOrDefault("SEEDING_AUTO_DELETE_PUBLIC_DELAY", 10*time.Minute)
+	config.Seeding.AutoDeleteKeepFiles = parseBoolOrDefault("SEEDING_AUTO_DELETE_KEEP_FILES", true)
+	config.Seeding.StopOnExit = parseBoolOrDefault("SEEDING_STOP_ON_EXIT", false)
+	config.Seeding.AutoTrackAll = parseBoolOrDefault("SEEDING_AUTO_TRACK_ALL", false)
+	config.Seeding.TrackedTag = strings.TrimSpace(getEnvOrDefault("SEEDING_TRACKED_TAG", ""))
+	categoryMultipliers, err := parseCategoryValues("SEEDING_CATEGORY_MULTIPLIERS")
+	if err != nil {
+		return nil, err
+	}
+	config.Seeding.CategoryMultipliers = categoryMultipliers
+	config.Seeding.RatioLimit = parseFloat64OrDefault("SEEDING_RATIO_LIMIT", 0)
+	categoryRatioLimits, err := parseCategoryValues("SEEDING_CATEGORY_RATIO_LIMITS")
+	if err != nil {
+		return nil, err
+	}
+	config.Seeding.CategoryRatioLimits = categoryRatioLimits
+	config.PrivateTrackers = parseListOrDefault("PRIVATE_TRACKERS", nil)
 
 	// Load proxy configuration (optional)
 	config.Proxy.Host = getEnvOrDefault("PROXY_HOST", "")
@@ -152,6 +447,29 @@ func LoadConfig() (*Config, error) {
 	config.Proxy.Password = getEnvOrDefault("PROXY_PASS", "")
 	config.Proxy.Enabled = config.Proxy.Host != "" && config.Proxy.Port > 0
 
+	// Load TUI configuration
+	config.TUI.LogOrder = strings.ToLower(getEnvOrDefault("TUI_LOG_ORDER", LogOrderNewestFirst))
+
+	// Load HTTP server configuration
+	config.Server.Addr = getEnvOrDefault("SERVER_ADDR", "127.0.0.1:8090")
+	config.Server.EventInterval = parseDurationOrDefault("SERVER_EVENT_INTERVAL", 5*time.Second)
+	config.Server.AuthToken = getEnvOrDefault("SERVER_AUTH_TOKEN", "")
+
+	// Load metrics configuration
+	config.Metrics.Enabled = parseBoolOrDefault("METRICS_ENABLED", false)
+	config.Metrics.Addr = getEnvOrDefault("METRICS_ADDR", "127.0.0.1:9101")
+
+	// Load display configuration
+	config.UI.TimeZone = getEnvOrDefault("UI_TIME_ZONE", "")
+	config.UI.TimeFormat = getEnvOrDefault("UI_TIME_FORMAT", DefaultTimeFormat)
+	if config.UI.TimeZone != "" {
+		location, err := time.LoadLocation(config.UI.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid UI_TIME_ZONE '%s': %w", config.UI.TimeZone, err)
+		}
+		config.UI.location = location
+	}
+
 	// Validate required configuration
 	if err := config.Validate(); err != nil {
 		return nil, fmt.Errorf("configuration validation failed: %w", err)
@@ -182,6 +500,38 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("QBITTORRENT_DEFAULT_SAVE_PATH is required")
 	}
 
+	if c.Discord.DeleteConfirmTimeout <= 0 {
+		return fmt.Errorf("Discord delete confirmation timeout must be greater than 0, got: %s", c.Discord.DeleteConfirmTimeout)
+	}
+
+	// Validate categories
+	if err := validateCategories(c.CategoryOptions); err != nil {
+		return err
+	}
+
+	// Validate request retries
+	if c.QBittorrent.RequestTimeout <= 0 {
+		return fmt.Errorf("qBittorrent request timeout must be greater than 0, got: %s", c.QBittorrent.RequestTimeout)
+	}
+	if c.QBittorrent.MaxRetries < 1 {
+		return fmt.Errorf("qBittorrent max retries must be at least 1, got: %d", c.QBittorrent.MaxRetries)
+	}
+	if c.QBittorrent.RetryDelay <= 0 {
+		return fmt.Errorf("qBittorrent retry delay must be greater than 0, got: %s", c.QBittorrent.RetryDelay)
+	}
+
+	// Validate save path templates
+	validCategories := c.GetValidCategories()
+	for category, template := range c.QBittorrent.SavePathTemplates {
+		if !slices.Contains(validCategories, category) {
+			return fmt.Errorf("invalid QBITTORRENT_SAVE_PATH_TEMPLATES entry '%s': unknown category (must be one of: %s)",
+				category, strings.Join(validCategories, ", "))
+		}
+		if err := validateSavePathTemplate(template); err != nil {
+			return fmt.Errorf("invalid QBITTORRENT_SAVE_PATH_TEMPLATES entry for '%s': %w", category, err)
+		}
+	}
+
 	// Validate log level
 	validLogLevels := map[string]bool{
 		"trace": true, "debug": true, "info": true, "warn": true, "error": true, "fatal": true, "panic": true,
@@ -190,16 +540,129 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid log level: %s (must be one of: trace, debug, info, warn, error, fatal, panic)", c.Logging.Level)
 	}
 
+	// Validate server event interval
+	if c.Server.EventInterval <= 0 {
+		return fmt.Errorf("server event interval must be greater than 0, got: %s", c.Server.EventInterval)
+	}
+
+	if c.Metrics.Enabled && c.Metrics.Addr == "" {
+		return fmt.Errorf("METRICS_ADDR is required when METRICS_ENABLED is true")
+	}
+
+	// Validate disk space source
+	if c.QBittorrent.DiskSpaceSource != DiskSpaceSourceLocal && c.QBittorrent.DiskSpaceSource != DiskSpaceSourceQBittorrent {
+		return fmt.Errorf("invalid disk space source: %s (must be one of: %s, %s)",
+			c.QBittorrent.DiskSpaceSource, DiskSpaceSourceLocal, DiskSpaceSourceQBittorrent)
+	}
+
+	// Validate disk health thresholds and alerting
+	for _, threshold := range []struct {
+		name  string
+		value float64
+	}{
+		{"DISK_WARNING_THRESHOLD", c.Disk.Thresholds.Warning},
+		{"DISK_CRITICAL_THRESHOLD", c.Disk.Thresholds.Critical},
+		{"DISK_DANGER_THRESHOLD", c.Disk.Thresholds.Danger},
+	} {
+		if threshold.value < 0 || threshold.value > 100 {
+			return fmt.Errorf("%s must be a free space percentage between 0 and 100, got: %g", threshold.name, threshold.value)
+		}
+	}
+	if c.Disk.Thresholds.Danger > c.Disk.Thresholds.Critical || c.Disk.Thresholds.Critical > c.Disk.Thresholds.Warning {
+		return fmt.Errorf("disk health thresholds must not increase from warning to danger, got: warning %g, critical %g, danger %g",
+			c.Disk.Thresholds.Warning, c.Disk.Thresholds.Critical, c.Disk.Thresholds.Danger)
+	}
+	if c.Disk.AlertInterval < 0 {
+		return fmt.Errorf("disk alert interval cannot be negative, got: %s", c.Disk.AlertInterval)
+	}
+	if c.Disk.AlertWebhook != "" {
+		if u, err := url.Parse(c.Disk.AlertWebhook); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid DISK_ALERT_WEBHOOK_URL '%s' (must be an http or https URL)", c.Disk.AlertWebhook)
+		}
+	}
+
+	// Validate completion webhook
+	if c.CompletionWebhook.URL != "" {
+		if u, err := url.Parse(c.CompletionWebhook.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid COMPLETION_WEBHOOK_URL '%s' (must be an http or https URL)", c.CompletionWebhook.URL)
+		}
+	}
+	if c.CompletionWebhook.MaxRetries < 0 {
+		return fmt.Errorf("completion webhook retries cannot be negative, got: %d", c.CompletionWebhook.MaxRetries)
+	}
+	if c.CompletionWebhook.RetryDelay < 0 {
+		return fmt.Errorf("completion webhook retry delay cannot be negative, got: %s", c.CompletionWebhook.RetryDelay)
+	}
+	if c.CompletionWebhook.Timeout <= 0 {
+		return fmt.Errorf("completion webhook timeout must be greater than 0, got: %s", c.CompletionWebhook.Timeout)
+	}
+
+	// Validate TUI log order
+	if c.TUI.LogOrder != LogOrderNewestFirst && c.TUI.LogOrder != LogOrderOldestFirst {
+		return fmt.Errorf("invalid TUI log order: %s (must be one of: %s, %s)", c.TUI.LogOrder, LogOrderNewestFirst, LogOrderOldestFirst)
+	}
+
+	// Validate file skip patterns
+	for _, pattern := range c.QBittorrent.SkipPatterns {
+		if err := validateFilePattern(pattern); err != nil {
+			return fmt.Errorf("invalid QBITTORRENT_SKIP_PATTERNS entry '%s': %w", pattern, err)
+		}
+	}
+
+	// qBittorrent separates tags with commas, so a tag can't contain one
+	if strings.Contains(c.Seeding.TrackedTag, ",") {
+		return fmt.Errorf("invalid SEEDING_TRACKED_TAG '%s' (must not contain a comma)", c.Seeding.TrackedTag)
+	}
+
+	// Validate public torrent auto-delete delay
+	if c.Seeding.AutoDeletePublicDelay < 0 {
+		return fmt.Errorf("auto-delete delay for public torrents cannot be negative, got: %s", c.Seeding.AutoDeletePublicDelay)
+	}
+
+	// Validate seeding mode
+	if c.Seeding.Mode != SeedingModeManaged && c.Seeding.Mode != SeedingModeNative {
+		return fmt.Errorf("invalid seeding mode: %s (must be one of: %s, %s)", c.Seeding.Mode, SeedingModeManaged, SeedingModeNative)
+	}
+
 	// Validate seeding time multiplier
 	if c.Seeding.TimeMultiplier <= 0 {
 		return fmt.Errorf("seeding time multiplier must be greater than 0, got: %f", c.Seeding.TimeMultiplier)
 	}
+	for category, multiplier := range c.Seeding.CategoryMultipliers {
+		if multiplier <= 0 {
+			return fmt.Errorf("seeding time multiplier for category '%s' must be greater than 0, got: %f", category, multiplier)
+		}
+	}
+
+	// Validate seeding ratio limits
+	if c.Seeding.RatioLimit < 0 {
+		return fmt.Errorf("seeding ratio limit cannot be negative, got: %f", c.Seeding.RatioLimit)
+	}
+	for category, limit := range c.Seeding.CategoryRatioLimits {
+		if limit < 0 {
+			return fmt.Errorf("seeding ratio limit for category '%s' cannot be negative, got: %f", category, limit)
+		}
+	}
 
 	return nil
 }
 
-// GetSavePathForCategory returns the save path for a given category
+// GetSavePathForCategory returns the save path for a given category, expanding
+// the category's save path template if one is configured
 func (c *Config) GetSavePathForCategory(category string) string {
+	category = strings.ToLower(category)
+	if !slices.Contains(CategoryNames(c.Categories()), category) {
+		category = "default"
+	}
+	if template, ok := c.QBittorrent.SavePathTemplates[category]; ok {
+		return ExpandSavePathTemplate(template, category, time.Now())
+	}
+	return c.GetConfiguredSavePath(category)
+}
+
+// GetConfiguredSavePath returns the fixed save path configured for a category,
+// ignoring save path templates
+func (c *Config) GetConfiguredSavePath(category string) string {
 	category = strings.ToLower(category)
 	switch category {
 	case "series":
@@ -208,14 +671,72 @@ func (c *Config) GetSavePathForCategory(category string) string {
 		return c.QBittorrent.SavePaths.Movies
 	case "anime":
 		return c.QBittorrent.SavePaths.Anime
-	default:
-		return c.QBittorrent.SavePaths.Default
 	}
+	if path, ok := c.QBittorrent.SavePaths.Custom[category]; ok && path != "" {
+		return path
+	}
+	return c.QBittorrent.SavePaths.Default
+}
+
+// Categories returns the torrent categories users can choose from, in display order.
+// The CLI, TUI and Discord bot all build their category selection from this list.
+// Without configured categories the defaults (movies, series, anime) are used.
+func (c *Config) Categories() []CategoryOption {
+	if len(c.CategoryOptions) == 0 {
+		categories := make([]CategoryOption, len(defaultCategoryNames))
+		for i, name := range defaultCategoryNames {
+			categories[i] = NewCategoryOption(name)
+		}
+		return categories
+	}
+	return slices.Clone(c.CategoryOptions)
+}
+
+// CategorySavePathEnv returns the environment variable holding a category's
+// save path, e.g. QBITTORRENT_DOCUMENTARIES_SAVE_PATH
+func CategorySavePathEnv(category string) string {
+	name := strings.Map(func(r rune) rune {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, strings.ToUpper(category))
+	return "QBITTORRENT_" + name + "_SAVE_PATH"
+}
+
+// validateCategories checks the configured category names
+func validateCategories(categories []CategoryOption) error {
+	if len(categories) > MaxCategories {
+		return fmt.Errorf("too many categories: %d (at most %d are supported)", len(categories), MaxCategories)
+	}
+
+	seen := make(map[string]bool)
+	for _, category := range categories {
+		switch {
+		case category.Name == "default" || category.Name == "all":
+			return fmt.Errorf("invalid category '%s' in CATEGORIES: the name is reserved", category.Name)
+		case !categoryNamePattern.MatchString(category.Name):
+			return fmt.Errorf("invalid category '%s' in CATEGORIES: use lowercase letters, digits, '-' and '_'", category.Name)
+		case seen[category.Name]:
+			return fmt.Errorf("duplicate category '%s' in CATEGORIES", category.Name)
+		}
+		seen[category.Name] = true
+	}
+	return nil
+}
+
+// CategoryNames returns the names of the given category options
+func CategoryNames(categories []CategoryOption) []string {
+	names := make([]string, len(categories))
+	for i, category := range categories {
+		names[i] = category.Name
+	}
+	return names
 }
 
 // GetValidCategories returns a list of valid torrent categories
 func (c *Config) GetValidCategories() []string {
-	return []string{"series", "movies", "anime", "default"}
+	return append(CategoryNames(c.Categories()), "default")
 }
 
 // Helper functions for parsing environment variables
@@ -254,6 +775,81 @@ func parseBoolOrDefault(key string, defaultValue bool) bool {
 	return defaultValue
 }
 
+func parseListOrDefault(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseCategoryValues parses comma-separated category=number entries, e.g.
+// "anime=20,movies=5", into a map keyed by lowercase category
+func parseCategoryValues(key string) (map[string]float64, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	values := make(map[string]float64)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		category, number, found := strings.Cut(item, "=")
+		category = strings.ToLower(strings.TrimSpace(category))
+		if !found || category == "" {
+			return nil, fmt.Errorf("invalid %s entry '%s': expected category=number", key, item)
+		}
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s entry '%s': %w", key, item, err)
+		}
+		values[category] = parsed
+	}
+	return values, nil
+}
+
+// parseHeaders parses comma-separated Name=Value entries, e.g.
+// "Authorization=Bearer abc,X-Source=akira", into HTTP headers
+func parseHeaders(key string) (map[string]string, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	headers := make(map[string]string)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		name, headerValue, found := strings.Cut(item, "=")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, fmt.Errorf("invalid %s entry '%s': expected Name=Value", key, item)
+		}
+		headers[name] = strings.TrimSpace(headerValue)
+	}
+	return headers, nil
+}
+
+// validateFilePattern checks a glob, or a regex when prefixed with "re:"
+func validateFilePattern(pattern string) error {
+	if expr, ok := strings.CutPrefix(pattern, "re:"); ok {
+		_, err := regexp.Compile(expr)
+		return err
+	}
+	_, err := path.Match(pattern, "")
+	return err
+}
+
 func parseDurationOrDefault(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if parsed, err := time.ParseDuration(value); err == nil {
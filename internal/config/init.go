@@ -0,0 +1,107 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultConfigFile is the .env file Akira loads from the working directory
+const DefaultConfigFile = ".env"
+
+// InitOptions holds the settings collected by 'akira config init'
+type InitOptions struct {
+	DiscordBotToken string
+	URL             string
+	Username        string
+	Password        string
+	DefaultSavePath string
+}
+
+// ConfigPath returns the absolute path of the config file Akira expects
+func ConfigPath() string {
+	path, err := filepath.Abs(DefaultConfigFile)
+	if err != nil {
+		return DefaultConfigFile
+	}
+	return path
+}
+
+// HasConfig returns true if a config file exists or qBittorrent is configured
+// through environment variables
+func HasConfig() bool {
+	if _, err := os.Stat(DefaultConfigFile); err == nil {
+		return true
+	}
+	return os.Getenv("QBITTORRENT_URL") != "" || os.Getenv("QBITTORRENT_PASSWORD") != ""
+}
+
+// WriteConfigFile writes a minimal .env file with the given settings
+func WriteConfigFile(path string, opts InitOptions) error {
+	var b strings.Builder
+	b.WriteString("# Generated by 'akira config init'. See .env.example for all available options.\n\n")
+	b.WriteString("# Discord Bot Configuration\n")
+	fmt.Fprintf(&b, "DISCORD_BOT_TOKEN=%s\n\n", quoteEnvValue(opts.DiscordBotToken))
+	b.WriteString("# qBittorrent WebUI Configuration\n")
+	fmt.Fprintf(&b, "QBITTORRENT_URL=%s\n", quoteEnvValue(opts.URL))
+	fmt.Fprintf(&b, "QBITTORRENT_USERNAME=%s\n", quoteEnvValue(opts.Username))
+	fmt.Fprintf(&b, "QBITTORRENT_PASSWORD=%s\n\n", quoteEnvValue(opts.Password))
+	b.WriteString("# qBittorrent Save Paths\n")
+	fmt.Fprintf(&b, "QBITTORRENT_DEFAULT_SAVE_PATH=%s\n", quoteEnvValue(opts.DefaultSavePath))
+
+	// The file contains credentials, so keep it private
+	if err := os.WriteFile(path, []byte(b.String()), 0600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
+
+// SetConfigValue sets key to value in the .env file at path, replacing an
+// existing assignment or appending one. The file must already exist, so that a
+// single saved setting doesn't pass for a configuration.
+func SetConfigValue(path, key, value string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	assignment := key + "=" + quoteEnvValue(value)
+	lines := strings.Split(string(data), "\n")
+	replaced := false
+	for i, line := range lines {
+		trimmed := strings.TrimPrefix(strings.TrimSpace(line), "export ")
+		if strings.HasPrefix(trimmed, key+"=") {
+			lines[i] = assignment
+			replaced = true
+		}
+	}
+	if !replaced {
+		// Keep the trailing newline at the end of the file
+		if last := len(lines) - 1; lines[last] == "" {
+			lines = append(lines[:last], assignment, "")
+		} else {
+			lines = append(lines, assignment)
+		}
+	}
+
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
+
+// quoteEnvValue quotes values that godotenv would otherwise misread
+func quoteEnvValue(value string) string {
+	if value == "" || !strings.ContainsAny(value, " #\"'\\$") {
+		return value
+	}
+	if !strings.Contains(value, "'") {
+		return "'" + value + "'"
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSetConfigValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "replaces existing",
+			content: "QBITTORRENT_URL=http://localhost:8080\nTUI_LOG_ORDER=newest\n",
+			want:    "QBITTORRENT_URL=http://localhost:8080\nTUI_LOG_ORDER=oldest\n",
+		},
+		{
+			name:    "replaces exported",
+			content: "export TUI_LOG_ORDER=newest\n",
+			want:    "TUI_LOG_ORDER=oldest\n",
+		},
+		{
+			name:    "keeps commented out",
+			content: "# TUI_LOG_ORDER=newest\n",
+			want:    "# TUI_LOG_ORDER=newest\nTUI_LOG_ORDER=oldest\n",
+		},
+		{
+			name:    "appends without trailing newline",
+			content: "QBITTORRENT_URL=http://localhost:8080",
+			want:    "QBITTORRENT_URL=http://localhost:8080\nTUI_LOG_ORDER=oldest",
+		},
+		{
+			name:    "other key with same prefix",
+			content: "TUI_LOG_ORDER_X=1\n",
+			want:    "TUI_LOG_ORDER_X=1\nTUI_LOG_ORDER=oldest\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), ".env")
+			if err := os.WriteFile(path, []byte(tt.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := SetConfigValue(path, "TUI_LOG_ORDER", LogOrderOldestFirst); err != nil {
+				t.Fatalf("SetConfigValue unexpected error: %v", err)
+			}
+
+			got, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("config file = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetConfigValueMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := SetConfigValue(path, "TUI_LOG_ORDER", LogOrderOldestFirst); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("SetConfigValue(missing file) error = %v, want fs.ErrNotExist", err)
+	}
+	if _, err := os.Stat(path); err == nil {
+		t.Error("SetConfigValue created a config file")
+	}
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Save path template placeholders, expanded when a torrent is added
+const (
+	PlaceholderCategory = "{category}" // The torrent's category, e.g. movies
+	PlaceholderDate     = "{date}"     // The current date as YYYY-MM-DD
+	PlaceholderYear     = "{year}"     // The current year, e.g. 2025
+	PlaceholderMonth    = "{month}"    // The current month as 01-12
+)
+
+// placeholderPattern matches anything that looks like a template placeholder
+var placeholderPattern = regexp.MustCompile(`\{[^{}]*\}`)
+
+// ExpandSavePathTemplate replaces the placeholders in template for a torrent
+// of the given category added at now
+func ExpandSavePathTemplate(template, category string, now time.Time) string {
+	return strings.NewReplacer(
+		PlaceholderCategory, strings.ToLower(category),
+		PlaceholderDate, now.Format("2006-01-02"),
+		PlaceholderYear, now.Format("2006"),
+		PlaceholderMonth, now.Format("01"),
+	).Replace(template)
+}
+
+// validateSavePathTemplate checks that a template is not empty and only uses known placeholders
+func validateSavePathTemplate(template string) error {
+	if strings.TrimSpace(template) == "" {
+		return fmt.Errorf("template is empty (expected category=template)")
+	}
+
+	for _, placeholder := range placeholderPattern.FindAllString(template, -1) {
+		switch placeholder {
+		case PlaceholderCategory, PlaceholderDate, PlaceholderYear, PlaceholderMonth:
+		default:
+			return fmt.Errorf("unknown placeholder %s (must be one of: %s, %s, %s, %s)", placeholder,
+				PlaceholderCategory, PlaceholderDate, PlaceholderYear, PlaceholderMonth)
+		}
+	}
+
+	// Any brace left after removing the placeholders is unbalanced
+	if strings.ContainsAny(placeholderPattern.ReplaceAllString(template, ""), "{}") {
+		return fmt.Errorf("unbalanced braces")
+	}
+
+	return nil
+}
+
+// parseSavePathTemplates parses a comma-separated list of category=template
+// entries. Malformed entries are kept with an empty template so Validate reports them.
+func parseSavePathTemplates(key string) map[string]string {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+
+	templates := make(map[string]string)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		category, template, _ := strings.Cut(item, "=")
+		templates[strings.ToLower(strings.TrimSpace(category))] = strings.TrimSpace(template)
+	}
+	return templates
+}
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExpandSavePathTemplate(t *testing.T) {
+	now := time.Date(2025, 3, 7, 18, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		template string
+		category string
+		want     string
+	}{
+		{name: "no placeholders", template: "/data/movies", category: "movies", want: "/data/movies"},
+		{name: "category", template: "/data/{category}", category: "movies", want: "/data/movies"},
+		{name: "category is lowercased", template: "/data/{category}", category: "Movies", want: "/data/movies"},
+		{name: "date", template: "/data/{date}", category: "movies", want: "/data/2025-03-07"},
+		{name: "year", template: "/data/movies/{year}", category: "movies", want: "/data/movies/2025"},
+		{name: "month", template: "/data/movies/{month}", category: "movies", want: "/data/movies/03"},
+		{name: "all placeholders", template: "/data/{category}/{year}/{month}/{date}", category: "series", want: "/data/series/2025/03/2025-03-07"},
+		{name: "repeated placeholder", template: "/{category}/{category}", category: "anime", want: "/anime/anime"},
+		{name: "empty category", template: "/data/{category}", category: "", want: "/data/"},
+		{name: "empty category without placeholder", template: "/data/{year}", category: "", want: "/data/2025"},
+		{name: "unknown placeholder is kept", template: "/data/{category}/{name}", category: "movies", want: "/data/movies/{name}"},
+		{name: "empty template", template: "", category: "movies", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExpandSavePathTemplate(tt.template, tt.category, now); got != tt.want {
+				t.Errorf("ExpandSavePathTemplate(%q, %q) = %q, want %q", tt.template, tt.category, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateSavePathTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		wantErr  string
+	}{
+		{template: "/data/movies"},
+		{template: "/data/{category}/{year}/{month}"},
+		{template: "/data/{date}"},
+		{template: "", wantErr: "template is empty"},
+		{template: "   ", wantErr: "template is empty"},
+		{template: "/data/{name}", wantErr: "unknown placeholder {name}"},
+		{template: "/data/{}", wantErr: "unknown placeholder {}"},
+		{template: "/data/{category", wantErr: "unbalanced braces"},
+		{template: "/data/category}", wantErr: "unbalanced braces"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			err := validateSavePathTemplate(tt.template)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("validateSavePathTemplate(%q) unexpected error: %v", tt.template, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateSavePathTemplate(%q) error = %v, want it to contain %q", tt.template, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/raainshe/akira/internal/config"
+	"github.com/raainshe/akira/internal/logging"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// completionWebhookDrainTimeout bounds how long Close waits for deliveries in flight
+const completionWebhookDrainTimeout = 30 * time.Second
+
+// TorrentCompletion describes a tracked torrent that finished downloading. It
+// is the JSON body POSTed to the completion webhook.
+type TorrentCompletion struct {
+	Event            EventType `json:"event"` // Always "torrent_completed"
+	Hash             string    `json:"hash"`
+	Name             string    `json:"name"`
+	Size             int64     `json:"size"` // Size in bytes
+	Category         string    `json:"category"`
+	SavePath         string    `json:"save_path"`
+	DownloadDuration string    `json:"download_duration"` // e.g. "1h2m3s", "0s" when the start is unknown
+	DownloadSeconds  int64     `json:"download_seconds"`
+	CompletedAt      time.Time `json:"completed_at"`
+}
+
+// CompletionWebhook POSTs torrent completions to the configured URL in the
+// background, retrying failed deliveries with exponential backoff
+type CompletionWebhook struct {
+	config     config.CompletionWebhookConfig
+	httpClient *http.Client
+	logger     *logging.Logger
+
+	// Deliveries in flight; ctx is cancelled by Close and then replaced
+	mutex   sync.Mutex
+	ctx     context.Context
+	cancel  context.CancelFunc
+	pending sync.WaitGroup
+}
+
+// NewCompletionWebhook creates a webhook notifier, or returns nil when no
+// COMPLETION_WEBHOOK_URL is configured
+func NewCompletionWebhook(cfg *config.Config) *CompletionWebhook {
+	if cfg.CompletionWebhook.URL == "" {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &CompletionWebhook{
+		config:     cfg.CompletionWebhook,
+		httpClient: &http.Client{Timeout: cfg.CompletionWebhook.Timeout},
+		logger:     logging.GetSeedingLogger(),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+// newTorrentCompletion builds the completion of a torrent from its tracking data
+func newTorrentCompletion(torrent qbittorrent.Torrent, trackingData *qbittorrent.SeedingTrackingData) TorrentCompletion {
+	return TorrentCompletion{
+		Event:            EventTorrentCompleted,
+		Hash:             torrent.Hash,
+		Name:             trackingData.Name,
+		Size:             torrent.Size,
+		Category:         torrent.Category,
+		SavePath:         torrent.SavePath,
+		DownloadDuration: trackingData.DownloadDuration.Round(time.Second).String(),
+		DownloadSeconds:  int64(trackingData.DownloadDuration.Seconds()),
+		CompletedAt:      trackingData.DownloadCompleteTime,
+	}
+}
+
+// Notify delivers a completion in the background and returns immediately
+func (w *CompletionWebhook) Notify(completion TorrentCompletion) {
+	w.mutex.Lock()
+	ctx := w.ctx
+	w.pending.Add(1)
+	w.mutex.Unlock()
+
+	go func() {
+		defer w.pending.Done()
+		if err := w.deliver(ctx, completion); err != nil {
+			w.logger.WithError(err).WithFields(map[string]interface{}{
+				"hash": completion.Hash,
+				"name": completion.Name,
+			}).Error("Completion webhook failed")
+		}
+	}()
+}
+
+// Close waits a while for deliveries in flight and then cancels the rest.
+// Completions notified afterwards are delivered as usual.
+func (w *CompletionWebhook) Close() {
+	done := make(chan struct{})
+	go func() {
+		w.pending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(completionWebhookDrainTimeout):
+		w.logger.Warn("Gave up waiting for completion webhooks")
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.cancel()
+	w.ctx, w.cancel = context.WithCancel(context.Background())
+}
+
+// deliver POSTs the completion, retrying connection errors and server errors
+func (w *CompletionWebhook) deliver(ctx context.Context, completion TorrentCompletion) error {
+	body, err := json.Marshal(completion)
+	if err != nil {
+		return fmt.Errorf("failed to marshal torrent completion: %w", err)
+	}
+
+	delay := w.config.RetryDelay
+	for attempt := 0; ; attempt++ {
+		retry, err := w.post(ctx, body)
+		if err == nil {
+			w.logger.WithFields(map[string]interface{}{
+				"hash":     completion.Hash,
+				"name":     completion.Name,
+				"attempts": attempt + 1,
+			}).Info("Completion webhook delivered")
+			return nil
+		}
+		if !retry || attempt >= w.config.MaxRetries {
+			return err
+		}
+
+		w.logger.WithFields(map[string]interface{}{
+			"hash":    completion.Hash,
+			"attempt": attempt + 1,
+			"delay":   delay,
+			"error":   err,
+		}).Warn("Completion webhook failed, retrying")
+		if err := sleepContext(ctx, delay); err != nil {
+			return fmt.Errorf("completion webhook cancelled: %w", err)
+		}
+		delay *= 2
+	}
+}
+
+// post makes a single delivery attempt and reports whether a failure is worth retrying
+func (w *CompletionWebhook) post(ctx context.Context, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range w.config.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, fmt.Errorf("failed to send completion webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		return retry, fmt.Errorf("completion webhook returned HTTP %d", resp.StatusCode)
+	}
+	return true, nil
+}
+
+// sleepContext waits for the duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
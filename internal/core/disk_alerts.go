@@ -0,0 +1,216 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/raainshe/akira/internal/config"
+	"github.com/raainshe/akira/internal/logging"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+const (
+	// diskAlertCommandTimeout bounds how long DISK_ALERT_COMMAND may run
+	diskAlertCommandTimeout = 30 * time.Second
+
+	// diskAlertWebhookTimeout bounds a single POST to DISK_ALERT_WEBHOOK_URL
+	diskAlertWebhookTimeout = 10 * time.Second
+)
+
+// DiskAlert describes a disk whose health got worse. It is the JSON body POSTed
+// to the alert webhook.
+type DiskAlert struct {
+	Path        string           `json:"path"`
+	OldHealth   DiskHealthStatus `json:"old_health"`
+	Health      DiskHealthStatus `json:"health"`
+	Free        int64            `json:"free"`         // Free space in bytes
+	Total       int64            `json:"total"`        // Total space in bytes
+	FreePercent float64          `json:"free_percent"` // Free percentage (0-100)
+	Time        time.Time        `json:"time"`
+}
+
+// DiskAlerter periodically checks the configured paths and raises an alert when
+// a disk gets less healthy: a warning is logged, and the configured command and
+// webhook are triggered. Recovering disks are only logged.
+type DiskAlerter struct {
+	diskService *DiskService
+	config      config.DiskConfig
+	httpClient  *http.Client
+	logger      *logging.Logger
+
+	health   map[string]DiskHealthStatus // Last known health per path
+	handlers []func(DiskAlert)           // Called for every alert, see OnAlert
+}
+
+// NewDiskAlerter creates an alerter for the paths checked by diskService
+func NewDiskAlerter(cfg *config.Config, diskService *DiskService) *DiskAlerter {
+	return &DiskAlerter{
+		diskService: diskService,
+		config:      cfg.Disk,
+		httpClient:  &http.Client{Timeout: diskAlertWebhookTimeout},
+		logger:      logging.GetCoreLogger(),
+		health:      make(map[string]DiskHealthStatus),
+	}
+}
+
+// OnAlert registers a handler called for every alert after the command and
+// webhook. It must be called before Run.
+func (a *DiskAlerter) OnAlert(handler func(DiskAlert)) {
+	a.handlers = append(a.handlers, handler)
+}
+
+// Run checks disk health every AlertInterval until the context is cancelled.
+// Disks that are already unhealthy alert on the first check.
+func (a *DiskAlerter) Run(ctx context.Context) {
+	if a.config.AlertInterval <= 0 {
+		a.logger.Debug("Disk alerts are disabled")
+		return
+	}
+
+	ticker := time.NewTicker(a.config.AlertInterval)
+	defer ticker.Stop()
+
+	a.logger.WithField("interval", a.config.AlertInterval).Info("Disk alert monitor started")
+	defer a.logger.Info("Disk alert monitor stopped")
+
+	a.Check(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.Check(ctx)
+		}
+	}
+}
+
+// Check compares the health of every configured path with the last check and
+// alerts for the ones that got worse. Paths that can't be checked keep their
+// last known health, so an unreachable disk doesn't raise a false alarm.
+func (a *DiskAlerter) Check(ctx context.Context) {
+	paths := a.diskService.GetAllConfiguredPaths()
+	diskInfos, _ := a.diskService.getDiskSpaces(ctx, paths)
+
+	for i, path := range paths {
+		diskInfo := diskInfos[i]
+		if diskInfo == nil {
+			continue
+		}
+
+		health := a.diskService.getDiskHealthStatus(diskInfo)
+		previous, known := a.health[path]
+		if !known {
+			previous = DiskHealthGood
+		}
+		a.health[path] = health
+
+		switch {
+		case health != DiskHealthGood && a.diskService.isWorseHealth(health, previous):
+			a.alert(ctx, DiskAlert{
+				Path:        path,
+				OldHealth:   previous,
+				Health:      health,
+				Free:        diskInfo.Free,
+				Total:       diskInfo.Total,
+				FreePercent: diskInfo.FreePercent,
+				Time:        time.Now(),
+			})
+		case health == DiskHealthGood && previous != DiskHealthGood:
+			a.logger.WithFields(map[string]interface{}{
+				"path":         path,
+				"free_space":   qbittorrent.FormatBytes(diskInfo.Free),
+				"free_percent": fmt.Sprintf("%.1f%%", diskInfo.FreePercent),
+			}).Info("✅ Disk space recovered")
+		}
+	}
+}
+
+// alert logs the alert and triggers the configured command, webhook and handlers
+func (a *DiskAlerter) alert(ctx context.Context, alert DiskAlert) {
+	a.logger.WithFields(map[string]interface{}{
+		"path":         alert.Path,
+		"old_health":   alert.OldHealth,
+		"health":       alert.Health,
+		"free_space":   qbittorrent.FormatBytes(alert.Free),
+		"free_percent": fmt.Sprintf("%.1f%%", alert.FreePercent),
+	}).Warn(fmt.Sprintf("🚨 LOW DISK SPACE: %s is %s with %s free (%.1f%%)",
+		alert.Path, alert.Health, qbittorrent.FormatBytes(alert.Free), alert.FreePercent))
+
+	if a.config.AlertCommand != "" {
+		if err := a.runCommand(ctx, alert); err != nil {
+			a.logger.WithError(err).WithField("path", alert.Path).Error("Disk alert command failed")
+		}
+	}
+
+	if a.config.AlertWebhook != "" {
+		if err := a.postWebhook(ctx, alert); err != nil {
+			a.logger.WithError(err).WithField("path", alert.Path).Error("Disk alert webhook failed")
+		}
+	}
+
+	for _, handler := range a.handlers {
+		handler(alert)
+	}
+}
+
+// runCommand runs DISK_ALERT_COMMAND through the shell with the alert in
+// AKIRA_DISK_* environment variables
+func (a *DiskAlerter) runCommand(ctx context.Context, alert DiskAlert) error {
+	ctx, cancel := context.WithTimeout(ctx, diskAlertCommandTimeout)
+	defer cancel()
+
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+
+	cmd := exec.CommandContext(ctx, shell, flag, a.config.AlertCommand)
+	cmd.Env = append(os.Environ(),
+		"AKIRA_DISK_PATH="+alert.Path,
+		"AKIRA_DISK_HEALTH="+string(alert.Health),
+		"AKIRA_DISK_OLD_HEALTH="+string(alert.OldHealth),
+		"AKIRA_DISK_FREE="+strconv.FormatInt(alert.Free, 10),
+		"AKIRA_DISK_TOTAL="+strconv.FormatInt(alert.Total, 10),
+		"AKIRA_DISK_FREE_PERCENT="+strconv.FormatFloat(alert.FreePercent, 'f', 1, 64),
+	)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to run disk alert command: %w (output: %s)", err, bytes.TrimSpace(output))
+	}
+	return nil
+}
+
+// postWebhook POSTs the alert as JSON to DISK_ALERT_WEBHOOK_URL
+func (a *DiskAlerter) postWebhook(ctx context.Context, alert DiskAlert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("failed to marshal disk alert: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.config.AlertWebhook, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send disk alert webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("disk alert webhook returned HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -2,20 +2,28 @@ package core
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"time"
 
+	"golang.org/x/sync/errgroup"
+
 	"github.com/raainshe/akira/internal/cache"
 	"github.com/raainshe/akira/internal/config"
 	"github.com/raainshe/akira/internal/logging"
 	"github.com/raainshe/akira/internal/qbittorrent"
 )
 
+// diskCheckConcurrency bounds how many paths are checked for disk space at once
+const diskCheckConcurrency = 4
+
 // DiskService provides cross-platform disk space operations
 type DiskService struct {
 	config *config.Config
 	cache  *cache.CacheManager
+	client *qbittorrent.Client
 	logger *logging.Logger
 }
 
@@ -29,18 +37,35 @@ type DiskInfo struct {
 	UsedPercent float64   `json:"used_percent"` // Used percentage (0-100)
 	FreePercent float64   `json:"free_percent"` // Free percentage (0-100)
 	Filesystem  string    `json:"filesystem"`   // Filesystem type (if available)
-	MountPoint  string    `json:"mount_point"`  // Mount point (Unix)
+	MountPoint  string    `json:"mount_point"`  // Mount point (Unix) or volume root (Windows)
+	DeviceID    string    `json:"device_id"`    // Identifier of the underlying filesystem/device
 	LastChecked time.Time `json:"last_checked"` // When this info was last updated
+
+	Health DiskHealthStatus `json:"health"` // Health by the configured free space thresholds
+}
+
+// PhysicalDisk represents a single filesystem and the configured paths that live on it
+type PhysicalDisk struct {
+	DeviceID   string    `json:"device_id"`   // Identifier of the underlying filesystem/device
+	MountPoint string    `json:"mount_point"` // Mount point of the filesystem
+	Info       *DiskInfo `json:"info"`        // Disk space information for the filesystem
+	Paths      []string  `json:"paths"`       // Configured paths on this filesystem
+	Categories []string  `json:"categories"`  // Categories whose save paths are on this filesystem
+}
+
+// HasCapacity returns false for paths that report no total size (unmounted or pseudo filesystems)
+func (d *DiskInfo) HasCapacity() bool {
+	return d.Total > 0
 }
 
 // DiskHealthStatus represents the health status of disk space
 type DiskHealthStatus string
 
 const (
-	DiskHealthGood     DiskHealthStatus = "good"     // > 20% free space
-	DiskHealthWarning  DiskHealthStatus = "warning"  // 10-20% free space
-	DiskHealthCritical DiskHealthStatus = "critical" // 5-10% free space
-	DiskHealthDanger   DiskHealthStatus = "danger"   // < 5% free space
+	DiskHealthGood     DiskHealthStatus = "good"     // At least the warning threshold free (default 20%)
+	DiskHealthWarning  DiskHealthStatus = "warning"  // Below the warning threshold (default 10-20% free)
+	DiskHealthCritical DiskHealthStatus = "critical" // Below the critical threshold (default 5-10% free)
+	DiskHealthDanger   DiskHealthStatus = "danger"   // Below the danger threshold (default < 5% free)
 )
 
 // DiskSummary represents a summary of all monitored disk spaces
@@ -55,11 +80,13 @@ type DiskSummary struct {
 	LastUpdated   time.Time            `json:"last_updated"`   // When this summary was generated
 }
 
-// NewDiskService creates a new disk service instance
-func NewDiskService(config *config.Config, cache *cache.CacheManager) *DiskService {
+// NewDiskService creates a new disk service instance. The client is only used
+// when DISK_SPACE_SOURCE is qbittorrent and may be nil otherwise.
+func NewDiskService(config *config.Config, cache *cache.CacheManager, client *qbittorrent.Client) *DiskService {
 	return &DiskService{
 		config: config,
 		cache:  cache,
+		client: client,
 		logger: logging.GetCoreLogger(),
 	}
 }
@@ -79,7 +106,7 @@ func (ds *DiskService) GetDiskSpace(ctx context.Context, path string) (*DiskInfo
 	if ds.cache != nil {
 		if cachedDisk, found := ds.cache.GetDiskSpace(normalizedPath); found {
 			ds.logger.WithField("path", normalizedPath).Debug("Using cached disk space information")
-			return &DiskInfo{
+			diskInfo := &DiskInfo{
 				Path:        normalizedPath,
 				Total:       cachedDisk.Total,
 				Used:        cachedDisk.Used,
@@ -87,21 +114,30 @@ func (ds *DiskService) GetDiskSpace(ctx context.Context, path string) (*DiskInfo
 				Available:   cachedDisk.Free, // For cache compatibility
 				UsedPercent: ds.calculatePercentage(cachedDisk.Used, cachedDisk.Total),
 				FreePercent: ds.calculatePercentage(cachedDisk.Free, cachedDisk.Total),
+				MountPoint:  cachedDisk.MountPoint,
+				Filesystem:  cachedDisk.Filesystem,
+				DeviceID:    cachedDisk.DeviceID,
 				LastChecked: cachedDisk.UpdatedAt,
-			}, nil
+			}
+			diskInfo.Health = ds.getDiskHealthStatus(diskInfo)
+			return diskInfo, nil
 		}
 	}
 
 	// Get fresh disk space information
-	diskInfo, err := ds.getDiskSpacePlatform(normalizedPath)
+	diskInfo, err := ds.fetchDiskSpace(ctx, normalizedPath)
 	if err != nil {
 		ds.logger.WithError(err).WithField("path", normalizedPath).Error("Failed to get disk space")
 		return nil, fmt.Errorf("failed to get disk space for %s: %w", normalizedPath, err)
 	}
+	diskInfo.Health = ds.getDiskHealthStatus(diskInfo)
 
 	// Cache the result
 	if ds.cache != nil {
 		cacheInfo := cache.NewDiskSpaceInfo(normalizedPath, diskInfo.Total, diskInfo.Used, diskInfo.Free)
+		cacheInfo.DeviceID = diskInfo.DeviceID
+		cacheInfo.MountPoint = diskInfo.MountPoint
+		cacheInfo.Filesystem = diskInfo.Filesystem
 		ds.cache.SetDiskSpace(normalizedPath, cacheInfo)
 	}
 
@@ -129,19 +165,25 @@ func (ds *DiskService) GetAllDiskSpaces(ctx context.Context) (*DiskSummary, erro
 	}
 
 	// Get all configured paths
-	paths := ds.getAllConfiguredPaths()
+	paths := ds.GetAllConfiguredPaths()
+	diskInfos, _ := ds.getDiskSpaces(ctx, paths)
+	countedDevices := make(map[string]bool)
 
-	for _, path := range paths {
-		diskInfo, err := ds.GetDiskSpace(ctx, path)
-		if err != nil {
-			ds.logger.WithError(err).WithField("path", path).Warn("Failed to get disk space for configured path")
+	for i, path := range paths {
+		diskInfo := diskInfos[i]
+		if diskInfo == nil {
 			continue
 		}
 
 		summary.Paths[path] = diskInfo
-		summary.TotalSpace += diskInfo.Total
-		summary.TotalUsed += diskInfo.Used
-		summary.TotalFree += diskInfo.Free
+
+		// Only count each physical disk once in the totals
+		if diskInfo.DeviceID == "" || !countedDevices[diskInfo.DeviceID] {
+			countedDevices[diskInfo.DeviceID] = true
+			summary.TotalSpace += diskInfo.Total
+			summary.TotalUsed += diskInfo.Used
+			summary.TotalFree += diskInfo.Free
+		}
 
 		// Check health status
 		health := ds.getDiskHealthStatus(diskInfo)
@@ -170,12 +212,113 @@ func (ds *DiskService) GetAllDiskSpaces(ctx context.Context) (*DiskSummary, erro
 	return summary, nil
 }
 
+// GetPhysicalDisks resolves every configured path to its underlying filesystem and
+// returns one entry per physical disk, listing the categories stored on it
+func (ds *DiskService) GetPhysicalDisks(ctx context.Context) ([]*PhysicalDisk, error) {
+	ds.logger.Debug("Resolving configured paths to physical disks")
+
+	categoriesByPath := ds.getCategoriesByPath()
+
+	paths := ds.GetAllConfiguredPaths()
+	diskInfos, checkErr := ds.getDiskSpaces(ctx, paths)
+
+	var disks []*PhysicalDisk
+	byDevice := make(map[string]*PhysicalDisk)
+
+	for i, path := range paths {
+		diskInfo := diskInfos[i]
+		if diskInfo == nil {
+			continue
+		}
+
+		// Fall back to the path itself when the device can't be identified
+		deviceID := diskInfo.DeviceID
+		if deviceID == "" {
+			deviceID = diskInfo.Path
+		}
+
+		disk, exists := byDevice[deviceID]
+		if !exists {
+			mountPoint := diskInfo.MountPoint
+			if mountPoint == "" {
+				mountPoint = diskInfo.Path
+			}
+			disk = &PhysicalDisk{
+				DeviceID:   deviceID,
+				MountPoint: mountPoint,
+				Info:       diskInfo,
+				Paths:      []string{},
+				Categories: []string{},
+			}
+			byDevice[deviceID] = disk
+			disks = append(disks, disk)
+		}
+
+		disk.Paths = append(disk.Paths, path)
+		disk.Categories = append(disk.Categories, categoriesByPath[path]...)
+	}
+
+	if len(disks) == 0 {
+		if checkErr != nil {
+			return nil, fmt.Errorf("no configured paths could be checked: %w", checkErr)
+		}
+		return nil, fmt.Errorf("no configured paths could be checked")
+	}
+
+	ds.logger.WithFields(map[string]interface{}{
+		"paths": len(paths),
+		"disks": len(disks),
+	}).Info("Resolved configured paths to physical disks")
+
+	return disks, nil
+}
+
+// getDiskSpaces checks the given paths concurrently, at most diskCheckConcurrency at
+// a time, which matters for slow network filesystems. The results are in the order
+// of paths, with nil for paths that failed; their errors are logged and joined.
+func (ds *DiskService) getDiskSpaces(ctx context.Context, paths []string) ([]*DiskInfo, error) {
+	diskInfos := make([]*DiskInfo, len(paths))
+	errs := make([]error, len(paths))
+
+	// A failing path must not cancel the others, so the workers never return errors
+	var group errgroup.Group
+	group.SetLimit(diskCheckConcurrency)
+
+	for i, path := range paths {
+		group.Go(func() error {
+			diskInfo, err := ds.GetDiskSpace(ctx, path)
+			if err != nil {
+				ds.logger.WithError(err).WithField("path", path).Warn("Failed to get disk space for configured path")
+				errs[i] = err
+				return nil
+			}
+			diskInfos[i] = diskInfo
+			return nil
+		})
+	}
+	group.Wait()
+
+	return diskInfos, errors.Join(errs...)
+}
+
+// getCategoriesByPath maps each configured save path to the categories that use it
+func (ds *DiskService) getCategoriesByPath() map[string][]string {
+	categoriesByPath := make(map[string][]string)
+	for _, category := range ds.config.GetValidCategories() {
+		path := ds.config.GetConfiguredSavePath(category)
+		if path != "" {
+			categoriesByPath[path] = append(categoriesByPath[path], category)
+		}
+	}
+	return categoriesByPath
+}
+
 // CheckDiskHealth performs a health check on all configured disk paths
 func (ds *DiskService) CheckDiskHealth(ctx context.Context) (map[string]DiskHealthStatus, error) {
 	ds.logger.Debug("Performing disk health check")
 
 	healthStatus := make(map[string]DiskHealthStatus)
-	paths := ds.getAllConfiguredPaths()
+	paths := ds.GetAllConfiguredPaths()
 
 	for _, path := range paths {
 		diskInfo, err := ds.GetDiskSpace(ctx, path)
@@ -219,6 +362,46 @@ func (ds *DiskService) FormatDiskInfo(diskInfo *DiskInfo) string {
 	)
 }
 
+// fetchDiskSpace gets disk space from the configured source. When qBittorrent
+// can't report it, the local platform implementation is used instead.
+func (ds *DiskService) fetchDiskSpace(ctx context.Context, path string) (*DiskInfo, error) {
+	if ds.config.QBittorrent.DiskSpaceSource == config.DiskSpaceSourceQBittorrent && ds.client != nil {
+		diskInfo, err := ds.getDiskSpaceFromClient(ctx, path)
+		if err == nil {
+			return diskInfo, nil
+		}
+		ds.logger.WithError(err).WithField("path", path).Warn("Failed to get disk space from qBittorrent, checking locally")
+	}
+
+	return ds.getDiskSpacePlatform(path)
+}
+
+// getDiskSpaceFromClient gets the free space qBittorrent reports for its default
+// save path. qBittorrent doesn't report the disk size, so Total is 0 and usage
+// percentages and health checks don't apply.
+func (ds *DiskService) getDiskSpaceFromClient(ctx context.Context, path string) (*DiskInfo, error) {
+	space, err := ds.client.GetDiskSpace(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	mountPoint := space.Path
+	if mountPoint == "" {
+		mountPoint = path
+	}
+
+	// qBittorrent measures a single disk, so every path shares one device
+	return &DiskInfo{
+		Path:        path,
+		Free:        space.Free,
+		Available:   space.Free,
+		Filesystem:  config.DiskSpaceSourceQBittorrent,
+		MountPoint:  mountPoint,
+		DeviceID:    config.DiskSpaceSourceQBittorrent,
+		LastChecked: time.Now(),
+	}, nil
+}
+
 // Platform-specific implementations are in disk_service_unix.go and disk_service_windows.go
 
 // Helper methods
@@ -241,22 +424,19 @@ func (ds *DiskService) normalizePath(path string) (string, error) {
 	return absPath, nil
 }
 
-// getAllConfiguredPaths returns all configured torrent save paths
-func (ds *DiskService) getAllConfiguredPaths() []string {
+// GetAllConfiguredPaths returns all configured torrent save paths
+func (ds *DiskService) GetAllConfiguredPaths() []string {
 	paths := []string{}
 
 	// Add all configured save paths
 	if ds.config.QBittorrent.SavePaths.Default != "" {
 		paths = append(paths, ds.config.QBittorrent.SavePaths.Default)
 	}
-	if ds.config.QBittorrent.SavePaths.Series != "" && ds.config.QBittorrent.SavePaths.Series != ds.config.QBittorrent.SavePaths.Default {
-		paths = append(paths, ds.config.QBittorrent.SavePaths.Series)
-	}
-	if ds.config.QBittorrent.SavePaths.Movies != "" && ds.config.QBittorrent.SavePaths.Movies != ds.config.QBittorrent.SavePaths.Default {
-		paths = append(paths, ds.config.QBittorrent.SavePaths.Movies)
-	}
-	if ds.config.QBittorrent.SavePaths.Anime != "" && ds.config.QBittorrent.SavePaths.Anime != ds.config.QBittorrent.SavePaths.Default {
-		paths = append(paths, ds.config.QBittorrent.SavePaths.Anime)
+	for _, category := range ds.config.Categories() {
+		path := ds.config.GetConfiguredSavePath(category.Name)
+		if path != "" && !slices.Contains(paths, path) {
+			paths = append(paths, path)
+		}
 	}
 
 	// Add disk space check path if different
@@ -295,14 +475,21 @@ func (ds *DiskService) calculatePercentage(part, total int64) float64 {
 }
 
 // getDiskHealthStatus determines the health status based on free space percentage
+// and the configured thresholds
 func (ds *DiskService) getDiskHealthStatus(diskInfo *DiskInfo) DiskHealthStatus {
+	// Zero-total paths (pseudo or unmounted filesystems) have no usage to warn about
+	if !diskInfo.HasCapacity() {
+		return DiskHealthGood
+	}
+
 	freePercent := diskInfo.FreePercent
+	thresholds := ds.config.Disk.Thresholds
 
-	if freePercent < 5.0 {
+	if freePercent < thresholds.Danger {
 		return DiskHealthDanger
-	} else if freePercent < 10.0 {
+	} else if freePercent < thresholds.Critical {
 		return DiskHealthCritical
-	} else if freePercent < 20.0 {
+	} else if freePercent < thresholds.Warning {
 		return DiskHealthWarning
 	}
 	return DiskHealthGood
@@ -0,0 +1,17 @@
+//go:build darwin || freebsd
+
+package core
+
+import "syscall"
+
+// detectFilesystem returns the filesystem type name statfs reports, e.g. apfs or zfs
+func detectFilesystem(mountPoint string, stat *syscall.Statfs_t) string {
+	name := make([]byte, 0, len(stat.Fstypename))
+	for _, c := range stat.Fstypename {
+		if c == 0 {
+			break
+		}
+		name = append(name, byte(c))
+	}
+	return string(name)
+}
@@ -0,0 +1,85 @@
+//go:build linux
+
+package core
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// mountsFile lists the mounted filesystems of this process
+const mountsFile = "/proc/self/mounts"
+
+// filesystemMagics maps statfs f_type values to filesystem names, for when the
+// mount table can't be read
+var filesystemMagics = map[uint32]string{
+	0xEF53:     "ext4", // Shared by ext2, ext3 and ext4
+	0x9123683E: "btrfs",
+	0x58465342: "xfs",
+	0x2FC12FC1: "zfs",
+	0xF2F52010: "f2fs",
+	0x6969:     "nfs",
+	0xFF534D42: "cifs",
+	0xFE534D42: "smb2",
+	0x65735546: "fuse",
+	0x01021994: "tmpfs",
+	0x794C7630: "overlay",
+	0x5346544E: "ntfs",
+	0x4D44:     "vfat",
+	0x2011BAB0: "exfat",
+	0x73717368: "squashfs",
+}
+
+// detectFilesystem returns the type of the filesystem mounted at mountPoint from
+// the mount table, falling back to the statfs magic number. It returns an empty
+// string when the type can't be determined.
+func detectFilesystem(mountPoint string, stat *syscall.Statfs_t) string {
+	if fsType := readMountType(mountsFile, mountPoint); fsType != "" {
+		return fsType
+	}
+	return filesystemMagics[uint32(stat.Type)]
+}
+
+// readMountType looks up the filesystem type of mountPoint in a mounts file.
+// The last entry wins, since later mounts hide earlier ones at the same point.
+func readMountType(path, mountPoint string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	fsType := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// device mountpoint type options dump pass
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 3 && unescapeMountField(fields[1]) == mountPoint {
+			fsType = fields[2]
+		}
+	}
+	return fsType
+}
+
+// unescapeMountField decodes the octal escapes (e.g. \040 for a space) used in mounts files
+func unescapeMountField(field string) string {
+	if !strings.Contains(field, `\`) {
+		return field
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(field); i++ {
+		if field[i] == '\\' && i+3 < len(field) {
+			if value, err := strconv.ParseUint(field[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(value))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(field[i])
+	}
+	return b.String()
+}
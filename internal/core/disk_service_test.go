@@ -0,0 +1,30 @@
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/raainshe/akira/internal/config"
+)
+
+func TestZeroTotalDiskInfo(t *testing.T) {
+	ds := &DiskService{config: &config.Config{}}
+	ds.config.Disk.Thresholds = config.DiskThresholds{Warning: 20, Critical: 10, Danger: 5}
+
+	info := &DiskInfo{Path: "/mnt/empty"}
+	info.UsedPercent = ds.calculatePercentage(info.Used, info.Total)
+	info.FreePercent = ds.calculatePercentage(info.Available, info.Total)
+
+	if info.HasCapacity() {
+		t.Error("HasCapacity() = true for a zero total, want false")
+	}
+	for name, percent := range map[string]float64{"UsedPercent": info.UsedPercent, "FreePercent": info.FreePercent} {
+		if math.IsNaN(percent) || math.IsInf(percent, 0) || percent != 0 {
+			t.Errorf("%s = %v, want 0", name, percent)
+		}
+	}
+	// A zero free percentage would otherwise be below every threshold
+	if got := ds.getDiskHealthStatus(info); got != DiskHealthGood {
+		t.Errorf("getDiskHealthStatus(zero total) = %q, want %q", got, DiskHealthGood)
+	}
+}
@@ -5,6 +5,7 @@ package core
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"runtime"
 	"syscall"
 	"time"
@@ -15,7 +16,7 @@ func (ds *DiskService) getDiskSpacePlatform(path string) (*DiskInfo, error) {
 	ds.logger.WithField("platform", runtime.GOOS).Debug("Getting real disk space information")
 
 	// Get file info to ensure path exists
-	_, err := os.Stat(path)
+	fileInfo, err := os.Stat(path)
 	if err != nil {
 		return nil, fmt.Errorf("path does not exist: %w", err)
 	}
@@ -37,6 +38,14 @@ func (ds *DiskService) getDiskSpacePlatform(path string) (*DiskInfo, error) {
 	usedPercent := ds.calculatePercentage(used, total)
 	freePercent := ds.calculatePercentage(free, total)
 
+	// Identify the filesystem so paths on the same disk can be grouped
+	deviceID := ""
+	mountPoint := path
+	if sys, ok := fileInfo.Sys().(*syscall.Stat_t); ok {
+		deviceID = fmt.Sprintf("dev:%d", uint64(sys.Dev))
+		mountPoint = findMountPoint(path, uint64(sys.Dev))
+	}
+
 	return &DiskInfo{
 		Path:        path,
 		Total:       total,
@@ -45,8 +54,32 @@ func (ds *DiskService) getDiskSpacePlatform(path string) (*DiskInfo, error) {
 		Available:   free,
 		UsedPercent: usedPercent,
 		FreePercent: freePercent,
-		Filesystem:  "unknown", // Could be enhanced to detect filesystem type
-		MountPoint:  path,
+		Filesystem:  detectFilesystem(mountPoint, &stat),
+		MountPoint:  mountPoint,
+		DeviceID:    deviceID,
 		LastChecked: time.Now(),
 	}, nil
 }
+
+// findMountPoint walks up from path until the parent directory is on a different device
+func findMountPoint(path string, device uint64) string {
+	current := path
+	for {
+		parent := filepath.Dir(current)
+		if parent == current {
+			return current
+		}
+
+		info, err := os.Stat(parent)
+		if err != nil {
+			return current
+		}
+
+		sys, ok := info.Sys().(*syscall.Stat_t)
+		if !ok || uint64(sys.Dev) != device {
+			return current
+		}
+
+		current = parent
+	}
+}
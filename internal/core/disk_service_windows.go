@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strings"
 	"time"
 	"unsafe"
 
@@ -50,6 +51,22 @@ func (ds *DiskService) getDiskSpacePlatform(path string) (*DiskInfo, error) {
 	usedPercent := ds.calculatePercentage(used, total)
 	freePercent := ds.calculatePercentage(free, total)
 
+	// Resolve the volume root so paths on the same volume can be grouped
+	mountPoint := path
+	volumeBuf := make([]uint16, windows.MAX_PATH+1)
+	if err := windows.GetVolumePathName(pathPtr, &volumeBuf[0], uint32(len(volumeBuf))); err == nil {
+		mountPoint = windows.UTF16ToString(volumeBuf)
+	}
+
+	// Filesystem name of the volume (NTFS, ReFS, FAT32...), left empty if unavailable
+	filesystem := ""
+	if rootPtr, err := windows.UTF16PtrFromString(mountPoint); err == nil {
+		nameBuf := make([]uint16, windows.MAX_PATH+1)
+		if err := windows.GetVolumeInformation(rootPtr, nil, 0, nil, nil, nil, &nameBuf[0], uint32(len(nameBuf))); err == nil {
+			filesystem = windows.UTF16ToString(nameBuf)
+		}
+	}
+
 	return &DiskInfo{
 		Path:        path,
 		Total:       total,
@@ -58,8 +75,9 @@ func (ds *DiskService) getDiskSpacePlatform(path string) (*DiskInfo, error) {
 		Available:   free,
 		UsedPercent: usedPercent,
 		FreePercent: freePercent,
-		Filesystem:  "NTFS", // Most common on Windows, could be enhanced to detect actual type
-		MountPoint:  path,
+		Filesystem:  filesystem,
+		MountPoint:  mountPoint,
+		DeviceID:    strings.ToUpper(mountPoint),
 		LastChecked: time.Now(),
 	}, nil
 }
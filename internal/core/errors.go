@@ -0,0 +1,37 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// Errors returned by the core services. They are wrapped with details, so
+// check for them with errors.Is.
+var (
+	ErrTorrentNotFound = errors.New("torrent not found")      // No torrent matches the given hash
+	ErrInvalidMagnet   = errors.New("invalid magnet URI")     // The magnet URI is malformed
+	ErrInvalidCategory = errors.New("invalid category")       // The category is not configured
+	ErrInvalidPattern  = errors.New("invalid name pattern")   // The name filter is not a valid regular expression
+	ErrTorrentExists   = errors.New("torrent already exists") // The torrent being added is already in qBittorrent
+)
+
+// TorrentExistsError describes the torrent that is already in qBittorrent.
+// It matches ErrTorrentExists with errors.Is.
+type TorrentExistsError struct {
+	Hash     string
+	Name     string
+	Category string
+	State    qbittorrent.TorrentState
+}
+
+// Error implements the error interface
+func (e *TorrentExistsError) Error() string {
+	return fmt.Sprintf("torrent already exists: %s (%s)", e.Name, e.State)
+}
+
+// Is makes errors.Is(err, ErrTorrentExists) match
+func (e *TorrentExistsError) Is(target error) bool {
+	return target == ErrTorrentExists
+}
@@ -0,0 +1,239 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/raainshe/akira/internal/logging"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// EventType identifies the kind of event published to event subscribers
+type EventType string
+
+const (
+	EventTorrentAdded        EventType = "torrent_added"         // A torrent appeared in qBittorrent
+	EventTorrentRemoved      EventType = "torrent_removed"       // A torrent disappeared from qBittorrent
+	EventTorrentStateChanged EventType = "torrent_state_changed" // A torrent moved to a different state
+	EventTorrentCompleted    EventType = "torrent_completed"     // A torrent finished downloading
+	EventSeedingStopped      EventType = "seeding_stopped"       // The seeding service stopped a torrent
+	EventTorrentAutoDeleted  EventType = "torrent_auto_deleted"  // A completed public torrent was deleted automatically
+	EventDiskHealthChanged   EventType = "disk_health_changed"   // A disk moved to a different health status
+)
+
+// Event is a single change notification. It is serialized as {"type", "time", "payload"}.
+type Event struct {
+	Type    EventType   `json:"type"`
+	Time    time.Time   `json:"time"`
+	Payload interface{} `json:"payload"`
+}
+
+// TorrentEventPayload describes the torrent an event refers to
+type TorrentEventPayload struct {
+	Hash     string                   `json:"hash"`
+	Name     string                   `json:"name"`
+	Category string                   `json:"category,omitempty"`
+	OldState qbittorrent.TorrentState `json:"old_state,omitempty"`
+	State    qbittorrent.TorrentState `json:"state,omitempty"`
+	Progress float64                  `json:"progress"`
+}
+
+// DiskHealthEventPayload describes a disk health transition
+type DiskHealthEventPayload struct {
+	Path      string           `json:"path"`
+	OldHealth DiskHealthStatus `json:"old_health"`
+	Health    DiskHealthStatus `json:"health"`
+}
+
+// EventBus fans events out to subscribers without ever blocking publishers.
+// Events for a subscriber whose buffer is full are dropped.
+type EventBus struct {
+	mutex       sync.RWMutex
+	subscribers map[chan Event]struct{}
+	logger      *logging.Logger
+}
+
+// NewEventBus creates a new event bus
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subscribers: make(map[chan Event]struct{}),
+		logger:      logging.GetCoreLogger(),
+	}
+}
+
+// Subscribe registers a subscriber with the given buffer size. The returned
+// function unsubscribes and closes the channel; it is safe to call more than once.
+func (b *EventBus) Subscribe(buffer int) (<-chan Event, func()) {
+	ch := make(chan Event, buffer)
+
+	b.mutex.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mutex.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mutex.Lock()
+			delete(b.subscribers, ch)
+			b.mutex.Unlock()
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
+
+// Publish sends an event to all subscribers
+func (b *EventBus) Publish(eventType EventType, payload interface{}) {
+	event := Event{
+		Type:    eventType,
+		Time:    time.Now(),
+		Payload: payload,
+	}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			b.logger.WithField("type", eventType).Warn("Dropping event for slow subscriber")
+		}
+	}
+}
+
+// SubscriberCount returns the number of active subscribers
+func (b *EventBus) SubscriberCount() int {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	return len(b.subscribers)
+}
+
+// EventMonitor polls torrents and disks and publishes the changes it sees
+type EventMonitor struct {
+	bus            *EventBus
+	torrentService *TorrentService
+	diskService    *DiskService
+	interval       time.Duration
+	logger         *logging.Logger
+
+	torrents   map[string]qbittorrent.Torrent
+	diskHealth map[string]DiskHealthStatus
+}
+
+// NewEventMonitor creates a monitor that checks for changes every interval
+func NewEventMonitor(bus *EventBus, torrentService *TorrentService, diskService *DiskService, interval time.Duration) *EventMonitor {
+	return &EventMonitor{
+		bus:            bus,
+		torrentService: torrentService,
+		diskService:    diskService,
+		interval:       interval,
+		logger:         logging.GetCoreLogger(),
+	}
+}
+
+// Run polls until the context is cancelled. The first poll only records the
+// current state, so no events are published for pre-existing torrents.
+func (m *EventMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	m.poll(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			m.logger.Debug("Event monitor stopped")
+			return
+		case <-ticker.C:
+			m.poll(ctx)
+		}
+	}
+}
+
+// poll fetches the current state and publishes the differences from the last poll
+func (m *EventMonitor) poll(ctx context.Context) {
+	torrents, err := m.torrentService.GetTorrents(ctx, nil)
+	if err != nil {
+		m.logger.WithError(err).Warn("Event monitor failed to fetch torrents")
+	} else {
+		m.diffTorrents(torrents)
+	}
+
+	health, err := m.diskService.CheckDiskHealth(ctx)
+	if err != nil {
+		m.logger.WithError(err).Warn("Event monitor failed to check disk health")
+	} else {
+		m.diffDiskHealth(health)
+	}
+}
+
+// diffTorrents publishes added, removed, state-changed and completed torrents
+func (m *EventMonitor) diffTorrents(torrents []qbittorrent.Torrent) {
+	current := make(map[string]qbittorrent.Torrent, len(torrents))
+	for _, torrent := range torrents {
+		current[torrent.Hash] = torrent
+	}
+
+	if m.torrents == nil {
+		m.torrents = current
+		return
+	}
+
+	for hash, torrent := range current {
+		previous, existed := m.torrents[hash]
+		if !existed {
+			m.bus.Publish(EventTorrentAdded, newTorrentEventPayload(torrent, ""))
+			continue
+		}
+
+		if previous.State != torrent.State {
+			m.bus.Publish(EventTorrentStateChanged, newTorrentEventPayload(torrent, previous.State))
+		}
+		if !previous.IsCompleted() && torrent.IsCompleted() {
+			m.bus.Publish(EventTorrentCompleted, newTorrentEventPayload(torrent, ""))
+		}
+	}
+
+	for hash, torrent := range m.torrents {
+		if _, exists := current[hash]; !exists {
+			m.bus.Publish(EventTorrentRemoved, newTorrentEventPayload(torrent, ""))
+		}
+	}
+
+	m.torrents = current
+}
+
+// diffDiskHealth publishes disk health transitions
+func (m *EventMonitor) diffDiskHealth(health map[string]DiskHealthStatus) {
+	if m.diskHealth == nil {
+		m.diskHealth = health
+		return
+	}
+
+	for path, status := range health {
+		if previous, known := m.diskHealth[path]; known && previous != status {
+			m.bus.Publish(EventDiskHealthChanged, DiskHealthEventPayload{
+				Path:      path,
+				OldHealth: previous,
+				Health:    status,
+			})
+		}
+	}
+
+	m.diskHealth = health
+}
+
+// newTorrentEventPayload builds the payload for a torrent event
+func newTorrentEventPayload(torrent qbittorrent.Torrent, oldState qbittorrent.TorrentState) TorrentEventPayload {
+	return TorrentEventPayload{
+		Hash:     torrent.Hash,
+		Name:     torrent.Name,
+		Category: torrent.Category,
+		OldState: oldState,
+		State:    torrent.State,
+		Progress: torrent.Progress,
+	}
+}
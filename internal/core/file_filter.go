@@ -0,0 +1,78 @@
+package core
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// regexPatternPrefix marks a file pattern as a regular expression instead of a glob
+const regexPatternPrefix = "re:"
+
+// FilePatternMatcher matches torrent file names against glob and regex patterns.
+// Globs such as "*sample*" or "*.nfo" are matched case-insensitively against both the
+// file's base name and its path within the torrent. Patterns starting with "re:" are
+// case-insensitive regular expressions matched against the path.
+type FilePatternMatcher struct {
+	globs   []string
+	regexes []*regexp.Regexp
+}
+
+// NewFilePatternMatcher compiles the given patterns
+func NewFilePatternMatcher(patterns []string) (*FilePatternMatcher, error) {
+	matcher := &FilePatternMatcher{}
+
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		if expr, ok := strings.CutPrefix(pattern, regexPatternPrefix); ok {
+			re, err := regexp.Compile("(?i)" + expr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid file pattern '%s': %w", pattern, err)
+			}
+			matcher.regexes = append(matcher.regexes, re)
+			continue
+		}
+
+		glob := strings.ToLower(pattern)
+		if _, err := path.Match(glob, ""); err != nil {
+			return nil, fmt.Errorf("invalid file pattern '%s': %w", pattern, err)
+		}
+		matcher.globs = append(matcher.globs, glob)
+	}
+
+	return matcher, nil
+}
+
+// Empty returns true if the matcher has no patterns
+func (m *FilePatternMatcher) Empty() bool {
+	return len(m.globs) == 0 && len(m.regexes) == 0
+}
+
+// Match returns true if the file name (a path within the torrent) matches any pattern
+func (m *FilePatternMatcher) Match(name string) bool {
+	name = strings.ReplaceAll(name, "\\", "/")
+	lowerName := strings.ToLower(name)
+	baseName := path.Base(lowerName)
+
+	for _, glob := range m.globs {
+		if matched, _ := path.Match(glob, baseName); matched {
+			return true
+		}
+		if matched, _ := path.Match(glob, lowerName); matched {
+			return true
+		}
+	}
+
+	for _, re := range m.regexes {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,111 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// LeaderboardSort selects how the seeding leaderboard is ranked
+type LeaderboardSort string
+
+const (
+	LeaderboardByRatio    LeaderboardSort = "ratio"    // Rank by share ratio
+	LeaderboardByUploaded LeaderboardSort = "uploaded" // Rank by total uploaded bytes
+)
+
+// LeaderboardEntry is a single ranked torrent on the seeding leaderboard
+type LeaderboardEntry struct {
+	Rank     int                      `json:"rank"`
+	Hash     string                   `json:"hash"`
+	Name     string                   `json:"name"`
+	Ratio    float64                  `json:"ratio"`
+	Uploaded int64                    `json:"uploaded"`
+	Size     int64                    `json:"size"`
+	State    qbittorrent.TorrentState `json:"state"`
+}
+
+// ParseLeaderboardSort converts a user-supplied ranking name to a LeaderboardSort
+func ParseLeaderboardSort(value string) (LeaderboardSort, error) {
+	switch LeaderboardSort(strings.ToLower(strings.TrimSpace(value))) {
+	case LeaderboardByRatio:
+		return LeaderboardByRatio, nil
+	case LeaderboardByUploaded:
+		return LeaderboardByUploaded, nil
+	default:
+		return "", fmt.Errorf("invalid leaderboard ranking: %s (must be one of: %s, %s)", value, LeaderboardByRatio, LeaderboardByUploaded)
+	}
+}
+
+// RankSeeders ranks torrents by ratio or uploaded bytes, best first. Ties are broken by
+// the other metric and then by name. A limit of 0 or less returns every torrent.
+func RankSeeders(torrents []qbittorrent.Torrent, by LeaderboardSort, limit int) []LeaderboardEntry {
+	ranked := make([]qbittorrent.Torrent, len(torrents))
+	copy(ranked, torrents)
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i], ranked[j]
+		if by == LeaderboardByUploaded {
+			if a.Uploaded != b.Uploaded {
+				return a.Uploaded > b.Uploaded
+			}
+			if a.Ratio != b.Ratio {
+				return a.Ratio > b.Ratio
+			}
+		} else {
+			if a.Ratio != b.Ratio {
+				return a.Ratio > b.Ratio
+			}
+			if a.Uploaded != b.Uploaded {
+				return a.Uploaded > b.Uploaded
+			}
+		}
+		return a.Name < b.Name
+	})
+
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	entries := make([]LeaderboardEntry, len(ranked))
+	for i, torrent := range ranked {
+		entries[i] = LeaderboardEntry{
+			Rank:     i + 1,
+			Hash:     torrent.Hash,
+			Name:     torrent.Name,
+			Ratio:    torrent.Ratio,
+			Uploaded: torrent.Uploaded,
+			Size:     torrent.Size,
+			State:    torrent.State,
+		}
+	}
+	return entries
+}
+
+// GetLeaderboard ranks the tracked torrents by ratio or uploaded bytes using live torrent data
+func (ss *SeedingService) GetLeaderboard(ctx context.Context, by LeaderboardSort, limit int) ([]LeaderboardEntry, error) {
+	torrents, err := ss.torrentService.GetTorrents(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get torrents: %w", err)
+	}
+
+	ss.dataMutex.RLock()
+	tracked := make([]qbittorrent.Torrent, 0, len(ss.trackingData))
+	for _, torrent := range torrents {
+		if _, exists := ss.trackingData[torrent.Hash]; exists {
+			tracked = append(tracked, torrent)
+		}
+	}
+	ss.dataMutex.RUnlock()
+
+	ss.logger.WithFields(map[string]interface{}{
+		"by":      by,
+		"limit":   limit,
+		"tracked": len(tracked),
+	}).Debug("Generating seeding leaderboard")
+
+	return RankSeeders(tracked, by, limit), nil
+}
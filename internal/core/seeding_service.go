@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"sync"
 	"time"
@@ -22,6 +23,7 @@ type SeedingService struct {
 
 	// Tracking data
 	trackingData map[string]*qbittorrent.SeedingTrackingData
+	missingTicks map[string]int // Reconciles in a row a tracked torrent was missing from qBittorrent
 	dataMutex    sync.RWMutex
 
 	// Background processing
@@ -29,6 +31,23 @@ type SeedingService struct {
 	ticker       *time.Ticker
 	isRunning    bool
 	runningMutex sync.RWMutex
+
+	// Optional event bus notified when seeding is stopped
+	events *EventBus
+
+	// Optional webhook notified when a tracked torrent completes, and any
+	// further handlers registered with OnTorrentCompleted
+	completionWebhook  *CompletionWebhook
+	completionHandlers []func(TorrentCompletion)
+
+	// Pause state; while paused tracking continues but nothing is auto-stopped
+	paused   bool
+	pausedAt time.Time
+}
+
+// seedingPauseState is the content of the pause state file
+type seedingPauseState struct {
+	PausedAt time.Time `json:"paused_at"`
 }
 
 // SeedingStatus represents the current status of seeding management
@@ -41,6 +60,9 @@ type SeedingStatus struct {
 	TotalSeedingTime  time.Duration                    `json:"total_seeding_time"`
 	Details           map[string]*SeedingTorrentStatus `json:"details"`
 	LastChecked       time.Time                        `json:"last_checked"`
+	IsRunning         bool                             `json:"is_running"` // Whether the background limit checks are running
+	Paused            bool                             `json:"paused"`
+	PausedAt          time.Time                        `json:"paused_at,omitempty"`
 }
 
 // SeedingTorrentStatus represents the seeding status of an individual torrent
@@ -55,6 +77,38 @@ type SeedingTorrentStatus struct {
 	AutoStopped      bool          `json:"auto_stopped"`
 	CurrentState     string        `json:"current_state"`
 	SeedingStopTime  time.Time     `json:"seeding_stop_time"`
+	Ratio            float64       `json:"ratio"`
+	RatioLimit       float64       `json:"ratio_limit,omitempty"` // Zero when no ratio limit applies
+	StopReason       string        `json:"stop_reason,omitempty"` // Which limit stopped seeding, see qbittorrent.SeedingStopReason*
+}
+
+// TrackingIssueType identifies a kind of drift between tracking data and qBittorrent
+type TrackingIssueType string
+
+const (
+	TrackingIssueMissingTorrent    TrackingIssueType = "missing_torrent"    // Tracked torrent no longer exists in qBittorrent
+	TrackingIssuePausedNotStopped  TrackingIssueType = "paused_not_stopped" // Torrent is paused but not marked as stopped
+	TrackingIssueMissingCompletion TrackingIssueType = "missing_completion" // Torrent is complete but has no completion time
+)
+
+// reconcileMissingTicks is how many reconciles in a row a tracked torrent has
+// to be missing from qBittorrent before its tracking is dropped
+const reconcileMissingTicks = 2
+
+// TrackingIssue describes a single inconsistency found while verifying tracking data
+type TrackingIssue struct {
+	Hash        string            `json:"hash"`
+	Name        string            `json:"name"`
+	Type        TrackingIssueType `json:"type"`
+	Description string            `json:"description"`
+	Repaired    bool              `json:"repaired"`
+}
+
+// TrackingVerification is the result of cross-checking tracking data against qBittorrent
+type TrackingVerification struct {
+	Checked  int             `json:"checked"`
+	Issues   []TrackingIssue `json:"issues"`
+	Repaired int             `json:"repaired"`
 }
 
 // NewSeedingService creates a new seeding service instance
@@ -65,7 +119,50 @@ func NewSeedingService(config *config.Config, torrentService *TorrentService, cl
 		client:         client,
 		logger:         logging.GetSeedingLogger(),
 		trackingData:   make(map[string]*qbittorrent.SeedingTrackingData),
+		missingTicks:   make(map[string]int),
 		stopChan:       make(chan struct{}),
+
+		completionWebhook: NewCompletionWebhook(config),
+	}
+}
+
+// SetEventBus makes the service publish seeding events to bus (nil disables publishing)
+func (ss *SeedingService) SetEventBus(bus *EventBus) {
+	ss.dataMutex.Lock()
+	defer ss.dataMutex.Unlock()
+	ss.events = bus
+}
+
+// publishSeedingStopped publishes a seeding_stopped event; the caller must hold dataMutex
+func (ss *SeedingService) publishSeedingStopped(hash, name, reason string) {
+	if ss.events == nil {
+		return
+	}
+	ss.events.Publish(EventSeedingStopped, map[string]interface{}{
+		"hash":   hash,
+		"name":   name,
+		"forced": reason == qbittorrent.SeedingStopReasonManual,
+		"reason": reason,
+	})
+}
+
+// OnTorrentCompleted registers a handler called when a tracked torrent finishes
+// downloading. Handlers are called with the tracking data locked, so they must
+// not block or call back into the service.
+func (ss *SeedingService) OnTorrentCompleted(handler func(TorrentCompletion)) {
+	ss.dataMutex.Lock()
+	defer ss.dataMutex.Unlock()
+	ss.completionHandlers = append(ss.completionHandlers, handler)
+}
+
+// notifyCompletedLocked passes a completion to the webhook and the registered
+// handlers; the caller must hold dataMutex
+func (ss *SeedingService) notifyCompletedLocked(completion TorrentCompletion) {
+	if ss.completionWebhook != nil {
+		ss.completionWebhook.Notify(completion)
+	}
+	for _, handler := range ss.completionHandlers {
+		handler(completion)
 	}
 }
 
@@ -93,10 +190,12 @@ func (ss *SeedingService) Start(ctx context.Context) error {
 	go ss.backgroundProcessor(ctx)
 
 	ss.logger.WithFields(map[string]interface{}{
-		"check_interval":   ss.config.Seeding.CheckInterval,
-		"time_multiplier":  ss.config.Seeding.TimeMultiplier,
-		"tracking_file":    ss.config.Seeding.TrackingDataFile,
-		"tracked_torrents": len(ss.trackingData),
+		"mode":                 ss.config.Seeding.Mode,
+		"check_interval":       ss.config.Seeding.CheckInterval,
+		"time_multiplier":      ss.config.Seeding.TimeMultiplier,
+		"category_multipliers": ss.config.Seeding.CategoryMultipliers,
+		"tracking_file":        ss.config.Seeding.TrackingDataFile,
+		"tracked_torrents":     len(ss.trackingData),
 	}).Info("Seeding management service started")
 
 	return nil
@@ -130,6 +229,11 @@ func (ss *SeedingService) Stop() error {
 		ss.logger.WithError(err).Error("Failed to save tracking data during shutdown")
 	}
 
+	// Give completion webhooks still being delivered a chance to finish
+	if ss.completionWebhook != nil {
+		ss.completionWebhook.Close()
+	}
+
 	ss.isRunning = false
 
 	// Create a new stop channel for next start
@@ -142,11 +246,14 @@ func (ss *SeedingService) Stop() error {
 
 // StartTracking begins tracking a new torrent for seeding management
 func (ss *SeedingService) StartTracking(ctx context.Context, hash, name string) error {
+	// Callers may pass the hash from a magnet link, which can be uppercase or base32
+	hash = NormalizeInfoHash(hash)
+
 	ss.dataMutex.Lock()
-	defer ss.dataMutex.Unlock()
 
 	// Check if already tracking
 	if _, exists := ss.trackingData[hash]; exists {
+		ss.dataMutex.Unlock()
 		ss.logger.WithField("hash", hash).Debug("Torrent already being tracked")
 		return nil
 	}
@@ -173,12 +280,172 @@ func (ss *SeedingService) StartTracking(ctx context.Context, hash, name string)
 			ss.logger.WithError(err).Error("Failed to save tracking data after starting tracking")
 		}
 	}()
+	ss.dataMutex.Unlock()
 
+	ss.tagTracked(ctx, []string{hash})
 	return nil
 }
 
+// tagTracked adds SEEDING_TRACKED_TAG to torrents that started being tracked.
+// Tracking doesn't depend on the tag, so failures are only logged.
+func (ss *SeedingService) tagTracked(ctx context.Context, hashes []string) {
+	tag := ss.config.Seeding.TrackedTag
+	if tag == "" || len(hashes) == 0 {
+		return
+	}
+
+	if err := ss.torrentService.AddTags(ctx, hashes, []string{tag}); err != nil {
+		ss.logger.WithError(err).WithField("tag", tag).Warn("Failed to tag tracked torrents")
+	}
+}
+
+// UntagTracked removes SEEDING_TRACKED_TAG from a torrent that stays in
+// qBittorrent after its tracking was stopped. It does nothing without a tag.
+func (ss *SeedingService) UntagTracked(ctx context.Context, hash string) error {
+	tag := ss.config.Seeding.TrackedTag
+	if tag == "" {
+		return nil
+	}
+	return ss.torrentService.RemoveTags(ctx, []string{hash}, []string{tag})
+}
+
+// GetTimeMultiplier returns the seeding time multiplier for a torrent. The
+// multiplier configured for the torrent's category takes precedence over the
+// global one; categories without their own multiplier use the global one.
+func (ss *SeedingService) GetTimeMultiplier(torrent qbittorrent.Torrent) float64 {
+	return ss.config.Seeding.MultiplierForCategory(ss.torrentService.TorrentCategory(torrent))
+}
+
+// GetRatioLimit returns the share ratio at which seeding stops for a torrent,
+// with the same category precedence as GetTimeMultiplier. Zero means no limit.
+func (ss *SeedingService) GetRatioLimit(torrent qbittorrent.Torrent) float64 {
+	return ss.config.Seeding.RatioLimitForCategory(ss.torrentService.TorrentCategory(torrent))
+}
+
+// seedingLimitReached returns the reason seeding of a completed torrent should
+// stop, or "" while neither the time limit nor the ratio limit has been reached
+func (ss *SeedingService) seedingLimitReached(torrent qbittorrent.Torrent, trackingData *qbittorrent.SeedingTrackingData, now time.Time) string {
+	if trackingData.DownloadCompleteTime.IsZero() {
+		return ""
+	}
+	if now.After(trackingData.SeedingStopTime) {
+		return qbittorrent.SeedingStopReasonTime
+	}
+	if limit := ss.GetRatioLimit(torrent); limit > 0 && torrent.Ratio >= limit {
+		return qbittorrent.SeedingStopReasonRatio
+	}
+	return ""
+}
+
+// backfillDownloadTimes fills in tracking times that are still unset from
+// qBittorrent's own timestamps, so torrents added outside Akira or tracked
+// after a restart get their real download duration. Times already tracked are
+// never overwritten. A completion qBittorrent has no time for is recorded as now.
+// It reports whether the start and the completion were set.
+func backfillDownloadTimes(torrent qbittorrent.Torrent, trackingData *qbittorrent.SeedingTrackingData, now time.Time) (started, completed bool) {
+	if trackingData.DownloadStartTime.IsZero() && torrent.AddedOn > 0 {
+		trackingData.DownloadStartTime = time.Unix(torrent.AddedOn, 0)
+		started = true
+	}
+
+	if !trackingData.DownloadCompleteTime.IsZero() || !torrent.IsCompleted() {
+		return started, false
+	}
+
+	completeTime := now
+	if torrent.CompletionOn > 0 {
+		completeTime = time.Unix(torrent.CompletionOn, 0)
+	}
+	trackingData.DownloadCompleteTime = completeTime
+
+	// Without a known start the download duration can't be measured
+	trackingData.DownloadDuration = 0
+	if !trackingData.DownloadStartTime.IsZero() && completeTime.After(trackingData.DownloadStartTime) {
+		trackingData.DownloadDuration = completeTime.Sub(trackingData.DownloadStartTime)
+	}
+	return started, true
+}
+
+// nativeMode returns true if qBittorrent enforces the limits instead of Akira
+func (ss *SeedingService) nativeMode() bool {
+	return ss.config.Seeding.Mode == config.SeedingModeNative
+}
+
+// nativeShareLimits converts a ratio limit (0 for none) and a seeding duration
+// into the values qBittorrent's share limits expect
+func nativeShareLimits(ratioLimit float64, seedingLimit time.Duration) (float64, int64) {
+	ratio := float64(qbittorrent.ShareLimitNone)
+	if ratioLimit > 0 {
+		ratio = ratioLimit
+	}
+	minutes := int64(math.Ceil(seedingLimit.Minutes()))
+	if minutes < 1 {
+		minutes = 1
+	}
+	return ratio, minutes
+}
+
+// syncShareLimitsLocked keeps qBittorrent's share limits for a completed torrent
+// in line with its tracked limits, and records the stop once qBittorrent has
+// paused the torrent at a limit. While auto-stopping is paused the limits are
+// removed. It returns true if the torrent was marked as stopped. The caller
+// must hold dataMutex.
+func (ss *SeedingService) syncShareLimitsLocked(ctx context.Context, hash string, torrent qbittorrent.Torrent,
+	trackingData *qbittorrent.SeedingTrackingData, now time.Time) bool {
+	if trackingData.DownloadCompleteTime.IsZero() {
+		return false
+	}
+
+	if torrent.IsPaused() {
+		reason := ss.seedingLimitReached(torrent, trackingData, now)
+		if reason == "" || ss.paused {
+			return false
+		}
+
+		trackingData.AutoStopped = true
+		trackingData.StopReason = reason
+		trackingData.UpdatedAt = now
+
+		seedingDuration := now.Sub(trackingData.DownloadCompleteTime)
+		ss.logger.WithFields(map[string]interface{}{
+			"hash":             hash,
+			"name":             trackingData.Name,
+			"seeding_duration": seedingDuration,
+			"ratio":            torrent.Ratio,
+			"stop_reason":      reason,
+		}).Infof("qBittorrent stopped seeding due to %s limit", reason)
+
+		logging.LogSeedingStopped(trackingData.Name, hash, seedingDuration.String())
+		ss.publishSeedingStopped(hash, trackingData.Name, reason)
+		return true
+	}
+
+	ratio, minutes := float64(qbittorrent.ShareLimitNone), int64(qbittorrent.ShareLimitNone)
+	if !ss.paused {
+		ratio, minutes = nativeShareLimits(ss.GetRatioLimit(torrent), trackingData.SeedingStopTime.Sub(trackingData.DownloadCompleteTime))
+	}
+	if torrent.RatioLimit == ratio && torrent.SeedingTimeLimit == minutes {
+		return false
+	}
+
+	if err := ss.client.SetShareLimits(ctx, []string{hash}, ratio, minutes); err != nil {
+		ss.logger.WithError(err).WithField("hash", hash).Error("Failed to sync share limits")
+	}
+	return false
+}
+
 // MarkTorrentCompleted marks a torrent as completed and calculates seeding duration
 func (ss *SeedingService) MarkTorrentCompleted(ctx context.Context, hash string, downloadDuration time.Duration) error {
+	// Look up the category before locking; without it the global limits apply
+	multiplier := ss.config.Seeding.TimeMultiplier
+	ratioLimit := ss.config.Seeding.RatioLimit
+	if torrent, err := ss.torrentService.FindTorrentByHash(ctx, hash); err == nil {
+		multiplier = ss.GetTimeMultiplier(*torrent)
+		ratioLimit = ss.GetRatioLimit(*torrent)
+	} else {
+		ss.logger.WithError(err).WithField("hash", hash).Debug("Using global seeding time multiplier")
+	}
+
 	ss.dataMutex.Lock()
 	defer ss.dataMutex.Unlock()
 
@@ -192,7 +459,7 @@ func (ss *SeedingService) MarkTorrentCompleted(ctx context.Context, hash string,
 	trackingData.DownloadDuration = downloadDuration
 
 	// Calculate seeding stop time
-	seedingDuration := time.Duration(float64(downloadDuration) * ss.config.Seeding.TimeMultiplier)
+	seedingDuration := time.Duration(float64(downloadDuration) * multiplier)
 	trackingData.SeedingStopTime = now.Add(seedingDuration)
 	trackingData.UpdatedAt = now
 
@@ -200,10 +467,19 @@ func (ss *SeedingService) MarkTorrentCompleted(ctx context.Context, hash string,
 		"hash":              hash,
 		"name":              trackingData.Name,
 		"download_duration": downloadDuration,
+		"time_multiplier":   multiplier,
 		"seeding_duration":  seedingDuration,
 		"seeding_stop_time": trackingData.SeedingStopTime,
 	}).Info("Torrent marked as completed, seeding time limit calculated")
 
+	// In native mode qBittorrent enforces the limits; the next check retries on failure
+	if ss.nativeMode() && !ss.paused {
+		ratio, minutes := nativeShareLimits(ratioLimit, seedingDuration)
+		if err := ss.client.SetShareLimits(ctx, []string{hash}, ratio, minutes); err != nil {
+			ss.logger.WithError(err).WithField("hash", hash).Warn("Failed to set share limits, will retry on the next check")
+		}
+	}
+
 	// Save tracking data (call without holding lock to avoid deadlock)
 	go func() {
 		if err := ss.SaveTrackingData(); err != nil {
@@ -214,6 +490,57 @@ func (ss *SeedingService) MarkTorrentCompleted(ctx context.Context, hash string,
 	return nil
 }
 
+// StopAllSeeding pauses every tracked torrent that is still seeding and marks it
+// as stopped for reason (see qbittorrent.SeedingStopReason*), returning how many
+// torrents were stopped
+func (ss *SeedingService) StopAllSeeding(ctx context.Context, reason string) (int, error) {
+	torrents, err := ss.torrentService.GetTorrents(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get torrents: %w", err)
+	}
+
+	torrentMap := make(map[string]qbittorrent.Torrent)
+	for _, torrent := range torrents {
+		torrentMap[torrent.Hash] = torrent
+	}
+
+	ss.dataMutex.Lock()
+	defer ss.dataMutex.Unlock()
+
+	var hashes []string
+	for hash, trackingData := range ss.trackingData {
+		if torrent, exists := torrentMap[hash]; exists && !trackingData.AutoStopped && torrent.IsSeeding() {
+			hashes = append(hashes, hash)
+		}
+	}
+	if len(hashes) == 0 {
+		return 0, nil
+	}
+
+	ss.logger.WithField("count", len(hashes)).Info("Stopping seeding for all tracked torrents")
+
+	if err := ss.torrentService.StopTorrents(ctx, hashes); err != nil {
+		return 0, fmt.Errorf("failed to stop torrents: %w", err)
+	}
+
+	now := time.Now()
+	for _, hash := range hashes {
+		trackingData := ss.trackingData[hash]
+		trackingData.AutoStopped = true
+		trackingData.StopReason = reason
+		trackingData.UpdatedAt = now
+		ss.publishSeedingStopped(hash, trackingData.Name, reason)
+	}
+
+	// Save tracking data (lock is already held)
+	if err := ss.saveTrackingDataLocked(); err != nil {
+		return len(hashes), fmt.Errorf("failed to save tracking data: %w", err)
+	}
+
+	ss.logger.WithField("count", len(hashes)).Info("Stopped seeding for all tracked torrents")
+	return len(hashes), nil
+}
+
 // StopTracking stops tracking a torrent (manual removal)
 func (ss *SeedingService) StopTracking(hash string) error {
 	ss.dataMutex.Lock()
@@ -261,9 +588,19 @@ func (ss *SeedingService) CheckSeedingLimits(ctx context.Context) error {
 	ss.dataMutex.Lock()
 	defer ss.dataMutex.Unlock()
 
+	// Pick up pauses and resumes made by other Akira processes
+	ss.loadPauseStateLocked()
+
 	now := time.Now()
 	stoppedCount := 0
 	checkedCount := 0
+	updatedCount := 0
+
+	// Public torrents have no seeding obligation, so they can be removed outright
+	deletedCount := 0
+	if ss.config.Seeding.AutoDeletePublicAfterComplete && !ss.paused {
+		deletedCount = ss.autoDeletePublicTorrentsLocked(ctx, torrentMap, now)
+	}
 
 	for hash, trackingData := range ss.trackingData {
 		checkedCount++
@@ -280,32 +617,46 @@ func (ss *SeedingService) CheckSeedingLimits(ctx context.Context) error {
 			continue
 		}
 
-		// Check if download is complete and update tracking data
-		if trackingData.DownloadCompleteTime.IsZero() && torrent.IsCompleted() {
-			// Download just completed
-			trackingData.DownloadCompleteTime = now
-			trackingData.DownloadDuration = trackingData.DownloadCompleteTime.Sub(trackingData.DownloadStartTime)
-
+		// Fill in missing times from qBittorrent and check if the download is complete
+		started, completed := backfillDownloadTimes(torrent, trackingData, now)
+		if started || completed {
+			trackingData.UpdatedAt = now
+			updatedCount++
+		}
+		if completed {
 			// Calculate seeding stop time
-			seedingDuration := time.Duration(float64(trackingData.DownloadDuration) * ss.config.Seeding.TimeMultiplier)
+			multiplier := ss.GetTimeMultiplier(torrent)
+			seedingDuration := time.Duration(float64(trackingData.DownloadDuration) * multiplier)
 			trackingData.SeedingStopTime = trackingData.DownloadCompleteTime.Add(seedingDuration)
-			trackingData.UpdatedAt = now
 
 			ss.logger.WithFields(map[string]interface{}{
 				"hash":              hash,
 				"name":              trackingData.Name,
 				"download_duration": trackingData.DownloadDuration,
+				"time_multiplier":   multiplier,
 				"seeding_duration":  seedingDuration,
 				"seeding_stop_time": trackingData.SeedingStopTime,
 			}).Info("Torrent download completed, seeding time limit calculated")
 
 			// Log the completion
 			logging.LogTorrentCompleted(trackingData.Name, hash, trackingData.DownloadDuration.String())
+			ss.notifyCompletedLocked(newTorrentCompletion(torrent, trackingData))
+		}
+
+		// In native mode qBittorrent stops the torrent, so only its limits are kept in sync
+		if ss.nativeMode() {
+			if ss.syncShareLimitsLocked(ctx, hash, torrent, trackingData, now) {
+				stoppedCount++
+			}
+			continue
 		}
 
 		// Check if seeding should be stopped
-		if !trackingData.DownloadCompleteTime.IsZero() && now.After(trackingData.SeedingStopTime) {
-			// Time to stop seeding
+		if ss.paused {
+			continue
+		}
+		if reason := ss.seedingLimitReached(torrent, trackingData, now); reason != "" {
+			// Time or ratio limit reached, stop seeding
 			if torrent.IsSeeding() {
 				err := ss.torrentService.StopTorrents(ctx, []string{hash})
 				if err != nil {
@@ -314,6 +665,7 @@ func (ss *SeedingService) CheckSeedingLimits(ctx context.Context) error {
 				}
 
 				trackingData.AutoStopped = true
+				trackingData.StopReason = reason
 				trackingData.UpdatedAt = now
 				stoppedCount++
 
@@ -322,22 +674,28 @@ func (ss *SeedingService) CheckSeedingLimits(ctx context.Context) error {
 					"hash":             hash,
 					"name":             trackingData.Name,
 					"seeding_duration": seedingDuration,
-				}).Info("Automatically stopped seeding due to time limit")
+					"ratio":            torrent.Ratio,
+					"stop_reason":      reason,
+				}).Infof("Automatically stopped seeding due to %s limit", reason)
 
 				// Log the seeding stop
 				logging.LogSeedingStopped(trackingData.Name, hash, seedingDuration.String())
+				ss.publishSeedingStopped(hash, trackingData.Name, reason)
 			}
 		}
 	}
 
 	ss.logger.WithFields(map[string]interface{}{
 		"checked_count": checkedCount,
+		"updated_count": updatedCount,
 		"stopped_count": stoppedCount,
+		"deleted_count": deletedCount,
+		"paused":        ss.paused,
 	}).Debug("Seeding limit check completed")
 
-	// Save tracking data if any changes were made
-	if stoppedCount > 0 {
-		if err := ss.SaveTrackingData(); err != nil {
+	// Save tracking data if any changes were made (lock is already held)
+	if updatedCount > 0 || stoppedCount > 0 || deletedCount > 0 {
+		if err := ss.saveTrackingDataLocked(); err != nil {
 			ss.logger.WithError(err).Error("Failed to save tracking data after seeding limit check")
 		}
 	}
@@ -345,6 +703,258 @@ func (ss *SeedingService) CheckSeedingLimits(ctx context.Context) error {
 	return nil
 }
 
+// ReconcileTracking brings the tracking data in line with qBittorrent's torrent
+// list. Tracking is dropped for torrents missing from two listings in a row
+// and, when auto-track-all is enabled, started for torrents added outside
+// Akira, using qBittorrent's timestamps for their download times. It returns how many
+// torrents started and stopped being tracked.
+func (ss *SeedingService) ReconcileTracking(ctx context.Context) (added, removed int, err error) {
+	fetchedAt := time.Now()
+	torrents, err := ss.torrentService.GetTorrents(ctx, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get torrents: %w", err)
+	}
+
+	torrentMap := make(map[string]qbittorrent.Torrent)
+	for _, torrent := range torrents {
+		torrentMap[torrent.Hash] = torrent
+	}
+
+	ss.dataMutex.Lock()
+
+	// An empty list while torrents are tracked is more likely a qBittorrent
+	// restart than every torrent being removed, so keep the tracking data then.
+	// A torrent also has to be missing for several reconciles in a row, since
+	// one listing can briefly miss a torrent that is being added.
+	if len(torrentMap) > 0 {
+		for hash, trackingData := range ss.trackingData {
+			if _, exists := torrentMap[hash]; exists {
+				delete(ss.missingTicks, hash)
+				continue
+			}
+			// Tracking started after the list was fetched, so the list can't show it yet
+			if trackingData.CreatedAt.After(fetchedAt) {
+				continue
+			}
+
+			ss.missingTicks[hash]++
+			if ss.missingTicks[hash] < reconcileMissingTicks {
+				continue
+			}
+
+			delete(ss.trackingData, hash)
+			delete(ss.missingTicks, hash)
+			removed++
+			ss.logger.WithFields(map[string]interface{}{
+				"hash": hash,
+				"name": trackingData.Name,
+			}).Info("Stopped tracking torrent that no longer exists in qBittorrent")
+		}
+	}
+	// Forget the counts of torrents whose tracking was stopped in the meantime
+	for hash := range ss.missingTicks {
+		if _, tracked := ss.trackingData[hash]; !tracked {
+			delete(ss.missingTicks, hash)
+		}
+	}
+
+	var addedHashes []string
+	if ss.config.Seeding.AutoTrackAll {
+		now := time.Now()
+		for hash, torrent := range torrentMap {
+			if _, exists := ss.trackingData[hash]; exists {
+				continue
+			}
+
+			trackingData := &qbittorrent.SeedingTrackingData{
+				Hash:      hash,
+				Name:      torrent.Name,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}
+			if _, completed := backfillDownloadTimes(torrent, trackingData, now); completed {
+				seedingDuration := time.Duration(float64(trackingData.DownloadDuration) * ss.GetTimeMultiplier(torrent))
+				trackingData.SeedingStopTime = trackingData.DownloadCompleteTime.Add(seedingDuration)
+			}
+			ss.trackingData[hash] = trackingData
+			addedHashes = append(addedHashes, hash)
+			added++
+
+			ss.logger.WithFields(map[string]interface{}{
+				"hash":              hash,
+				"name":              torrent.Name,
+				"seeding_stop_time": trackingData.SeedingStopTime,
+			}).Info("Started tracking torrent added outside Akira")
+		}
+	}
+
+	if added > 0 || removed > 0 {
+		if err := ss.saveTrackingDataLocked(); err != nil {
+			ss.logger.WithError(err).Error("Failed to save tracking data after reconciling")
+		}
+	}
+	ss.dataMutex.Unlock()
+
+	ss.tagTracked(ctx, addedHashes)
+	return added, removed, nil
+}
+
+// autoDeletePublicTorrentsLocked deletes completed public-tracker torrents once the configured
+// delay has passed and returns how many were deleted. Deleted torrents are removed from
+// torrentMap and the tracking data. The caller must hold dataMutex.
+func (ss *SeedingService) autoDeletePublicTorrentsLocked(ctx context.Context, torrentMap map[string]qbittorrent.Torrent, now time.Time) int {
+	keepFiles := ss.config.Seeding.AutoDeleteKeepFiles
+	deletedCount := 0
+
+	for hash, torrent := range torrentMap {
+		if !torrent.IsCompleted() || !IsPublicTorrent(torrent, ss.config.PrivateTrackers) {
+			continue
+		}
+
+		completedAt := time.Unix(torrent.CompletionOn, 0)
+		if torrent.CompletionOn <= 0 {
+			trackingData, tracked := ss.trackingData[hash]
+			if !tracked || trackingData.DownloadCompleteTime.IsZero() {
+				continue // Completion time unknown, so the delay can't be honoured
+			}
+			completedAt = trackingData.DownloadCompleteTime
+		}
+		if now.Sub(completedAt) < ss.config.Seeding.AutoDeletePublicDelay {
+			continue
+		}
+
+		if err := ss.torrentService.DeleteTorrents(ctx, []string{hash}, !keepFiles); err != nil {
+			ss.logger.WithError(err).WithField("hash", hash).Error("Failed to auto-delete public torrent")
+			continue
+		}
+
+		delete(torrentMap, hash)
+		delete(ss.trackingData, hash)
+		deletedCount++
+
+		ss.logger.WithFields(map[string]interface{}{
+			"hash":         hash,
+			"name":         torrent.Name,
+			"trackers":     TrackerHosts(torrent),
+			"completed_at": completedAt,
+			"keep_files":   keepFiles,
+		}).Info("Automatically deleted completed public torrent")
+
+		logging.LogTorrentDeleted(torrent.Name, hash, !keepFiles)
+		if ss.events != nil {
+			ss.events.Publish(EventTorrentAutoDeleted, map[string]interface{}{
+				"hash":         hash,
+				"name":         torrent.Name,
+				"delete_files": !keepFiles,
+			})
+		}
+	}
+
+	return deletedCount
+}
+
+// VerifyTracking cross-references tracking data with the live torrent list and reports
+// drift. When repair is true, stale records are removed and timestamps reconciled.
+func (ss *SeedingService) VerifyTracking(ctx context.Context, repair bool) (*TrackingVerification, error) {
+	ss.logger.WithField("repair", repair).Info("Verifying seeding tracking data")
+
+	torrents, err := ss.torrentService.GetTorrents(ctx, nil)
+	if err != nil {
+		ss.logger.WithError(err).Error("Failed to get torrents for tracking verification")
+		return nil, fmt.Errorf("failed to get torrents: %w", err)
+	}
+
+	torrentMap := make(map[string]qbittorrent.Torrent)
+	for _, torrent := range torrents {
+		torrentMap[torrent.Hash] = torrent
+	}
+
+	ss.dataMutex.Lock()
+	defer ss.dataMutex.Unlock()
+
+	result := &TrackingVerification{
+		Checked: len(ss.trackingData),
+		Issues:  []TrackingIssue{},
+	}
+	now := time.Now()
+
+	for hash, trackingData := range ss.trackingData {
+		torrent, exists := torrentMap[hash]
+		if !exists {
+			issue := TrackingIssue{
+				Hash:        hash,
+				Name:        trackingData.Name,
+				Type:        TrackingIssueMissingTorrent,
+				Description: "torrent no longer exists in qBittorrent",
+			}
+			if repair {
+				delete(ss.trackingData, hash)
+				issue.Repaired = true
+			}
+			result.Issues = append(result.Issues, issue)
+			continue
+		}
+
+		if torrent.IsCompleted() && trackingData.DownloadCompleteTime.IsZero() {
+			issue := TrackingIssue{
+				Hash:        hash,
+				Name:        trackingData.Name,
+				Type:        TrackingIssueMissingCompletion,
+				Description: "torrent is complete but has no completion time",
+			}
+			if repair {
+				// Prefer qBittorrent's own timestamps over the time of the repair
+				backfillDownloadTimes(torrent, trackingData, now)
+				seedingDuration := time.Duration(float64(trackingData.DownloadDuration) * ss.GetTimeMultiplier(torrent))
+				trackingData.SeedingStopTime = trackingData.DownloadCompleteTime.Add(seedingDuration)
+				trackingData.UpdatedAt = now
+				issue.Repaired = true
+			}
+			result.Issues = append(result.Issues, issue)
+		}
+
+		if torrent.IsCompleted() && torrent.IsPaused() && !trackingData.AutoStopped {
+			issue := TrackingIssue{
+				Hash:        hash,
+				Name:        trackingData.Name,
+				Type:        TrackingIssuePausedNotStopped,
+				Description: "torrent is paused in qBittorrent but not marked as stopped",
+			}
+			if repair {
+				trackingData.AutoStopped = true
+				trackingData.StopReason = qbittorrent.SeedingStopReasonManual
+				if trackingData.SeedingStopTime.IsZero() || trackingData.SeedingStopTime.After(now) {
+					trackingData.SeedingStopTime = now
+				}
+				trackingData.UpdatedAt = now
+				issue.Repaired = true
+			}
+			result.Issues = append(result.Issues, issue)
+		}
+	}
+
+	for _, issue := range result.Issues {
+		if issue.Repaired {
+			result.Repaired++
+		}
+	}
+
+	if result.Repaired > 0 {
+		if err := ss.saveTrackingDataLocked(); err != nil {
+			ss.logger.WithError(err).Error("Failed to save tracking data after repair")
+			return result, fmt.Errorf("failed to save repaired tracking data: %w", err)
+		}
+	}
+
+	ss.logger.WithFields(map[string]interface{}{
+		"checked":  result.Checked,
+		"issues":   len(result.Issues),
+		"repaired": result.Repaired,
+	}).Info("Seeding tracking verification completed")
+
+	return result, nil
+}
+
 // GetSeedingStatus returns the current status of all tracked torrents
 func (ss *SeedingService) GetSeedingStatus(ctx context.Context) (*SeedingStatus, error) {
 	ss.logger.Debug("Generating seeding status report")
@@ -361,12 +971,17 @@ func (ss *SeedingService) GetSeedingStatus(ctx context.Context) (*SeedingStatus,
 		torrentMap[torrent.Hash] = torrent
 	}
 
-	ss.dataMutex.RLock()
-	defer ss.dataMutex.RUnlock()
+	ss.dataMutex.Lock()
+	defer ss.dataMutex.Unlock()
+
+	ss.loadPauseStateLocked()
 
 	status := &SeedingStatus{
 		Details:     make(map[string]*SeedingTorrentStatus),
 		LastChecked: time.Now(),
+		IsRunning:   ss.IsRunning(),
+		Paused:      ss.paused,
+		PausedAt:    ss.pausedAt,
 	}
 
 	now := time.Now()
@@ -384,6 +999,9 @@ func (ss *SeedingService) GetSeedingStatus(ctx context.Context) (*SeedingStatus,
 			AutoStopped:      trackingData.AutoStopped,
 			CurrentState:     torrent.GetStateDisplayName(),
 			SeedingStopTime:  trackingData.SeedingStopTime,
+			Ratio:            torrent.Ratio,
+			RatioLimit:       ss.GetRatioLimit(torrent),
+			StopReason:       trackingData.StopReason,
 		}
 
 		// Calculate seeding duration
@@ -395,7 +1013,7 @@ func (ss *SeedingService) GetSeedingStatus(ctx context.Context) (*SeedingStatus,
 			}
 
 			// Calculate seeding limit and time remaining
-			seedingLimit := time.Duration(float64(trackingData.DownloadDuration) * ss.config.Seeding.TimeMultiplier)
+			seedingLimit := time.Duration(float64(trackingData.DownloadDuration) * ss.GetTimeMultiplier(torrent))
 			torrentStatus.SeedingLimit = seedingLimit
 
 			timeRemaining := trackingData.SeedingStopTime.Sub(now)
@@ -404,6 +1022,11 @@ func (ss *SeedingService) GetSeedingStatus(ctx context.Context) (*SeedingStatus,
 				torrentStatus.IsOverdue = true
 			}
 			torrentStatus.TimeRemaining = timeRemaining
+
+			// A reached ratio limit is overdue too until the next check stops the torrent
+			if !trackingData.AutoStopped && torrentStatus.RatioLimit > 0 && torrent.Ratio >= torrentStatus.RatioLimit {
+				torrentStatus.IsOverdue = true
+			}
 		}
 
 		status.Details[hash] = torrentStatus
@@ -438,6 +1061,11 @@ func (ss *SeedingService) SaveTrackingData() error {
 	ss.dataMutex.RLock()
 	defer ss.dataMutex.RUnlock()
 
+	return ss.saveTrackingDataLocked()
+}
+
+// saveTrackingDataLocked writes tracking data to disk; the caller must hold dataMutex
+func (ss *SeedingService) saveTrackingDataLocked() error {
 	data, err := json.MarshalIndent(ss.trackingData, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal tracking data: %w", err)
@@ -483,6 +1111,81 @@ func (ss *SeedingService) LoadTrackingData() error {
 	return nil
 }
 
+// Pause stops seeding limits from being enforced until Resume is called. Tracking
+// data is still kept current. The pause is persisted so that other Akira processes,
+// such as the daemon, honour it as well.
+func (ss *SeedingService) Pause() error {
+	ss.dataMutex.Lock()
+	defer ss.dataMutex.Unlock()
+
+	ss.loadPauseStateLocked()
+	if ss.paused {
+		return nil
+	}
+
+	state := seedingPauseState{PausedAt: time.Now()}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal pause state: %w", err)
+	}
+	if err := os.WriteFile(ss.config.Seeding.PauseStateFile, data, 0644); err != nil {
+		return fmt.Errorf("failed to write pause state file: %w", err)
+	}
+
+	ss.paused = true
+	ss.pausedAt = state.PausedAt
+	ss.logger.Info("Seeding limit enforcement paused")
+
+	return nil
+}
+
+// Resume re-enables enforcement of seeding limits after Pause
+func (ss *SeedingService) Resume() error {
+	ss.dataMutex.Lock()
+	defer ss.dataMutex.Unlock()
+
+	if err := os.Remove(ss.config.Seeding.PauseStateFile); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove pause state file: %w", err)
+	}
+
+	if ss.paused {
+		ss.logger.WithField("paused_for", time.Since(ss.pausedAt).Round(time.Second)).Info("Seeding limit enforcement resumed")
+	}
+	ss.paused = false
+	ss.pausedAt = time.Time{}
+
+	return nil
+}
+
+// PauseState returns whether seeding limit enforcement is paused and since when
+func (ss *SeedingService) PauseState() (bool, time.Time) {
+	ss.dataMutex.Lock()
+	defer ss.dataMutex.Unlock()
+
+	ss.loadPauseStateLocked()
+	return ss.paused, ss.pausedAt
+}
+
+// loadPauseStateLocked refreshes the pause state from disk; the caller must hold dataMutex
+func (ss *SeedingService) loadPauseStateLocked() {
+	data, err := os.ReadFile(ss.config.Seeding.PauseStateFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			ss.logger.WithError(err).Warn("Failed to read pause state file")
+		}
+		ss.paused = false
+		ss.pausedAt = time.Time{}
+		return
+	}
+
+	var state seedingPauseState
+	if err := json.Unmarshal(data, &state); err != nil {
+		ss.logger.WithError(err).Warn("Failed to parse pause state file, treating service as paused")
+	}
+	ss.paused = true
+	ss.pausedAt = state.PausedAt
+}
+
 // IsRunning returns whether the seeding service is currently running
 func (ss *SeedingService) IsRunning() bool {
 	ss.runningMutex.RLock()
@@ -505,6 +1208,9 @@ func (ss *SeedingService) backgroundProcessor(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ss.ticker.C:
+			if _, _, err := ss.ReconcileTracking(ctx); err != nil {
+				ss.logger.WithError(err).Error("Failed to reconcile seeding tracking")
+			}
 			if err := ss.CheckSeedingLimits(ctx); err != nil {
 				ss.logger.WithError(err).Error("Failed to check seeding limits")
 			}
@@ -558,12 +1264,14 @@ func (ss *SeedingService) ForceStopSeeding(ctx context.Context, hashes []string)
 	for _, hash := range hashes {
 		if trackingData, exists := ss.trackingData[hash]; exists {
 			trackingData.AutoStopped = true
+			trackingData.StopReason = qbittorrent.SeedingStopReasonManual
 			trackingData.UpdatedAt = now
+			ss.publishSeedingStopped(hash, trackingData.Name, qbittorrent.SeedingStopReasonManual)
 		}
 	}
 
-	// Save tracking data
-	if err := ss.SaveTrackingData(); err != nil {
+	// Save tracking data (lock is already held)
+	if err := ss.saveTrackingDataLocked(); err != nil {
 		ss.logger.WithError(err).Error("Failed to save tracking data after force stop")
 	}
 
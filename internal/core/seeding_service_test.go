@@ -0,0 +1,83 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/raainshe/akira/internal/config"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// newReconcileTestService returns a seeding service backed by a fake
+// qBittorrent that lists whatever *torrents holds at the time of the request
+func newReconcileTestService(t *testing.T, torrents *[]qbittorrent.Torrent) *SeedingService {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/torrents/info", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(*torrents)
+	})
+	client := newTestClient(t, mux)
+
+	cfg := &config.Config{}
+	cfg.Seeding.TrackingDataFile = filepath.Join(t.TempDir(), "seeding_tracking.json")
+	return NewSeedingService(cfg, NewTorrentService(client, cfg, nil), client)
+}
+
+func TestReconcileTrackingNeedsTwoMissingTicks(t *testing.T) {
+	const gone = "c12fe1c06bba254a9dc9f519b335aa7c1367a88a"
+	const other = "0123456789abcdef0123456789abcdef01234567"
+
+	torrents := []qbittorrent.Torrent{{Hash: other, Name: "Other"}}
+	ss := newReconcileTestService(t, &torrents)
+	created := time.Now().Add(-time.Hour)
+	ss.trackingData[gone] = &qbittorrent.SeedingTrackingData{Hash: gone, Name: "Gone", CreatedAt: created}
+
+	ctx := context.Background()
+	if _, removed, err := ss.ReconcileTracking(ctx); err != nil || removed != 0 {
+		t.Fatalf("first ReconcileTracking = %d removed, %v; want 0, nil", removed, err)
+	}
+	if _, tracked := ss.trackingData[gone]; !tracked {
+		t.Fatal("tracking dropped after a single missing listing")
+	}
+
+	// Showing up again resets the count
+	torrents = append(torrents, qbittorrent.Torrent{Hash: gone, Name: "Gone"})
+	if _, removed, err := ss.ReconcileTracking(ctx); err != nil || removed != 0 {
+		t.Fatalf("ReconcileTracking while listed = %d removed, %v; want 0, nil", removed, err)
+	}
+	torrents = torrents[:1]
+	if _, removed, err := ss.ReconcileTracking(ctx); err != nil || removed != 0 {
+		t.Fatalf("ReconcileTracking after reappearing = %d removed, %v; want 0, nil", removed, err)
+	}
+
+	if _, removed, err := ss.ReconcileTracking(ctx); err != nil || removed != 1 {
+		t.Fatalf("second missing ReconcileTracking = %d removed, %v; want 1, nil", removed, err)
+	}
+	if _, tracked := ss.trackingData[gone]; tracked {
+		t.Error("tracking kept after two missing listings")
+	}
+}
+
+func TestReconcileTrackingKeepsTrackingStartedAfterListing(t *testing.T) {
+	const added = "c12fe1c06bba254a9dc9f519b335aa7c1367a88a"
+
+	torrents := []qbittorrent.Torrent{{Hash: "0123456789abcdef0123456789abcdef01234567", Name: "Other"}}
+	ss := newReconcileTestService(t, &torrents)
+	// Tracking that starts while the list is being fetched has a later CreatedAt
+	ss.trackingData[added] = &qbittorrent.SeedingTrackingData{Hash: added, Name: "Added", CreatedAt: time.Now().Add(time.Hour)}
+
+	for i := 0; i < reconcileMissingTicks; i++ {
+		if _, _, err := ss.ReconcileTracking(context.Background()); err != nil {
+			t.Fatalf("ReconcileTracking unexpected error: %v", err)
+		}
+	}
+	if _, tracked := ss.trackingData[added]; !tracked {
+		t.Error("tracking started after the listing was dropped")
+	}
+}
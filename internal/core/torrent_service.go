@@ -1,12 +1,21 @@
 package core
 
 import (
+	"cmp"
 	"context"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io/fs"
+	"net/http"
 	"net/url"
+	"os"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/raainshe/akira/internal/cache"
@@ -17,9 +26,16 @@ import (
 
 // AddTorrentRequest represents a request to add a torrent
 type AddTorrentRequest struct {
-	MagnetURI string `json:"magnet_uri"`          // Magnet URI to add
-	Category  string `json:"category,omitempty"`  // Torrent category (series, movies, anime)
-	SavePath  string `json:"save_path,omitempty"` // Custom save path (overrides category path)
+	MagnetURI          string   `json:"magnet_uri"`                    // Magnet URI to add
+	Category           string   `json:"category,omitempty"`            // Torrent category (series, movies, anime)
+	SavePath           string   `json:"save_path,omitempty"`           // Custom save path (overrides category path)
+	Paused             bool     `json:"paused,omitempty"`              // Add the torrent in the paused state
+	SkipChecking       bool     `json:"skip_checking,omitempty"`       // Skip hash checking of existing files
+	SequentialDownload bool     `json:"sequential_download,omitempty"` // Download pieces in order
+	FirstLastPriority  bool     `json:"first_last_priority,omitempty"` // Download the first and last pieces first
+	UploadLimit        int64    `json:"upload_limit,omitempty"`        // Upload speed limit in bytes/s, 0 for unlimited
+	DownloadLimit      int64    `json:"download_limit,omitempty"`      // Download speed limit in bytes/s, 0 for unlimited
+	Tags               []string `json:"tags,omitempty"`                // Tags to apply, created in qBittorrent if needed
 }
 
 // TorrentService provides high-level business logic for torrent operations
@@ -28,19 +44,35 @@ type TorrentService struct {
 	config *config.Config
 	cache  *cache.CacheManager
 	logger *logging.Logger
+
+	syncMutex sync.Mutex
+	snapshot  *qbittorrent.MainDataSnapshot // Torrents kept up to date by SyncTorrents
+
+	background sync.WaitGroup // Skip patterns still waiting for metadata, see WaitBackground
 }
 
 // TorrentFilter represents filtering options for torrent queries
 type TorrentFilter struct {
 	Category    string                     // Filter by category (series, movies, anime, etc.)
+	Tag         string                     // Filter by tag (case-insensitive)
 	State       qbittorrent.TorrentState   // Filter by torrent state
 	States      []qbittorrent.TorrentState // Filter by multiple states
-	NamePattern string                     // Filter by name pattern (regex)
+	NamePattern string                     // Filter by name pattern (case-insensitive regex)
 	OnlyActive  bool                       // Only show active torrents (downloading/uploading)
 	OnlySeeding bool                       // Only show seeding torrents
 	SortBy      TorrentSortField           // Sort field
 	SortDesc    bool                       // Sort in descending order
+	Reverse     bool                       // Reverse the final order, after sorting
 	Limit       int                        // Limit number of results (0 = no limit)
+	MinSize     int64                      // Minimum total size in bytes (0 = no limit)
+	MaxSize     int64                      // Maximum total size in bytes (0 = no limit)
+	MinRatio    float64                    // Minimum share ratio (0 = no limit)
+	MaxRatio    float64                    // Maximum share ratio (0 = no limit)
+
+	AddedBefore     time.Time // Only torrents added before this time
+	AddedAfter      time.Time // Only torrents added at or after this time
+	CompletedBefore time.Time // Only torrents completed before this time
+	CompletedAfter  time.Time // Only torrents completed at or after this time
 }
 
 // TorrentSortField represents fields that can be used for sorting
@@ -58,6 +90,25 @@ const (
 	SortBySeedingTime   TorrentSortField = "seeding_time"
 )
 
+// TorrentSortFields lists all supported sort fields
+var TorrentSortFields = []TorrentSortField{
+	SortByName, SortBySize, SortByProgress, SortByDownloadSpeed, SortByUploadSpeed,
+	SortByAddedDate, SortByCompletedDate, SortByRatio, SortBySeedingTime,
+}
+
+// ParseTorrentSortField converts a sort field name (case-insensitive) to a TorrentSortField
+func ParseTorrentSortField(name string) (TorrentSortField, error) {
+	field := TorrentSortField(strings.ToLower(strings.TrimSpace(name)))
+	if !slices.Contains(TorrentSortFields, field) {
+		names := make([]string, len(TorrentSortFields))
+		for i, f := range TorrentSortFields {
+			names[i] = string(f)
+		}
+		return "", fmt.Errorf("invalid sort field '%s' (must be one of: %s)", name, strings.Join(names, ", "))
+	}
+	return field, nil
+}
+
 // AddTorrentOptions represents options for adding torrents with business logic
 type AddTorrentOptions struct {
 	Category           string        // Category (will be validated and mapped to save path)
@@ -88,13 +139,22 @@ type TorrentStats struct {
 	UploadSpeed   int64 `json:"upload_speed"`
 }
 
+const (
+	// metadataWaitTimeout bounds how long skip patterns wait for a new magnet's metadata
+	metadataWaitTimeout = 30 * time.Second
+
+	// metadataPollInterval is how often the file list is checked while waiting for metadata
+	metadataPollInterval = 2 * time.Second
+)
+
 // NewTorrentService creates a new torrent service instance
 func NewTorrentService(client *qbittorrent.Client, config *config.Config, cache *cache.CacheManager) *TorrentService {
 	return &TorrentService{
-		client: client,
-		config: config,
-		cache:  cache,
-		logger: logging.GetCoreLogger(),
+		client:   client,
+		config:   config,
+		cache:    cache,
+		logger:   logging.GetCoreLogger(),
+		snapshot: qbittorrent.NewMainDataSnapshot(),
 	}
 }
 
@@ -111,7 +171,10 @@ func (ts *TorrentService) GetTorrents(ctx context.Context, filter *TorrentFilter
 
 	// Apply filtering if provided
 	if filter != nil {
-		torrents = ts.applyFilter(torrents, filter)
+		torrents, err = ts.applyFilter(torrents, filter)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	ts.logger.WithFields(map[string]interface{}{
@@ -122,11 +185,44 @@ func (ts *TorrentService) GetTorrents(ctx context.Context, filter *TorrentFilter
 	return torrents, nil
 }
 
+// SyncTorrents brings the synced snapshot up to date and returns all torrents and
+// the server state. Only the changes since the previous call are transferred, which
+// makes it much cheaper than GetTorrents for frequent refreshes. After a failure the
+// next call starts over with a full update.
+func (ts *TorrentService) SyncTorrents(ctx context.Context) ([]qbittorrent.Torrent, *qbittorrent.ServerState, error) {
+	ts.syncMutex.Lock()
+	defer ts.syncMutex.Unlock()
+
+	update, err := ts.client.SyncMainData(ctx, ts.snapshot.Rid())
+	if err != nil {
+		ts.snapshot.Reset()
+		return nil, nil, fmt.Errorf("failed to sync torrents: %w", err)
+	}
+
+	if err := ts.snapshot.Apply(update); err != nil {
+		ts.logger.WithError(err).Warn("Failed to apply torrent sync update, resyncing")
+		ts.snapshot.Reset()
+		return nil, nil, fmt.Errorf("failed to sync torrents: %w", err)
+	}
+
+	torrents := ts.snapshot.Torrents()
+	serverState := ts.snapshot.ServerState()
+
+	ts.logger.WithFields(map[string]interface{}{
+		"total_count": len(torrents),
+		"full_update": update.FullUpdate,
+		"changed":     len(update.Torrents),
+		"removed":     len(update.TorrentsRemoved),
+	}).Debug("Torrents synced")
+
+	return torrents, &serverState, nil
+}
+
 // GetTorrentsByCategory retrieves torrents filtered by category
 func (ts *TorrentService) GetTorrentsByCategory(ctx context.Context, category string) ([]qbittorrent.Torrent, error) {
 	// Validate category
 	if !ts.isValidCategory(category) {
-		return nil, fmt.Errorf("invalid category: %s", category)
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCategory, category)
 	}
 
 	filter := &TorrentFilter{
@@ -171,6 +267,49 @@ func (ts *TorrentService) GetActiveTorrents(ctx context.Context) ([]qbittorrent.
 	return ts.GetTorrents(ctx, filter)
 }
 
+// GetErroredTorrents retrieves torrents that are errored or missing their files
+func (ts *TorrentService) GetErroredTorrents(ctx context.Context) ([]qbittorrent.Torrent, error) {
+	filter := &TorrentFilter{
+		States:   []qbittorrent.TorrentState{qbittorrent.StateError, qbittorrent.StateMissingFiles},
+		SortBy:   SortByName,
+		SortDesc: false,
+	}
+
+	return ts.GetTorrents(ctx, filter)
+}
+
+// GetStalledTorrents retrieves downloads that have been stalled for at least threshold,
+// longest stalled first. A threshold of 0 uses the configured stalled threshold.
+func (ts *TorrentService) GetStalledTorrents(ctx context.Context, threshold time.Duration) ([]qbittorrent.Torrent, error) {
+	if threshold <= 0 {
+		threshold = ts.config.QBittorrent.StalledThreshold
+	}
+
+	torrents, err := ts.GetTorrentsByState(ctx, qbittorrent.StateStalledDL)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var stalled []qbittorrent.Torrent
+	for _, torrent := range torrents {
+		if torrent.IsStalledFor(threshold, now) {
+			stalled = append(stalled, torrent)
+		}
+	}
+
+	sort.SliceStable(stalled, func(i, j int) bool {
+		return stalled[i].StalledDuration(now) > stalled[j].StalledDuration(now)
+	})
+
+	return stalled, nil
+}
+
+// StalledThreshold returns the configured stalled threshold
+func (ts *TorrentService) StalledThreshold() time.Duration {
+	return ts.config.QBittorrent.StalledThreshold
+}
+
 // SearchTorrents searches torrents by name pattern
 func (ts *TorrentService) SearchTorrents(ctx context.Context, pattern string) ([]qbittorrent.Torrent, error) {
 	if pattern == "" {
@@ -200,28 +339,73 @@ func (ts *TorrentService) AddMagnet(ctx context.Context, request *AddTorrentRequ
 	// Validate magnet URI
 	if err := ts.validateMagnetURI(request.MagnetURI); err != nil {
 		ts.logger.WithError(err).Error("Invalid magnet URI")
-		return nil, fmt.Errorf("invalid magnet URI: %w", err)
+		return nil, err
+	}
+
+	// Re-adding an existing torrent would do nothing but could change its category
+	if hash, err := ts.extractHashFromMagnet(request.MagnetURI); err == nil {
+		existing, err := ts.FindTorrentByHash(ctx, hash)
+		if err != nil && !errors.Is(err, ErrTorrentNotFound) {
+			ts.logger.WithError(err).Error("Failed to check for an existing torrent")
+			return nil, fmt.Errorf("failed to check for an existing torrent: %w", err)
+		}
+		if err == nil {
+			ts.logger.WithFields(map[string]interface{}{
+				"hash":  existing.Hash,
+				"name":  existing.Name,
+				"state": existing.State,
+			}).Info("Torrent already exists, not adding it again")
+			return nil, &TorrentExistsError{
+				Hash:     existing.Hash,
+				Name:     existing.Name,
+				Category: existing.Category,
+				State:    existing.State,
+			}
+		}
 	}
 
-	// Validate and normalize category
+	// Validate category. Without one, or with the "default" pseudo-category,
+	// the torrent stays uncategorized in qBittorrent and is saved to the
+	// default path, so no "default" category is ever created. Categories are
+	// stored lowercase, so "Movies" doesn't create a duplicate of "movies".
+	request.Category = strings.ToLower(strings.TrimSpace(request.Category))
+	if request.Category == "default" {
+		request.Category = ""
+	}
 	if request.Category != "" {
-		if !ts.isValidCategory(request.Category) {
-			return nil, fmt.Errorf("invalid category: %s (valid: %v)", request.Category, ts.config.GetValidCategories())
+		if !ts.isValidCategory(request.Category) && !ts.config.QBittorrent.AutoCreateCategories {
+			return nil, fmt.Errorf("%w: %s (valid: %v)", ErrInvalidCategory, request.Category, ts.config.GetValidCategories())
 		}
-	} else {
-		request.Category = "default"
 	}
 
-	// Determine save path
+	// Determine save path. Custom paths are checked by the caller, which may
+	// deliberately skip the check; category paths are checked here.
 	savePath := request.SavePath
 	if savePath == "" {
 		savePath = ts.config.GetSavePathForCategory(request.Category)
+		if err := ts.ensureSavePath(savePath); err != nil {
+			return nil, err
+		}
+	}
+
+	// Create the category the user named in qBittorrent if it doesn't exist yet
+	if request.Category != "" && ts.config.QBittorrent.AutoCreateCategories {
+		if err := ts.ensureCategoryExists(ctx, request.Category); err != nil {
+			return nil, err
+		}
 	}
 
 	// Convert to qBittorrent request format
 	qbitOptions := qbittorrent.AddTorrentRequest{
-		Category: request.Category,
-		SavePath: savePath,
+		Category:               request.Category,
+		SavePath:               savePath,
+		Paused:                 request.Paused,
+		SkipChecking:           request.SkipChecking,
+		SequentialDownload:     request.SequentialDownload,
+		FirstLastPiecePriority: request.FirstLastPriority,
+		UpLimit:                request.UploadLimit,
+		DlLimit:                request.DownloadLimit,
+		Tags:                   strings.Join(request.Tags, ","),
 	}
 
 	// Add the magnet link
@@ -265,9 +449,322 @@ func (ts *TorrentService) AddMagnet(ctx context.Context, request *AddTorrentRequ
 		"hash":      torrent.Hash,
 	}).Info("Magnet link added successfully")
 
+	// Don't download unwanted files such as samples. Waiting for the metadata can
+	// take a while, so do it in the background; it must outlive the caller's context.
+	if len(ts.config.QBittorrent.SkipPatterns) > 0 {
+		ts.background.Add(1)
+		go func() {
+			defer ts.background.Done()
+			skipCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), metadataWaitTimeout+time.Minute)
+			defer cancel()
+			ts.skipUnwantedFiles(skipCtx, torrent.Hash, request.Paused)
+		}()
+	}
+
 	return torrent, nil
 }
 
+// WaitBackground waits until skip patterns of added torrents have been applied
+// or ctx is done. Short-lived commands call it before exiting, since the work is
+// lost when the process ends.
+func (ts *TorrentService) WaitBackground(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		ts.background.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// skipUnwantedFiles applies the configured skip patterns to a newly added torrent once its
+// metadata is available. Failures are logged only, since the torrent itself was added.
+func (ts *TorrentService) skipUnwantedFiles(ctx context.Context, hash string, paused bool) {
+	logger := ts.logger.WithField("hash", hash)
+
+	// Paused magnets don't fetch metadata, so there are no files to look at yet
+	if paused {
+		logger.Info("Torrent added paused; run 'akira files <hash> --skip-pattern' once its metadata is available")
+		return
+	}
+
+	deadline := time.Now().Add(metadataWaitTimeout)
+	for {
+		files, err := ts.client.GetTorrentFiles(ctx, hash)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to get torrent files for skip patterns")
+			return
+		}
+		if len(files) > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			logger.Warn("Torrent metadata not available in time; skip patterns were not applied")
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(metadataPollInterval):
+		}
+	}
+
+	if _, err := ts.SkipMatchingFiles(ctx, hash, ts.config.QBittorrent.SkipPatterns); err != nil {
+		logger.WithError(err).Warn("Failed to apply skip patterns")
+	}
+}
+
+// GetTorrentFiles returns the files of a torrent. The list is empty until its metadata is available.
+func (ts *TorrentService) GetTorrentFiles(ctx context.Context, hash string) ([]qbittorrent.TorrentFile, error) {
+	if hash == "" {
+		return nil, fmt.Errorf("hash cannot be empty")
+	}
+
+	files, err := ts.client.GetTorrentFiles(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get torrent files: %w", err)
+	}
+	return files, nil
+}
+
+// GetTorrentProperties retrieves the detailed properties of a torrent
+func (ts *TorrentService) GetTorrentProperties(ctx context.Context, hash string) (*qbittorrent.TorrentProperties, error) {
+	if hash == "" {
+		return nil, fmt.Errorf("hash cannot be empty")
+	}
+
+	properties, err := ts.client.GetTorrentProperties(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get torrent properties: %w", err)
+	}
+	return properties, nil
+}
+
+// GetTorrentTrackers retrieves the trackers of a torrent
+func (ts *TorrentService) GetTorrentTrackers(ctx context.Context, hash string) ([]qbittorrent.TorrentTracker, error) {
+	if hash == "" {
+		return nil, fmt.Errorf("hash cannot be empty")
+	}
+
+	trackers, err := ts.client.GetTorrentTrackers(ctx, hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get torrent trackers: %w", err)
+	}
+	return trackers, nil
+}
+
+// ExportTorrent returns the .torrent file of a torrent. Torrents added by magnet
+// link can't be exported until their metadata has been downloaded.
+func (ts *TorrentService) ExportTorrent(ctx context.Context, torrent *qbittorrent.Torrent) ([]byte, error) {
+	if torrent.State == qbittorrent.StateMetaDL {
+		return nil, fmt.Errorf("cannot export '%s': qBittorrent is still downloading its metadata", torrent.Name)
+	}
+
+	data, err := ts.client.ExportTorrent(ctx, torrent.Hash)
+	if err != nil {
+		// qBittorrent answers 409 when the metadata isn't available yet
+		var apiErr *qbittorrent.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusConflict {
+			return nil, fmt.Errorf("cannot export '%s': its metadata hasn't been downloaded yet", torrent.Name)
+		}
+		return nil, fmt.Errorf("failed to export torrent: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("qBittorrent returned an empty .torrent file for '%s'", torrent.Name)
+	}
+
+	return data, nil
+}
+
+// SetFilePriority sets the priority of the files with the given indexes and returns
+// the updated files. Indexes are checked against the torrent's files first.
+func (ts *TorrentService) SetFilePriority(ctx context.Context, hash string, indexes []int, priority int) ([]qbittorrent.TorrentFile, error) {
+	if len(indexes) == 0 {
+		return nil, fmt.Errorf("no file indexes provided")
+	}
+
+	files, err := ts.GetTorrentFiles(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("torrent has no files yet (waiting for metadata)")
+	}
+
+	byIndex := make(map[int]qbittorrent.TorrentFile, len(files))
+	for _, file := range files {
+		byIndex[file.Index] = file
+	}
+
+	var updated []qbittorrent.TorrentFile
+	seen := make(map[int]bool)
+	for _, index := range indexes {
+		file, exists := byIndex[index]
+		if !exists {
+			return nil, fmt.Errorf("file index %d is out of range (torrent has %d files, valid indexes: 0-%d)",
+				index, len(files), len(files)-1)
+		}
+		if !seen[index] {
+			seen[index] = true
+			updated = append(updated, file)
+		}
+	}
+
+	ts.logger.WithFields(map[string]interface{}{
+		"hash":     hash,
+		"files":    len(updated),
+		"priority": priority,
+	}).Info("Setting file priority")
+
+	uniqueIndexes := make([]int, len(updated))
+	for i, file := range updated {
+		uniqueIndexes[i] = file.Index
+	}
+
+	if err := ts.client.SetFilePriority(ctx, hash, uniqueIndexes, priority); err != nil {
+		return nil, fmt.Errorf("failed to set file priority: %w", err)
+	}
+
+	for i := range updated {
+		updated[i].Priority = priority
+	}
+	return updated, nil
+}
+
+// SkipMatchingFiles sets files matching any of the patterns to "do not download" and returns them.
+// See FilePatternMatcher for the pattern syntax.
+func (ts *TorrentService) SkipMatchingFiles(ctx context.Context, hash string, patterns []string) ([]qbittorrent.TorrentFile, error) {
+	matcher, err := NewFilePatternMatcher(patterns)
+	if err != nil {
+		return nil, err
+	}
+	if matcher.Empty() {
+		return nil, fmt.Errorf("no skip patterns provided")
+	}
+
+	files, err := ts.GetTorrentFiles(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	var skipped []qbittorrent.TorrentFile
+	var indexes []int
+	for _, file := range files {
+		if file.Priority != qbittorrent.FilePriorityDoNotDownload && matcher.Match(file.Name) {
+			skipped = append(skipped, file)
+			indexes = append(indexes, file.Index)
+		}
+	}
+
+	if len(skipped) == 0 {
+		ts.logger.WithField("hash", hash).Debug("No files matched the skip patterns")
+		return nil, nil
+	}
+
+	// Never skip every file; that leaves a torrent with nothing to download
+	if len(skipped) == len(files) {
+		return nil, fmt.Errorf("skip patterns match all %d files of the torrent, not skipping any", len(files))
+	}
+
+	if err := ts.client.SetFilePriority(ctx, hash, indexes, qbittorrent.FilePriorityDoNotDownload); err != nil {
+		return nil, fmt.Errorf("failed to skip files: %w", err)
+	}
+
+	for _, file := range skipped {
+		ts.logger.WithFields(map[string]interface{}{
+			"hash": hash,
+			"file": file.Name,
+			"size": file.Size,
+		}).Info("Skipped unwanted file")
+	}
+
+	return skipped, nil
+}
+
+// Categories returns the categories users can choose from when adding or filtering torrents
+func (ts *TorrentService) Categories() []config.CategoryOption {
+	return ts.config.Categories()
+}
+
+// CanCreateCategories reports whether unknown categories are created in qBittorrent on add
+func (ts *TorrentService) CanCreateCategories() bool {
+	return ts.config.QBittorrent.AutoCreateCategories
+}
+
+// ensureSavePath verifies that a category save path is usable, creating it when
+// QBITTORRENT_CREATE_SAVE_PATHS is enabled. A missing path is otherwise only
+// logged and left to qBittorrent. Nothing is checked for a remote qBittorrent,
+// whose paths don't exist on this machine.
+func (ts *TorrentService) ensureSavePath(savePath string) error {
+	if ts.config.QBittorrent.Remote || savePath == "" {
+		return nil
+	}
+
+	info, err := os.Stat(savePath)
+	switch {
+	case err == nil && !info.IsDir():
+		return fmt.Errorf("save path %s exists but is not a directory", savePath)
+	case err == nil:
+		return nil
+	case !errors.Is(err, fs.ErrNotExist):
+		return fmt.Errorf("save path %s is not accessible: %w", savePath, err)
+	case !ts.config.QBittorrent.CreateSavePaths:
+		ts.logger.WithField("save_path", savePath).Warn("Save path does not exist, leaving it to qBittorrent " +
+			"(set QBITTORRENT_CREATE_SAVE_PATHS=true to create it)")
+		return nil
+	}
+
+	if err := os.MkdirAll(savePath, 0755); err != nil {
+		ts.logger.WithError(err).WithField("save_path", savePath).Error("Failed to create save path")
+		if errors.Is(err, fs.ErrPermission) {
+			return fmt.Errorf("permission denied creating save path %s: make sure Akira can write to its parent directory", savePath)
+		}
+		return fmt.Errorf("failed to create save path %s: %w", savePath, err)
+	}
+
+	ts.logger.WithField("save_path", savePath).Info("Created missing save path")
+	return nil
+}
+
+// ensureCategoryExists creates the category in qBittorrent if it is missing from the category list
+func (ts *TorrentService) ensureCategoryExists(ctx context.Context, category string) error {
+	categories, err := ts.client.GetCategories(ctx)
+	if err != nil {
+		ts.logger.WithError(err).Error("Failed to fetch categories")
+		return fmt.Errorf("failed to fetch categories: %w", err)
+	}
+
+	if _, exists := categories[category]; exists {
+		return nil
+	}
+
+	// Templated paths change over time, so categories get the fixed configured path
+	savePath := ts.config.GetConfiguredSavePath(category)
+	if err := ts.client.CreateCategory(ctx, category, savePath); err != nil {
+		// qBittorrent answers 409 when the category exists already, e.g. when a
+		// concurrent add created it after the category list was fetched
+		var apiErr *qbittorrent.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusConflict {
+			return nil
+		}
+		ts.logger.WithError(err).Error("Failed to create category")
+		return fmt.Errorf("failed to create category '%s': %w", category, err)
+	}
+
+	ts.logger.WithFields(map[string]interface{}{
+		"category":  category,
+		"save_path": savePath,
+	}).Info("Created missing category in qBittorrent")
+
+	return nil
+}
+
 // extractHashFromMagnet extracts the info hash from a magnet URI
 func (ts *TorrentService) extractHashFromMagnet(magnetURI string) (string, error) {
 	parsedURL, err := url.Parse(magnetURI)
@@ -277,20 +774,37 @@ func (ts *TorrentService) extractHashFromMagnet(magnetURI string) (string, error
 
 	query := parsedURL.Query()
 	if !query.Has("xt") {
-		return "", fmt.Errorf("invalid magnet URI: missing 'xt' parameter")
+		return "", fmt.Errorf("%w: missing 'xt' parameter", ErrInvalidMagnet)
 	}
 
 	xt := query.Get("xt")
 	if !strings.HasPrefix(xt, "urn:btih:") {
-		return "", fmt.Errorf("invalid magnet URI: 'xt' parameter must start with 'urn:btih:'")
+		return "", fmt.Errorf("%w: 'xt' parameter must start with 'urn:btih:'", ErrInvalidMagnet)
 	}
 
 	hash := strings.TrimPrefix(xt, "urn:btih:")
 	if len(hash) != 32 && len(hash) != 40 {
-		return "", fmt.Errorf("invalid magnet URI: info hash must be 32 or 40 characters")
+		return "", fmt.Errorf("%w: info hash must be 32 or 40 characters", ErrInvalidMagnet)
+	}
+	if len(hash) == 32 {
+		if _, err := base32.StdEncoding.DecodeString(strings.ToUpper(hash)); err != nil {
+			return "", fmt.Errorf("%w: malformed base32 info hash", ErrInvalidMagnet)
+		}
 	}
 
-	return hash, nil
+	return NormalizeInfoHash(hash), nil
+}
+
+// NormalizeInfoHash converts an info hash to the lowercase hex form qBittorrent
+// reports, decoding the 32-character base32 form magnet links may use. Other
+// hashes are only lowercased.
+func NormalizeInfoHash(hash string) string {
+	if len(hash) == 32 {
+		if decoded, err := base32.StdEncoding.DecodeString(strings.ToUpper(hash)); err == nil {
+			return hex.EncodeToString(decoded)
+		}
+	}
+	return strings.ToLower(hash)
 }
 
 // DeleteTorrents deletes torrents with category-based filtering
@@ -385,7 +899,213 @@ func (ts *TorrentService) FindTorrentByHash(ctx context.Context, hash string) (*
 		}
 	}
 
-	return nil, fmt.Errorf("torrent with hash '%s' not found", hash)
+	return nil, fmt.Errorf("%w: no torrent with hash '%s'", ErrTorrentNotFound, hash)
+}
+
+// RenameTorrent changes the display name of a torrent. Files on disk keep their names.
+func (ts *TorrentService) RenameTorrent(ctx context.Context, hash, newName string) error {
+	newName = strings.TrimSpace(newName)
+	if hash == "" {
+		return fmt.Errorf("no torrent hash provided")
+	}
+	if newName == "" {
+		return fmt.Errorf("new name cannot be empty")
+	}
+
+	ts.logger.WithFields(map[string]interface{}{
+		"hash": hash,
+		"name": newName,
+	}).Info("Renaming torrent")
+
+	if err := ts.client.RenameTorrent(ctx, hash, newName); err != nil {
+		ts.logger.WithError(err).Error("Failed to rename torrent")
+
+		var apiErr *qbittorrent.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusConflict {
+			return fmt.Errorf("cannot rename torrent to '%s': qBittorrent rejected the name", newName)
+		}
+		return fmt.Errorf("failed to rename torrent: %w", err)
+	}
+
+	ts.logger.WithField("hash", hash).Info("Torrent renamed successfully")
+	return nil
+}
+
+// RenameFile renames or moves a file inside a torrent. Paths are relative to the torrent's root.
+func (ts *TorrentService) RenameFile(ctx context.Context, hash, oldPath, newPath string) error {
+	return ts.renamePath(ctx, hash, oldPath, newPath, false)
+}
+
+// RenameFolder renames or moves a folder inside a torrent. Paths are relative to the torrent's root.
+func (ts *TorrentService) RenameFolder(ctx context.Context, hash, oldPath, newPath string) error {
+	return ts.renamePath(ctx, hash, oldPath, newPath, true)
+}
+
+// renamePath validates and performs a file or folder rename, turning qBittorrent's
+// conflict response into a readable error
+func (ts *TorrentService) renamePath(ctx context.Context, hash, oldPath, newPath string, folder bool) error {
+	kind := "file"
+	if folder {
+		kind = "folder"
+	}
+
+	oldPath, newPath = strings.TrimSpace(oldPath), strings.TrimSpace(newPath)
+	if hash == "" {
+		return fmt.Errorf("no torrent hash provided")
+	}
+	if oldPath == "" {
+		return fmt.Errorf("%s path cannot be empty", kind)
+	}
+	if newPath == "" {
+		return fmt.Errorf("new %s name cannot be empty", kind)
+	}
+	if oldPath == newPath {
+		return fmt.Errorf("new %s name is the same as the old one", kind)
+	}
+
+	ts.logger.WithFields(map[string]interface{}{
+		"hash":     hash,
+		"old_path": oldPath,
+		"new_path": newPath,
+	}).Infof("Renaming torrent %s", kind)
+
+	var err error
+	if folder {
+		err = ts.client.RenameFolder(ctx, hash, oldPath, newPath)
+	} else {
+		err = ts.client.RenameFile(ctx, hash, oldPath, newPath)
+	}
+	if err != nil {
+		ts.logger.WithError(err).Errorf("Failed to rename torrent %s", kind)
+
+		// qBittorrent answers 409 when the old path doesn't exist or the new one is taken
+		var apiErr *qbittorrent.APIError
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusConflict {
+			return fmt.Errorf("cannot rename %s '%s' to '%s': the name is already taken or the %s doesn't exist", kind, oldPath, newPath, kind)
+		}
+		return fmt.Errorf("failed to rename %s: %w", kind, err)
+	}
+
+	ts.logger.WithField("hash", hash).Infof("Torrent %s renamed successfully", kind)
+	return nil
+}
+
+// SetTorrentLocation moves the data of the specified torrents to a new directory.
+// The torrents show as moving until qBittorrent has finished copying.
+func (ts *TorrentService) SetTorrentLocation(ctx context.Context, hashes []string, location string) error {
+	if len(hashes) == 0 {
+		return fmt.Errorf("no torrent hashes provided")
+	}
+
+	location = strings.TrimSpace(location)
+	if location == "" {
+		return fmt.Errorf("location cannot be empty")
+	}
+
+	ts.logger.WithFields(map[string]interface{}{
+		"count":    len(hashes),
+		"location": location,
+	}).Info("Moving torrents")
+
+	if err := ts.client.SetTorrentLocation(ctx, hashes, location); err != nil {
+		ts.logger.WithError(err).Error("Failed to move torrents")
+		return fmt.Errorf("failed to move torrents: %w", err)
+	}
+
+	ts.logger.WithField("count", len(hashes)).Info("Torrents moved successfully")
+	return nil
+}
+
+// SetCategory moves the specified torrents to a category, creating the category
+// in qBittorrent first if it doesn't exist there yet
+func (ts *TorrentService) SetCategory(ctx context.Context, hashes []string, category string) error {
+	if len(hashes) == 0 {
+		return fmt.Errorf("no torrent hashes provided")
+	}
+
+	category = strings.ToLower(strings.TrimSpace(category))
+	if category == "" {
+		return fmt.Errorf("category cannot be empty")
+	}
+	if !ts.isValidCategory(category) && !ts.config.QBittorrent.AutoCreateCategories {
+		return fmt.Errorf("%w: %s (valid: %v)", ErrInvalidCategory, category, ts.config.GetValidCategories())
+	}
+
+	ts.logger.WithFields(map[string]interface{}{
+		"count":    len(hashes),
+		"category": category,
+	}).Info("Setting torrent category")
+
+	if err := ts.ensureCategoryExists(ctx, category); err != nil {
+		return err
+	}
+
+	if err := ts.client.SetCategory(ctx, hashes, category); err != nil {
+		ts.logger.WithError(err).Error("Failed to set torrent category")
+		return fmt.Errorf("failed to set category: %w", err)
+	}
+
+	ts.logger.WithField("count", len(hashes)).Info("Torrent category set successfully")
+	return nil
+}
+
+// GetAllTags retrieves all tags defined in qBittorrent, sorted by name
+func (ts *TorrentService) GetAllTags(ctx context.Context) ([]string, error) {
+	tags, err := ts.client.GetAllTags(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		return strings.ToLower(tags[i]) < strings.ToLower(tags[j])
+	})
+	return tags, nil
+}
+
+// AddTags adds tags to the specified torrents. qBittorrent creates tags that don't exist yet.
+func (ts *TorrentService) AddTags(ctx context.Context, hashes []string, tags []string) error {
+	if len(hashes) == 0 {
+		return fmt.Errorf("no torrent hashes provided")
+	}
+	if len(tags) == 0 {
+		return fmt.Errorf("no tags provided")
+	}
+
+	ts.logger.WithFields(map[string]interface{}{
+		"count": len(hashes),
+		"tags":  tags,
+	}).Info("Adding torrent tags")
+
+	if err := ts.client.AddTorrentTags(ctx, hashes, tags); err != nil {
+		ts.logger.WithError(err).Error("Failed to add torrent tags")
+		return fmt.Errorf("failed to add tags: %w", err)
+	}
+
+	ts.logger.WithField("count", len(hashes)).Info("Torrent tags added successfully")
+	return nil
+}
+
+// RemoveTags removes tags from the specified torrents
+func (ts *TorrentService) RemoveTags(ctx context.Context, hashes []string, tags []string) error {
+	if len(hashes) == 0 {
+		return fmt.Errorf("no torrent hashes provided")
+	}
+	if len(tags) == 0 {
+		return fmt.Errorf("no tags provided")
+	}
+
+	ts.logger.WithFields(map[string]interface{}{
+		"count": len(hashes),
+		"tags":  tags,
+	}).Info("Removing torrent tags")
+
+	if err := ts.client.RemoveTorrentTags(ctx, hashes, tags); err != nil {
+		ts.logger.WithError(err).Error("Failed to remove torrent tags")
+		return fmt.Errorf("failed to remove tags: %w", err)
+	}
+
+	ts.logger.WithField("count", len(hashes)).Info("Torrent tags removed successfully")
+	return nil
 }
 
 // PauseTorrents pauses the specified torrents
@@ -406,6 +1126,183 @@ func (ts *TorrentService) PauseTorrents(ctx context.Context, hashes []string) er
 	return nil
 }
 
+// SetTorrentPriorityTop moves the specified torrents to the top of the download queue
+func (ts *TorrentService) SetTorrentPriorityTop(ctx context.Context, hashes []string) error {
+	if len(hashes) == 0 {
+		return fmt.Errorf("no torrent hashes provided")
+	}
+
+	ts.logger.WithField("count", len(hashes)).Info("Moving torrents to top of queue")
+
+	err := ts.client.SetTorrentPriorityTop(ctx, hashes)
+	if err != nil {
+		ts.logger.WithError(err).Error("Failed to move torrents to top of queue")
+		return fmt.Errorf("failed to move torrents to top of queue: %w", err)
+	}
+
+	ts.logger.WithField("count", len(hashes)).Info("Torrents moved to top of queue successfully")
+	return nil
+}
+
+// RecheckTorrents forces a hash recheck of the specified torrents
+func (ts *TorrentService) RecheckTorrents(ctx context.Context, hashes []string) error {
+	if len(hashes) == 0 {
+		return fmt.Errorf("no torrent hashes provided")
+	}
+
+	ts.logger.WithField("count", len(hashes)).Info("Rechecking torrents")
+
+	err := ts.client.RecheckTorrents(ctx, hashes)
+	if err != nil {
+		ts.logger.WithError(err).Error("Failed to recheck torrents")
+		return fmt.Errorf("failed to recheck torrents: %w", err)
+	}
+
+	ts.logger.WithField("count", len(hashes)).Info("Torrents recheck started successfully")
+	return nil
+}
+
+// ReannounceTorrents forces the specified torrents to reannounce to their trackers
+func (ts *TorrentService) ReannounceTorrents(ctx context.Context, hashes []string) error {
+	if len(hashes) == 0 {
+		return fmt.Errorf("no torrent hashes provided")
+	}
+
+	ts.logger.WithField("count", len(hashes)).Info("Reannouncing torrents")
+
+	err := ts.client.ReannounceTorrents(ctx, hashes)
+	if err != nil {
+		ts.logger.WithError(err).Error("Failed to reannounce torrents")
+		return fmt.Errorf("failed to reannounce torrents: %w", err)
+	}
+
+	ts.logger.WithField("count", len(hashes)).Info("Torrents reannounced successfully")
+	return nil
+}
+
+// SetTorrentDownloadLimit sets the download speed limit (bytes/s, 0 for no limit) of the specified torrents
+func (ts *TorrentService) SetTorrentDownloadLimit(ctx context.Context, hashes []string, limit int64) error {
+	if len(hashes) == 0 {
+		return fmt.Errorf("no torrent hashes provided")
+	}
+	if limit < 0 {
+		return fmt.Errorf("download limit must not be negative")
+	}
+
+	ts.logger.WithFields(map[string]interface{}{
+		"count": len(hashes),
+		"limit": limit,
+	}).Info("Setting torrent download limit")
+
+	err := ts.client.SetTorrentDownloadLimit(ctx, hashes, limit)
+	if err != nil {
+		ts.logger.WithError(err).Error("Failed to set torrent download limit")
+		return fmt.Errorf("failed to set torrent download limit: %w", err)
+	}
+
+	ts.logger.WithField("count", len(hashes)).Info("Torrent download limit set successfully")
+	return nil
+}
+
+// SetTorrentUploadLimit sets the upload speed limit (bytes/s, 0 for no limit) of the specified torrents
+func (ts *TorrentService) SetTorrentUploadLimit(ctx context.Context, hashes []string, limit int64) error {
+	if len(hashes) == 0 {
+		return fmt.Errorf("no torrent hashes provided")
+	}
+	if limit < 0 {
+		return fmt.Errorf("upload limit must not be negative")
+	}
+
+	ts.logger.WithFields(map[string]interface{}{
+		"count": len(hashes),
+		"limit": limit,
+	}).Info("Setting torrent upload limit")
+
+	err := ts.client.SetTorrentUploadLimit(ctx, hashes, limit)
+	if err != nil {
+		ts.logger.WithError(err).Error("Failed to set torrent upload limit")
+		return fmt.Errorf("failed to set torrent upload limit: %w", err)
+	}
+
+	ts.logger.WithField("count", len(hashes)).Info("Torrent upload limit set successfully")
+	return nil
+}
+
+// SetGlobalDownloadLimit sets the client-wide download speed limit (bytes/s, 0 for no limit)
+func (ts *TorrentService) SetGlobalDownloadLimit(ctx context.Context, limit int64) error {
+	if limit < 0 {
+		return fmt.Errorf("download limit must not be negative")
+	}
+
+	ts.logger.WithField("limit", limit).Info("Setting global download limit")
+
+	if err := ts.client.SetGlobalDownloadLimit(ctx, limit); err != nil {
+		ts.logger.WithError(err).Error("Failed to set global download limit")
+		return fmt.Errorf("failed to set global download limit: %w", err)
+	}
+
+	ts.logger.WithField("limit", limit).Info("Global download limit set successfully")
+	return nil
+}
+
+// SetGlobalUploadLimit sets the client-wide upload speed limit (bytes/s, 0 for no limit)
+func (ts *TorrentService) SetGlobalUploadLimit(ctx context.Context, limit int64) error {
+	if limit < 0 {
+		return fmt.Errorf("upload limit must not be negative")
+	}
+
+	ts.logger.WithField("limit", limit).Info("Setting global upload limit")
+
+	if err := ts.client.SetGlobalUploadLimit(ctx, limit); err != nil {
+		ts.logger.WithError(err).Error("Failed to set global upload limit")
+		return fmt.Errorf("failed to set global upload limit: %w", err)
+	}
+
+	ts.logger.WithField("limit", limit).Info("Global upload limit set successfully")
+	return nil
+}
+
+// GetAlternativeSpeedLimits reports whether the alternative speed limits are active
+func (ts *TorrentService) GetAlternativeSpeedLimits(ctx context.Context) (bool, error) {
+	enabled, err := ts.client.GetSpeedLimitsMode(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get speed limits mode: %w", err)
+	}
+	return enabled, nil
+}
+
+// ToggleAlternativeSpeedLimits switches between the normal and alternative speed limits
+// and returns whether the alternative limits are now active
+func (ts *TorrentService) ToggleAlternativeSpeedLimits(ctx context.Context) (bool, error) {
+	enabled, err := ts.GetAlternativeSpeedLimits(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	ts.logger.WithField("enabled", !enabled).Info("Switching alternative speed limits")
+
+	if err := ts.client.ToggleAlternativeSpeedLimits(ctx); err != nil {
+		ts.logger.WithError(err).Error("Failed to switch alternative speed limits")
+		return enabled, fmt.Errorf("failed to toggle alternative speed limits: %w", err)
+	}
+
+	return !enabled, nil
+}
+
+// SetAlternativeSpeedLimits turns the alternative speed limits on or off, toggling only
+// when the current mode differs. It returns whether the alternative limits are now active.
+func (ts *TorrentService) SetAlternativeSpeedLimits(ctx context.Context, enabled bool) (bool, error) {
+	current, err := ts.GetAlternativeSpeedLimits(ctx)
+	if err != nil {
+		return false, err
+	}
+	if current == enabled {
+		return current, nil
+	}
+
+	return ts.ToggleAlternativeSpeedLimits(ctx)
+}
+
 // StopTorrents stops the specified torrents (completely stops them)
 func (ts *TorrentService) StopTorrents(ctx context.Context, hashes []string) error {
 	if len(hashes) == 0 {
@@ -481,15 +1378,31 @@ func (ts *TorrentService) GetTorrentStats(ctx context.Context) (*TorrentStats, e
 		"downloading": stats.Downloading,
 		"seeding":     stats.Seeding,
 		"completed":   stats.Completed,
-	}).Info("Torrent statistics calculated")
+	}).Debug("Torrent statistics calculated")
 
 	return stats, nil
 }
 
 // Helper methods
 
-// applyFilter applies filtering logic to torrents
-func (ts *TorrentService) applyFilter(torrents []qbittorrent.Torrent, filter *TorrentFilter) []qbittorrent.Torrent {
+// matchesTimeRange reports whether a Unix timestamp lies in [after, before). Zero
+// bounds are open; an unset timestamp never matches a bound.
+func matchesTimeRange(timestamp int64, after, before time.Time) bool {
+	if after.IsZero() && before.IsZero() {
+		return true
+	}
+	if timestamp <= 0 {
+		return false
+	}
+	t := time.Unix(timestamp, 0)
+	if !after.IsZero() && t.Before(after) {
+		return false
+	}
+	return before.IsZero() || t.Before(before)
+}
+
+// applyFilter applies filtering logic to torrents. It fails if the name pattern is not a valid regex.
+func (ts *TorrentService) applyFilter(torrents []qbittorrent.Torrent, filter *TorrentFilter) ([]qbittorrent.Torrent, error) {
 	var filtered []qbittorrent.Torrent
 
 	// Compile regex pattern if provided
@@ -498,20 +1411,25 @@ func (ts *TorrentService) applyFilter(torrents []qbittorrent.Torrent, filter *To
 		var err error
 		nameRegex, err = regexp.Compile("(?i)" + filter.NamePattern) // Case insensitive
 		if err != nil {
-			ts.logger.WithError(err).Warn("Invalid regex pattern, skipping name filter")
-			nameRegex = nil
+			return nil, fmt.Errorf("%w '%s': %v", ErrInvalidPattern, filter.NamePattern, err)
 		}
 	}
 
 	for _, torrent := range torrents {
-		// Filter by category
+		// Filter by category, which may come from the save path when the
+		// torrent has no category set in qBittorrent
 		if filter.Category != "" {
-			torrentCategory := ts.getTorrentCategory(torrent)
-			if torrentCategory != filter.Category {
+			torrentCategory := ts.TorrentCategory(torrent)
+			if !strings.EqualFold(torrentCategory, filter.Category) {
 				continue
 			}
 		}
 
+		// Filter by tag
+		if filter.Tag != "" && !torrent.HasTag(filter.Tag) {
+			continue
+		}
+
 		// Filter by state
 		if filter.State != "" && torrent.State != filter.State {
 			continue
@@ -546,93 +1464,147 @@ func (ts *TorrentService) applyFilter(torrents []qbittorrent.Torrent, filter *To
 			continue
 		}
 
+		// Filter by size
+		if filter.MinSize > 0 && torrent.Size < filter.MinSize {
+			continue
+		}
+		if filter.MaxSize > 0 && torrent.Size > filter.MaxSize {
+			continue
+		}
+
+		// Filter by ratio
+		if filter.MinRatio > 0 && torrent.Ratio < filter.MinRatio {
+			continue
+		}
+		if filter.MaxRatio > 0 && torrent.Ratio > filter.MaxRatio {
+			continue
+		}
+
+		// Filter by added and completion time; incomplete torrents have no completion time
+		if !matchesTimeRange(torrent.AddedOn, filter.AddedAfter, filter.AddedBefore) ||
+			!matchesTimeRange(torrent.CompletionOn, filter.CompletedAfter, filter.CompletedBefore) {
+			continue
+		}
+
 		filtered = append(filtered, torrent)
 	}
 
 	// Apply sorting
 	ts.sortTorrents(filtered, filter.SortBy, filter.SortDesc)
+	if filter.Reverse {
+		slices.Reverse(filtered)
+	}
 
 	// Apply limit
 	if filter.Limit > 0 && len(filtered) > filter.Limit {
 		filtered = filtered[:filter.Limit]
 	}
 
-	return filtered
+	return filtered, nil
 }
 
-// sortTorrents sorts torrents by the specified field
+// sortTorrents sorts torrents by the specified field. The sort is stable and
+// ties are broken by name (then hash), so repeated listings order consistently.
 func (ts *TorrentService) sortTorrents(torrents []qbittorrent.Torrent, sortBy TorrentSortField, desc bool) {
-	sort.Slice(torrents, func(i, j int) bool {
-		var less bool
+	sort.SliceStable(torrents, func(i, j int) bool {
+		a, b := torrents[i], torrents[j]
 
+		var order int
 		switch sortBy {
 		case SortByName:
-			less = strings.ToLower(torrents[i].Name) < strings.ToLower(torrents[j].Name)
+			order = compareNames(a, b)
 		case SortBySize:
-			less = torrents[i].Size < torrents[j].Size
+			order = cmp.Compare(a.Size, b.Size)
 		case SortByProgress:
-			less = torrents[i].Progress < torrents[j].Progress
+			order = cmp.Compare(a.Progress, b.Progress)
 		case SortByDownloadSpeed:
-			less = torrents[i].Dlspeed < torrents[j].Dlspeed
+			order = cmp.Compare(a.Dlspeed, b.Dlspeed)
 		case SortByUploadSpeed:
-			less = torrents[i].Upspeed < torrents[j].Upspeed
+			order = cmp.Compare(a.Upspeed, b.Upspeed)
 		case SortByAddedDate:
-			less = torrents[i].AddedOn < torrents[j].AddedOn
+			order = cmp.Compare(a.AddedOn, b.AddedOn)
 		case SortByCompletedDate:
-			less = torrents[i].CompletionOn < torrents[j].CompletionOn
+			order = cmp.Compare(a.CompletionOn, b.CompletionOn)
 		case SortByRatio:
-			less = torrents[i].Ratio < torrents[j].Ratio
+			order = cmp.Compare(a.Ratio, b.Ratio)
 		case SortBySeedingTime:
-			less = torrents[i].SeedingTime < torrents[j].SeedingTime
+			order = cmp.Compare(a.SeedingTime, b.SeedingTime)
 		default:
-			less = strings.ToLower(torrents[i].Name) < strings.ToLower(torrents[j].Name)
+			order = compareNames(a, b)
 		}
 
 		if desc {
-			return !less
+			order = -order
+		}
+		if order != 0 {
+			return order < 0
 		}
-		return less
+
+		// Ties always order by name ascending, whatever the sort direction
+		return compareNames(a, b) < 0
 	})
 }
 
-// getTorrentCategory determines the category of a torrent based on its save path
-func (ts *TorrentService) getTorrentCategory(torrent qbittorrent.Torrent) string {
+// compareNames compares torrents by case-insensitive name, falling back to the hash
+func compareNames(a, b qbittorrent.Torrent) int {
+	if order := strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)); order != 0 {
+		return order
+	}
+	return strings.Compare(a.Hash, b.Hash)
+}
+
+// TorrentCategory returns the category of a torrent. Torrents without a category
+// in qBittorrent get the category whose save path contains theirs, or "default".
+func (ts *TorrentService) TorrentCategory(torrent qbittorrent.Torrent) string {
 	// First check the category field
 	if torrent.Category != "" {
 		return torrent.Category
 	}
 
-	// Fall back to path-based detection
-	savePath := strings.ToLower(torrent.SavePath)
+	// Fall back to path-based detection. The most specific category path
+	// containing the save path wins, so /data/movies/4k isn't taken for
+	// /data/movies and /data/movies-4k isn't taken for either.
+	savePath := normalizeCategoryPath(torrent.SavePath)
+	defaultPath := normalizeCategoryPath(ts.config.QBittorrent.SavePaths.Default)
+
+	match, matchPath := "default", ""
+	for _, category := range ts.config.Categories() {
+		categoryPath := normalizeCategoryPath(ts.config.GetConfiguredSavePath(category.Name))
+		// Categories without their own path can't be told apart from the default
+		if categoryPath == "/" || categoryPath == defaultPath {
+			continue
+		}
+		if strings.Contains(savePath, categoryPath) && len(categoryPath) > len(matchPath) {
+			match, matchPath = category.Name, categoryPath
+		}
+	}
 
-	seriesPath := strings.ToLower(ts.config.QBittorrent.SavePaths.Series)
-	moviesPath := strings.ToLower(ts.config.QBittorrent.SavePaths.Movies)
-	animePath := strings.ToLower(ts.config.QBittorrent.SavePaths.Anime)
+	return match
+}
 
-	if strings.Contains(savePath, seriesPath) {
-		return "series"
-	} else if strings.Contains(savePath, moviesPath) {
-		return "movies"
-	} else if strings.Contains(savePath, animePath) {
-		return "anime"
+// normalizeCategoryPath lowercases a save path, uses forward slashes and wraps
+// it in slashes, so that containment only matches whole path components
+func normalizeCategoryPath(path string) string {
+	path = strings.Trim(strings.ReplaceAll(strings.ToLower(path), "\\", "/"), "/")
+	if path == "" {
+		return "/"
 	}
-
-	return "default"
+	return "/" + path + "/"
 }
 
 // validateMagnetURI validates that a string is a valid magnet URI
 func (ts *TorrentService) validateMagnetURI(magnetURI string) error {
 	if magnetURI == "" {
-		return fmt.Errorf("magnet URI cannot be empty")
+		return fmt.Errorf("%w: magnet URI cannot be empty", ErrInvalidMagnet)
 	}
 
 	if !strings.HasPrefix(strings.ToLower(magnetURI), "magnet:?") {
-		return fmt.Errorf("invalid magnet URI format")
+		return fmt.Errorf("%w: must start with 'magnet:?'", ErrInvalidMagnet)
 	}
 
 	// Check for required xt parameter (exact topic)
 	if !strings.Contains(magnetURI, "xt=urn:btih:") {
-		return fmt.Errorf("magnet URI missing required xt parameter")
+		return fmt.Errorf("%w: missing required xt parameter", ErrInvalidMagnet)
 	}
 
 	return nil
@@ -0,0 +1,271 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/raainshe/akira/internal/config"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// newTestClient starts a fake qBittorrent that accepts any login and serves the
+// other endpoints from mux. It returns a client for it.
+func newTestClient(t *testing.T, mux *http.ServeMux) *qbittorrent.Client {
+	t.Helper()
+
+	mux.HandleFunc("/api/v2/auth/login", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "SID", Value: "test", Path: "/"})
+		w.Write([]byte("Ok."))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := qbittorrent.NewClient(server.URL, "admin", "secret")
+	if err != nil {
+		t.Fatalf("NewClient unexpected error: %v", err)
+	}
+	return client
+}
+
+// newCategoryTestService returns a service with the default categories saved
+// under /data, for testing category detection without qBittorrent
+func newCategoryTestService() *TorrentService {
+	cfg := &config.Config{}
+	cfg.QBittorrent.SavePaths = config.SavePathsConfig{
+		Default: "/data",
+		Movies:  "/data/movies",
+		Series:  "/data/series",
+		Anime:   "/data/anime",
+	}
+	return &TorrentService{config: cfg}
+}
+
+func TestTorrentCategory(t *testing.T) {
+	tests := []struct {
+		name    string
+		torrent qbittorrent.Torrent
+		want    string
+	}{
+		{name: "category field", torrent: qbittorrent.Torrent{Category: "movies", SavePath: "/elsewhere"}, want: "movies"},
+		{name: "path only", torrent: qbittorrent.Torrent{SavePath: "/data/movies"}, want: "movies"},
+		{name: "path with trailing slash", torrent: qbittorrent.Torrent{SavePath: "/data/movies/"}, want: "movies"},
+		{name: "path below category path", torrent: qbittorrent.Torrent{SavePath: "/data/movies/Some Movie (2024)"}, want: "movies"},
+		{name: "path in other case", torrent: qbittorrent.Torrent{SavePath: "/DATA/Movies"}, want: "movies"},
+		{name: "windows separators", torrent: qbittorrent.Torrent{SavePath: `D:\data\series`}, want: "series"},
+		{name: "partial path component", torrent: qbittorrent.Torrent{SavePath: "/data/movies-4k"}, want: "default"},
+		{name: "default path", torrent: qbittorrent.Torrent{SavePath: "/data"}, want: "default"},
+		{name: "no path", torrent: qbittorrent.Torrent{}, want: "default"},
+	}
+
+	ts := newCategoryTestService()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ts.TorrentCategory(tt.torrent); got != tt.want {
+				t.Errorf("TorrentCategory(category %q, path %q) = %q, want %q", tt.torrent.Category, tt.torrent.SavePath, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyFilterCategory(t *testing.T) {
+	torrents := []qbittorrent.Torrent{
+		{Name: "explicit", Category: "movies", SavePath: "/data/movies"},
+		{Name: "path only", SavePath: "/data/movies"},
+		{Name: "other case", Category: "Movies", SavePath: "/elsewhere"},
+		{Name: "partial component", SavePath: "/data/movies-4k"},
+		{Name: "series", SavePath: "/data/series"},
+	}
+
+	ts := newCategoryTestService()
+	filtered, err := ts.applyFilter(torrents, &TorrentFilter{Category: "movies"})
+	if err != nil {
+		t.Fatalf("applyFilter unexpected error: %v", err)
+	}
+
+	got := make(map[string]bool)
+	for _, torrent := range filtered {
+		got[torrent.Name] = true
+	}
+	for _, name := range []string{"explicit", "path only", "other case"} {
+		if !got[name] {
+			t.Errorf("applyFilter dropped %q", name)
+		}
+	}
+	if len(got) != 3 {
+		t.Errorf("applyFilter returned %d torrents, want 3: %v", len(got), got)
+	}
+}
+
+func TestNormalizeInfoHash(t *testing.T) {
+	const hex = "c12fe1c06bba254a9dc9f519b335aa7c1367a88a"
+	tests := []struct {
+		name string
+		hash string
+		want string
+	}{
+		{name: "lowercase hex", hash: hex, want: hex},
+		{name: "uppercase hex", hash: "C12FE1C06BBA254A9DC9F519B335AA7C1367A88A", want: hex},
+		{name: "base32", hash: "YEX6DQDLXISUVHOJ6UM3GNNKPQJWPKEK", want: hex},
+		{name: "lowercase base32", hash: "yex6dqdlxisuvhoj6um3gnnkpqjwpkek", want: hex},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeInfoHash(tt.hash); got != tt.want {
+				t.Errorf("NormalizeInfoHash(%q) = %q, want %q", tt.hash, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyFilterInvalidPattern(t *testing.T) {
+	ts := newCategoryTestService()
+	_, err := ts.applyFilter([]qbittorrent.Torrent{{Name: "Ubuntu"}}, &TorrentFilter{NamePattern: "ubuntu[("})
+	if !errors.Is(err, ErrInvalidPattern) {
+		t.Errorf("applyFilter(invalid pattern) error = %v, want ErrInvalidPattern", err)
+	}
+}
+
+func TestAddMagnetAutoCreatesOnlyNamedCategories(t *testing.T) {
+	tests := []struct {
+		name         string
+		category     string
+		wantCategory string
+		wantCreated  []string
+		conflict     bool
+	}{
+		{name: "no category", category: "", wantCategory: "", wantCreated: nil},
+		{name: "default pseudo-category", category: "default", wantCategory: "", wantCreated: nil},
+		{name: "new category", category: "music", wantCategory: "music", wantCreated: []string{"music"}},
+		{name: "mixed-case category", category: " Music ", wantCategory: "music", wantCreated: []string{"music"}},
+		{name: "category created concurrently", category: "music", wantCategory: "music", wantCreated: []string{"music"}, conflict: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var mutex sync.Mutex
+			var created []string
+			addedCategory := "<not added>"
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v2/torrents/info", func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("[]"))
+			})
+			mux.HandleFunc("/api/v2/torrents/categories", func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("{}"))
+			})
+			mux.HandleFunc("/api/v2/torrents/createCategory", func(w http.ResponseWriter, r *http.Request) {
+				mutex.Lock()
+				created = append(created, r.FormValue("category"))
+				mutex.Unlock()
+				if tt.conflict {
+					w.WriteHeader(http.StatusConflict)
+				}
+			})
+			mux.HandleFunc("/api/v2/torrents/add", func(w http.ResponseWriter, r *http.Request) {
+				mutex.Lock()
+				addedCategory = r.FormValue("category")
+				mutex.Unlock()
+				w.Write([]byte("Ok."))
+			})
+			client := newTestClient(t, mux)
+
+			cfg := &config.Config{}
+			cfg.QBittorrent.Remote = true
+			cfg.QBittorrent.AutoCreateCategories = true
+			ts := NewTorrentService(client, cfg, nil)
+
+			_, err := ts.AddMagnet(context.Background(), &AddTorrentRequest{
+				MagnetURI: "magnet:?xt=urn:btih:c12fe1c06bba254a9dc9f519b335aa7c1367a88a&dn=Ubuntu",
+				Category:  tt.category,
+			})
+			if err != nil {
+				t.Fatalf("AddMagnet unexpected error: %v", err)
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if addedCategory != tt.wantCategory {
+				t.Errorf("added with category %q, want %q", addedCategory, tt.wantCategory)
+			}
+			if len(created) != len(tt.wantCreated) || (len(created) > 0 && created[0] != tt.wantCreated[0]) {
+				t.Errorf("created categories %v, want %v", created, tt.wantCreated)
+			}
+		})
+	}
+}
+
+func TestAddMagnetFailsWhenDuplicateCheckFails(t *testing.T) {
+	var added atomic.Bool
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/torrents/info", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/api/v2/torrents/add", func(w http.ResponseWriter, r *http.Request) {
+		added.Store(true)
+		w.Write([]byte("Ok."))
+	})
+	client := newTestClient(t, mux)
+
+	ts := NewTorrentService(client, &config.Config{}, nil)
+	_, err := ts.AddMagnet(context.Background(), &AddTorrentRequest{
+		MagnetURI: "magnet:?xt=urn:btih:c12fe1c06bba254a9dc9f519b335aa7c1367a88a&dn=Ubuntu",
+	})
+	if err == nil {
+		t.Fatal("AddMagnet error = nil, want the failed duplicate check")
+	}
+	if added.Load() {
+		t.Error("AddMagnet added the torrent although the duplicate check failed")
+	}
+}
+
+func TestEnsureSavePathMissing(t *testing.T) {
+	tests := []struct {
+		name       string
+		create     bool
+		wantExists bool
+	}{
+		{name: "creation disabled", create: false, wantExists: false},
+		{name: "creation enabled", create: true, wantExists: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			savePath := filepath.Join(t.TempDir(), "music")
+
+			cfg := &config.Config{}
+			cfg.QBittorrent.CreateSavePaths = tt.create
+			ts := NewTorrentService(nil, cfg, nil)
+
+			if err := ts.ensureSavePath(savePath); err != nil {
+				t.Fatalf("ensureSavePath(%q) unexpected error: %v", savePath, err)
+			}
+			if _, err := os.Stat(savePath); (err == nil) != tt.wantExists {
+				t.Errorf("save path exists = %v, want %v", err == nil, tt.wantExists)
+			}
+		})
+	}
+}
+
+func TestSetTorrentLimitsRejectNegative(t *testing.T) {
+	ts := NewTorrentService(nil, &config.Config{}, nil)
+	hashes := []string{"c12fe1c06bba254a9dc9f519b335aa7c1367a88a"}
+
+	if err := ts.SetTorrentDownloadLimit(context.Background(), hashes, -1); err == nil {
+		t.Error("SetTorrentDownloadLimit(-1) error = nil, want an error")
+	}
+	if err := ts.SetTorrentUploadLimit(context.Background(), hashes, -1); err == nil {
+		t.Error("SetTorrentUploadLimit(-1) error = nil, want an error")
+	}
+}
@@ -0,0 +1,69 @@
+package core
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// TrackerHosts returns the lower-cased host names of a torrent's trackers, taken from
+// its working tracker and the trackers listed in its magnet URI
+func TrackerHosts(torrent qbittorrent.Torrent) []string {
+	seen := make(map[string]bool)
+	var hosts []string
+
+	addHost := func(rawURL string) {
+		parsedURL, err := url.Parse(strings.TrimSpace(rawURL))
+		if err != nil {
+			return
+		}
+		host := strings.ToLower(parsedURL.Hostname())
+		if host != "" && !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+
+	if torrent.Tracker != "" {
+		addHost(torrent.Tracker)
+	}
+
+	if magnet, err := url.Parse(torrent.MagnetURI); err == nil {
+		for _, tracker := range magnet.Query()["tr"] {
+			addHost(tracker)
+		}
+	}
+
+	return hosts
+}
+
+// IsPrivateTrackerHost returns true if host is, or is a subdomain of, one of the private tracker hosts
+func IsPrivateTrackerHost(host string, privateTrackers []string) bool {
+	host = strings.ToLower(host)
+	for _, private := range privateTrackers {
+		private = strings.ToLower(strings.TrimSpace(private))
+		if private == "" {
+			continue
+		}
+		if host == private || strings.HasSuffix(host, "."+private) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPublicTorrent returns true if the torrent has known trackers and none of them is private.
+// Torrents without any known tracker are not considered public.
+func IsPublicTorrent(torrent qbittorrent.Torrent, privateTrackers []string) bool {
+	hosts := TrackerHosts(torrent)
+	if len(hosts) == 0 {
+		return false
+	}
+	for _, host := range hosts {
+		if IsPrivateTrackerHost(host, privateTrackers) {
+			return false
+		}
+	}
+	return true
+}
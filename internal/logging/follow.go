@@ -0,0 +1,168 @@
+package logging
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// DefaultFollowInterval is how often Follow checks the log file for new lines
+const DefaultFollowInterval = 500 * time.Millisecond
+
+// Follow prints the entries of the log file at path matching opts and then
+// keeps watching the file, calling handle for every new matching entry until
+// the context is cancelled. opts.Tail limits only the entries already in the
+// file. A missing file is waited for, and rotation (the file being renamed and
+// recreated) or truncation is detected by polling every interval.
+func Follow(ctx context.Context, path string, opts ReadOptions, interval time.Duration, handle func(LogEntry)) error {
+	if path == "" {
+		return fmt.Errorf("no log file configured")
+	}
+	if interval <= 0 {
+		interval = DefaultFollowInterval
+	}
+
+	f := &follower{path: path, opts: opts, level: NormalizeLevel(opts.Level), handle: handle}
+	defer f.close()
+
+	// Show the existing entries first, limited to the tail
+	if err := f.open(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if f.file != nil {
+		var backlog []LogEntry
+		if err := f.readLines(func(entry LogEntry) { backlog = append(backlog, entry) }); err != nil {
+			return err
+		}
+		if opts.Tail > 0 && len(backlog) > opts.Tail {
+			backlog = backlog[len(backlog)-opts.Tail:]
+		}
+		for _, entry := range backlog {
+			handle(entry)
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := f.poll(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// follower tracks the open log file and the unfinished line read from it
+type follower struct {
+	path    string
+	opts    ReadOptions
+	level   string
+	handle  func(LogEntry)
+	file    *os.File
+	reader  *bufio.Reader
+	offset  int64
+	partial string
+}
+
+// open opens the log file from the beginning
+func (f *follower) open() error {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	f.close()
+	f.file = file
+	f.reader = bufio.NewReader(file)
+	f.offset = 0
+	f.partial = ""
+	return nil
+}
+
+// close closes the current log file, if any
+func (f *follower) close() {
+	if f.file != nil {
+		f.file.Close()
+		f.file = nil
+	}
+}
+
+// poll reads new lines and switches to a new file after rotation or truncation
+func (f *follower) poll() error {
+	if f.file == nil {
+		if err := f.open(); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		return f.readLines(f.handle)
+	}
+
+	current, statErr := os.Stat(f.path)
+	opened, err := f.file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+
+	switch {
+	case statErr != nil || !os.SameFile(current, opened):
+		// Rotated: finish the old file, then pick up the new one once it exists
+		if err := f.readLines(f.handle); err != nil {
+			return err
+		}
+		f.flushPartial()
+		f.close()
+		if statErr == nil {
+			return f.poll()
+		}
+		return nil
+	case current.Size() < f.offset:
+		// Truncated in place: start again from the beginning
+		if err := f.open(); err != nil {
+			return err
+		}
+	}
+
+	return f.readLines(f.handle)
+}
+
+// readLines reads all complete lines added since the last read. A trailing
+// line without a newline is kept until the rest of it is written.
+func (f *follower) readLines(handle func(LogEntry)) error {
+	for {
+		chunk, err := f.reader.ReadString('\n')
+		f.offset += int64(len(chunk))
+
+		if err != nil {
+			f.partial += chunk
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("failed to read log file: %w", err)
+		}
+
+		line := f.partial + strings.TrimRight(chunk, "\r\n")
+		f.partial = ""
+		if entry, ok := ParseLine(line); ok && f.opts.matches(entry, f.level) {
+			handle(entry)
+		}
+	}
+}
+
+// flushPartial emits the unfinished last line of a file that will not grow anymore
+func (f *follower) flushPartial() {
+	if entry, ok := ParseLine(f.partial); ok && f.opts.matches(entry, f.level) {
+		f.handle(entry)
+	}
+	f.partial = ""
+}
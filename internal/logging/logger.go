@@ -25,6 +25,7 @@ const (
 	ComponentConfig      Component = "config"
 	ComponentCore        Component = "core"
 	ComponentMain        Component = "main"
+	ComponentServer      Component = "server"
 )
 
 // MultiFormatter formats logs differently for stdout vs file output
@@ -42,8 +43,9 @@ func (f *MultiFormatter) Format(entry *logrus.Entry) ([]byte, error) {
 // Logger wraps logrus.Logger with additional functionality
 type Logger struct {
 	*logrus.Logger
-	config    *config.LoggingConfig
-	component Component
+	config     *config.LoggingConfig
+	component  Component
+	fileWriter io.Writer // Rotating log file, nil when LOG_FILE is unset
 }
 
 // loggerInstance holds the global logger instance
@@ -69,6 +71,7 @@ func Initialize(cfg *config.LoggingConfig) (*Logger, error) {
 	}
 
 	// Add file writer with rotation
+	var fileWriter io.Writer
 	if cfg.File != "" {
 		// Ensure log directory exists
 		logDir := filepath.Dir(cfg.File)
@@ -78,7 +81,7 @@ func Initialize(cfg *config.LoggingConfig) (*Logger, error) {
 			}
 		}
 
-		fileWriter := &lumberjack.Logger{
+		fileWriter = &lumberjack.Logger{
 			Filename:   cfg.File,
 			MaxSize:    cfg.MaxSize,    // megabytes
 			MaxBackups: cfg.MaxBackups, // number of backup files
@@ -125,9 +128,10 @@ func Initialize(cfg *config.LoggingConfig) (*Logger, error) {
 
 	// Create wrapper logger
 	appLogger := &Logger{
-		Logger:    logger,
-		config:    cfg,
-		component: ComponentMain,
+		Logger:     logger,
+		config:     cfg,
+		component:  ComponentMain,
+		fileWriter: fileWriter,
 	}
 
 	// Set global instance
@@ -163,12 +167,29 @@ func GetLogger() *Logger {
 // WithComponent creates a new logger instance with a specific component context
 func (l *Logger) WithComponent(component Component) *Logger {
 	return &Logger{
-		Logger:    l.Logger,
-		config:    l.config,
-		component: component,
+		Logger:     l.Logger,
+		config:     l.config,
+		component:  component,
+		fileWriter: l.fileWriter,
 	}
 }
 
+// ConsoleToStderr moves the console log output from stdout to stderr, so that
+// commands printing JSON or CSV keep stdout machine-readable. The log file, if
+// any, is still written.
+func (l *Logger) ConsoleToStderr() {
+	// Logging to the file only, nothing reaches stdout
+	if l.config != nil && !l.config.ToStdout && l.fileWriter != nil {
+		return
+	}
+
+	writers := []io.Writer{os.Stderr}
+	if l.fileWriter != nil {
+		writers = append(writers, l.fileWriter)
+	}
+	l.SetOutput(io.MultiWriter(writers...))
+}
+
 // WithField adds a field to the logger entry and ensures component is included
 func (l *Logger) WithField(key string, value interface{}) *logrus.Entry {
 	return l.Logger.WithFields(logrus.Fields{
@@ -288,6 +309,11 @@ func GetConfigLogger() *Logger {
 	return GetLogger().WithComponent(ComponentConfig)
 }
 
+// GetServerLogger returns a logger instance configured for HTTP server operations
+func GetServerLogger() *Logger {
+	return GetLogger().WithComponent(ComponentServer)
+}
+
 // GetCoreLogger returns a logger instance configured for core business logic operations
 func GetCoreLogger() *Logger {
 	return GetLogger().WithComponent(ComponentCore)
@@ -0,0 +1,290 @@
+package logging
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// maxLogLineSize bounds how long a single log line may be before reading fails
+const maxLogLineSize = 1024 * 1024
+
+// LogEntry is a single parsed log line
+type LogEntry struct {
+	Time      time.Time              `json:"time,omitempty"`
+	Level     string                 `json:"level"`
+	Component string                 `json:"component,omitempty"`
+	Message   string                 `json:"message"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+}
+
+// ReadOptions filters the entries returned by ReadEntries. Zero values disable a filter.
+type ReadOptions struct {
+	Level     string    // Only entries at this level ("warn" and "warning" are equivalent)
+	Component string    // Only entries from this component
+	Since     time.Time // Only entries logged at or after this time
+	Until     time.Time // Only entries logged before this time
+	Tail      int       // Only the last N matching entries
+	MaxBytes  int64     // Only read the last N bytes of the file, skipping the line cut off at the start
+}
+
+// textLinePattern matches logrus' colored text format, e.g. "INFO[2025-09-02 21:50:57] message  key=value"
+var textLinePattern = regexp.MustCompile(`^([A-Z]{4})\[([^\]]*)\]\s?(.*)$`)
+
+// textFieldPattern matches the start of a key=value field in text log lines
+var textFieldPattern = regexp.MustCompile(`\s+[A-Za-z_][A-Za-z0-9_.\-]*=`)
+
+// ansiPattern matches ANSI color escape sequences
+var ansiPattern = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// timeLayouts are the timestamp formats written by the configured formatters
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000Z07:00",
+	"2006-01-02 15:04:05",
+}
+
+// ReadEntries reads the log file at path and returns the entries matching opts
+// in chronological order. JSON lines and logrus text lines are both understood;
+// any other non-empty line becomes an entry with only a message.
+func ReadEntries(path string, opts ReadOptions) ([]LogEntry, error) {
+	if path == "" {
+		return nil, fmt.Errorf("no log file configured")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log file: %w", err)
+	}
+	defer file.Close()
+
+	// Start near the end of large files when only the last bytes are wanted
+	skipFirst := false
+	if opts.MaxBytes > 0 {
+		info, err := file.Stat()
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat log file: %w", err)
+		}
+		if info.Size() > opts.MaxBytes {
+			if _, err := file.Seek(info.Size()-opts.MaxBytes, io.SeekStart); err != nil {
+				return nil, fmt.Errorf("failed to seek log file: %w", err)
+			}
+			skipFirst = true
+		}
+	}
+
+	level := NormalizeLevel(opts.Level)
+
+	var entries []LogEntry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxLogLineSize)
+	for scanner.Scan() {
+		if skipFirst {
+			// The first line is most likely cut off by the seek
+			skipFirst = false
+			continue
+		}
+		entry, ok := ParseLine(scanner.Text())
+		if !ok || !opts.matches(entry, level) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read log file: %w", err)
+	}
+
+	if opts.Tail > 0 && len(entries) > opts.Tail {
+		entries = entries[len(entries)-opts.Tail:]
+	}
+
+	return entries, nil
+}
+
+// ParseLine parses a single log line. It returns false for blank lines.
+func ParseLine(line string) (LogEntry, bool) {
+	line = strings.TrimSpace(ansiPattern.ReplaceAllString(line, ""))
+	if line == "" {
+		return LogEntry{}, false
+	}
+
+	if strings.HasPrefix(line, "{") {
+		if entry, err := parseJSONLine(line); err == nil {
+			return entry, true
+		}
+	}
+
+	if entry, ok := parseTextLine(line); ok {
+		return entry, true
+	}
+
+	return LogEntry{Message: line}, true
+}
+
+// NormalizeLevel maps the level names and abbreviations used by logrus to a
+// single lowercase name, e.g. "WARN", "warn" and "warning" all become "warning"
+func NormalizeLevel(level string) string {
+	level = strings.ToLower(strings.TrimSpace(level))
+	switch {
+	case level == "" || level == "all":
+		return ""
+	case strings.HasPrefix(level, "warn"):
+		return "warning"
+	case strings.HasPrefix(level, "erro"):
+		return "error"
+	case strings.HasPrefix(level, "debu"):
+		return "debug"
+	case strings.HasPrefix(level, "trac"):
+		return "trace"
+	case strings.HasPrefix(level, "fata"):
+		return "fatal"
+	case strings.HasPrefix(level, "pani"):
+		return "panic"
+	}
+	return level
+}
+
+// matches reports whether entry passes the filters; level is the normalized level filter
+func (opts ReadOptions) matches(entry LogEntry, level string) bool {
+	if level != "" && entry.Level != level {
+		return false
+	}
+	if opts.Component != "" && !strings.EqualFold(entry.Component, opts.Component) {
+		return false
+	}
+	if !opts.Since.IsZero() && (entry.Time.IsZero() || entry.Time.Before(opts.Since)) {
+		return false
+	}
+	if !opts.Until.IsZero() && (entry.Time.IsZero() || !entry.Time.Before(opts.Until)) {
+		return false
+	}
+	return true
+}
+
+// parseJSONLine parses a line written by logrus' JSON formatter
+func parseJSONLine(line string) (LogEntry, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(line), &raw); err != nil {
+		return LogEntry{}, err
+	}
+
+	entry := LogEntry{Fields: make(map[string]interface{})}
+	for key, value := range raw {
+		switch key {
+		case "level":
+			entry.Level = NormalizeLevel(fmt.Sprint(value))
+		case "msg":
+			entry.Message = fmt.Sprint(value)
+		case "time":
+			entry.Time = parseTime(fmt.Sprint(value))
+		case "component":
+			entry.Component = fmt.Sprint(value)
+		default:
+			entry.Fields[key] = value
+		}
+	}
+
+	return entry, nil
+}
+
+// parseTextLine parses a line written by logrus' text formatter, either in
+// its colored "LEVL[time] msg key=value" form or as key=value pairs
+func parseTextLine(line string) (LogEntry, bool) {
+	if matches := textLinePattern.FindStringSubmatch(line); matches != nil {
+		entry := LogEntry{
+			Level: NormalizeLevel(matches[1]),
+			Time:  parseTime(matches[2]),
+		}
+
+		rest := matches[3]
+		if loc := textFieldPattern.FindStringIndex(rest); loc != nil {
+			entry.Fields = parseTextFields(rest[loc[0]:])
+			rest = rest[:loc[0]]
+		} else {
+			entry.Fields = make(map[string]interface{})
+		}
+		entry.Message = strings.TrimSpace(rest)
+		entry.takeTextFields()
+		return entry, true
+	}
+
+	if !strings.HasPrefix(line, "time=") && !strings.HasPrefix(line, "level=") {
+		return LogEntry{}, false
+	}
+
+	entry := LogEntry{Fields: parseTextFields(line)}
+	entry.takeTextFields()
+	return entry, true
+}
+
+// takeTextFields moves the well-known keys out of Fields into the entry
+func (e *LogEntry) takeTextFields() {
+	if value, ok := e.Fields["level"]; ok {
+		e.Level = NormalizeLevel(fmt.Sprint(value))
+		delete(e.Fields, "level")
+	}
+	if value, ok := e.Fields["msg"]; ok {
+		e.Message = fmt.Sprint(value)
+		delete(e.Fields, "msg")
+	}
+	if value, ok := e.Fields["time"]; ok {
+		e.Time = parseTime(fmt.Sprint(value))
+		delete(e.Fields, "time")
+	}
+	if value, ok := e.Fields["component"]; ok {
+		e.Component = fmt.Sprint(value)
+		delete(e.Fields, "component")
+	}
+}
+
+// parseTextFields parses space-separated key=value pairs, where values may be
+// double-quoted with backslash escapes
+func parseTextFields(s string) map[string]interface{} {
+	fields := make(map[string]interface{})
+
+	for {
+		s = strings.TrimLeft(s, " \t")
+		eq := strings.IndexByte(s, '=')
+		if s == "" || eq <= 0 {
+			return fields
+		}
+		key := s[:eq]
+		s = s[eq+1:]
+
+		var value string
+		if strings.HasPrefix(s, `"`) {
+			var b strings.Builder
+			i := 1
+			for ; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' && i+1 < len(s) {
+					i++
+				}
+				b.WriteByte(s[i])
+			}
+			value = b.String()
+			s = s[min(i+1, len(s)):]
+		} else if end := strings.IndexAny(s, " \t"); end >= 0 {
+			value, s = s[:end], s[end:]
+		} else {
+			value, s = s, ""
+		}
+
+		fields[key] = value
+	}
+}
+
+// parseTime parses a log timestamp, returning the zero time if it is not recognized
+func parseTime(value string) time.Time {
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,112 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+)
+
+// Tailer keeps the most recent entries of a log file in memory, reading only
+// the lines appended since the last Poll. Rotation and truncation are handled
+// the same way as in Follow.
+type Tailer struct {
+	follower *follower
+	maxBytes int64
+	started  bool
+
+	// entries is a ring buffer holding the newest entries, oldest at start
+	entries []LogEntry
+	start   int
+	size    int
+}
+
+// NewTailer creates a tailer for the log file at path that keeps up to
+// capacity entries. The first Poll only reads the last maxBytes of the file;
+// zero reads it all.
+func NewTailer(path string, capacity int, maxBytes int64) *Tailer {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	t := &Tailer{
+		maxBytes: maxBytes,
+		entries:  make([]LogEntry, capacity),
+	}
+	t.follower = &follower{path: path, handle: t.add}
+	return t
+}
+
+// Poll reads the entries written since the last call. Until the file has been
+// opened once, a missing file is returned as an error.
+func (t *Tailer) Poll() error {
+	if t.follower.path == "" {
+		return fmt.Errorf("no log file configured")
+	}
+
+	if !t.started {
+		if err := t.follower.open(); err != nil {
+			return err
+		}
+		t.started = true
+		if err := t.follower.seekTail(t.maxBytes); err != nil {
+			return err
+		}
+		return t.follower.readLines(t.add)
+	}
+
+	return t.follower.poll()
+}
+
+// Entries returns the buffered entries in chronological order
+func (t *Tailer) Entries() []LogEntry {
+	entries := make([]LogEntry, 0, t.size)
+	for i := 0; i < t.size; i++ {
+		entries = append(entries, t.entries[(t.start+i)%len(t.entries)])
+	}
+	return entries
+}
+
+// Close closes the log file
+func (t *Tailer) Close() {
+	t.follower.close()
+}
+
+// add appends an entry, dropping the oldest one when the buffer is full
+func (t *Tailer) add(entry LogEntry) {
+	if t.size < len(t.entries) {
+		t.entries[(t.start+t.size)%len(t.entries)] = entry
+		t.size++
+		return
+	}
+	t.entries[t.start] = entry
+	t.start = (t.start + 1) % len(t.entries)
+}
+
+// seekTail moves to the first complete line within the last n bytes of the
+// file. It does nothing when n is zero or the file is smaller than n.
+func (f *follower) seekTail(n int64) error {
+	if n <= 0 {
+		return nil
+	}
+
+	info, err := f.file.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+	if info.Size() <= n {
+		return nil
+	}
+
+	offset, err := f.file.Seek(info.Size()-n, io.SeekStart)
+	if err != nil {
+		return fmt.Errorf("failed to seek log file: %w", err)
+	}
+	f.reader.Reset(f.file)
+	f.offset = offset
+
+	// Drop the line cut off by the seek
+	skipped, err := f.reader.ReadString('\n')
+	f.offset += int64(len(skipped))
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("failed to read log file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,278 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/logging"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+const (
+	// scrapeTimeout bounds how long collecting the metrics of a single scrape may take
+	scrapeTimeout = 10 * time.Second
+
+	// contentType is the Prometheus text exposition format
+	contentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// Server exposes Prometheus metrics at /metrics. Gauges are collected from the
+// services on every scrape; request counters come from the qBittorrent client.
+type Server struct {
+	addr           string
+	qbClient       *qbittorrent.Client
+	torrentService *core.TorrentService
+	diskService    *core.DiskService
+	seedingService *core.SeedingService
+	httpServer     *http.Server
+	logger         *logging.Logger
+}
+
+// NewServer creates a metrics server listening on addr
+func NewServer(addr string, qbClient *qbittorrent.Client, torrentService *core.TorrentService,
+	diskService *core.DiskService, seedingService *core.SeedingService) *Server {
+
+	s := &Server{
+		addr:           addr,
+		qbClient:       qbClient,
+		torrentService: torrentService,
+		diskService:    diskService,
+		seedingService: seedingService,
+		logger:         logging.GetServerLogger(),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", s.handleMetrics)
+	s.httpServer = &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return s
+}
+
+// Start listens on the configured address and serves requests in the
+// background until Stop is called
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.addr, err)
+	}
+
+	go func() {
+		if err := s.httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.WithError(err).Error("Metrics server failed")
+		}
+	}()
+
+	s.logger.WithField("addr", s.addr).Info("Metrics server listening")
+	return nil
+}
+
+// Stop shuts the server down, waiting for running scrapes until the context is done
+func (s *Server) Stop(ctx context.Context) error {
+	if err := s.httpServer.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to shut down metrics server: %w", err)
+	}
+	return nil
+}
+
+// handleMetrics writes all metrics in the Prometheus text format. A source
+// that can't be read is skipped and reported through akira_scrape_success.
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), scrapeTimeout)
+	defer cancel()
+
+	m := &metricWriter{}
+	success := map[string]bool{
+		"torrents": s.writeTorrentMetrics(ctx, m),
+		"transfer": s.writeTransferMetrics(ctx, m),
+		"disk":     s.writeDiskMetrics(ctx, m),
+		"seeding":  s.writeSeedingMetrics(ctx, m),
+	}
+	s.writeRequestMetrics(m)
+
+	m.header("akira_scrape_success", "gauge", "Whether the source could be read during this scrape")
+	for _, source := range sortedKeys(success) {
+		m.sample("akira_scrape_success", boolValue(success[source]), "source", source)
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Write(m.buf.Bytes())
+}
+
+// writeTorrentMetrics writes the torrent counts by state and category
+func (s *Server) writeTorrentMetrics(ctx context.Context, m *metricWriter) bool {
+	torrents, err := s.torrentService.GetTorrents(ctx, nil)
+	if err != nil {
+		s.logger.WithError(err).Warn("Failed to collect torrent metrics")
+		return false
+	}
+
+	states := make(map[string]int)
+	categories := make(map[string]int)
+	for _, torrent := range torrents {
+		states[string(torrent.State)]++
+		categories[torrent.Category]++
+	}
+
+	m.header("akira_torrents", "gauge", "Number of torrents by qBittorrent state")
+	for _, state := range sortedKeys(states) {
+		m.sample("akira_torrents", float64(states[state]), "state", state)
+	}
+
+	m.header("akira_category_torrents", "gauge", "Number of torrents by category")
+	for _, category := range sortedKeys(categories) {
+		m.sample("akira_category_torrents", float64(categories[category]), "category", category)
+	}
+	return true
+}
+
+// writeTransferMetrics writes the global transfer speeds
+func (s *Server) writeTransferMetrics(ctx context.Context, m *metricWriter) bool {
+	state, err := s.qbClient.GetServerState(ctx)
+	if err != nil {
+		s.logger.WithError(err).Warn("Failed to collect transfer metrics")
+		return false
+	}
+
+	m.header("akira_download_speed_bytes", "gauge", "Global download speed in bytes per second")
+	m.sample("akira_download_speed_bytes", float64(state.DlInfoSpeed))
+	m.header("akira_upload_speed_bytes", "gauge", "Global upload speed in bytes per second")
+	m.sample("akira_upload_speed_bytes", float64(state.UpInfoSpeed))
+	return true
+}
+
+// writeDiskMetrics writes the free and total space of every configured path
+func (s *Server) writeDiskMetrics(ctx context.Context, m *metricWriter) bool {
+	summary, err := s.diskService.GetAllDiskSpaces(ctx)
+	if err != nil {
+		s.logger.WithError(err).Warn("Failed to collect disk metrics")
+		return false
+	}
+
+	paths := sortedKeys(summary.Paths)
+	m.header("akira_disk_free_bytes", "gauge", "Free disk space in bytes by configured path")
+	for _, path := range paths {
+		m.sample("akira_disk_free_bytes", float64(summary.Paths[path].Free), "path", path)
+	}
+	m.header("akira_disk_total_bytes", "gauge", "Total disk space in bytes by configured path")
+	for _, path := range paths {
+		m.sample("akira_disk_total_bytes", float64(summary.Paths[path].Total), "path", path)
+	}
+	return true
+}
+
+// writeSeedingMetrics writes the tracked and overdue seeding counts
+func (s *Server) writeSeedingMetrics(ctx context.Context, m *metricWriter) bool {
+	status, err := s.seedingService.GetSeedingStatus(ctx)
+	if err != nil {
+		s.logger.WithError(err).Warn("Failed to collect seeding metrics")
+		return false
+	}
+
+	m.header("akira_seeding_tracked_torrents", "gauge", "Number of torrents tracked for seeding limits")
+	m.sample("akira_seeding_tracked_torrents", float64(status.TrackedTorrents))
+	m.header("akira_seeding_overdue_torrents", "gauge", "Number of tracked torrents past their seeding time limit")
+	m.sample("akira_seeding_overdue_torrents", float64(status.OverdueSeeding))
+	return true
+}
+
+// writeRequestMetrics writes the qBittorrent connection state and the request
+// counters and latency histogram per endpoint
+func (s *Server) writeRequestMetrics(m *metricWriter) {
+	m.header("akira_qbittorrent_up", "gauge", "Whether the last request reached qBittorrent")
+	m.sample("akira_qbittorrent_up", boolValue(s.qbClient.Health().Connected))
+
+	stats := s.qbClient.RequestStats()
+
+	m.header("akira_qbittorrent_requests_total", "counter", "qBittorrent API requests by endpoint")
+	for _, stat := range stats {
+		m.sample("akira_qbittorrent_requests_total", float64(stat.Requests), "endpoint", stat.Endpoint)
+	}
+
+	m.header("akira_qbittorrent_request_errors_total", "counter", "Failed qBittorrent API requests by endpoint")
+	for _, stat := range stats {
+		m.sample("akira_qbittorrent_request_errors_total", float64(stat.Errors), "endpoint", stat.Endpoint)
+	}
+
+	m.header("akira_qbittorrent_request_duration_seconds", "histogram", "qBittorrent API request latency by endpoint")
+	for _, stat := range stats {
+		for i, bound := range qbittorrent.RequestLatencyBuckets {
+			m.sample("akira_qbittorrent_request_duration_seconds_bucket", float64(stat.BucketCounts[i]),
+				"endpoint", stat.Endpoint, "le", formatValue(bound))
+		}
+		m.sample("akira_qbittorrent_request_duration_seconds_bucket", float64(stat.Requests), "endpoint", stat.Endpoint, "le", "+Inf")
+		m.sample("akira_qbittorrent_request_duration_seconds_sum", stat.DurationSeconds, "endpoint", stat.Endpoint)
+		m.sample("akira_qbittorrent_request_duration_seconds_count", float64(stat.Requests), "endpoint", stat.Endpoint)
+	}
+}
+
+// metricWriter builds a response in the Prometheus text exposition format
+type metricWriter struct {
+	buf bytes.Buffer
+}
+
+// header writes the HELP and TYPE lines of a metric
+func (m *metricWriter) header(name, metricType, help string) {
+	fmt.Fprintf(&m.buf, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+// sample writes a single sample; labels are alternating names and values
+func (m *metricWriter) sample(name string, value float64, labels ...string) {
+	m.buf.WriteString(name)
+	if len(labels) > 0 {
+		m.buf.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				m.buf.WriteByte(',')
+			}
+			fmt.Fprintf(&m.buf, `%s="%s"`, labels[i], labelEscaper.Replace(labels[i+1]))
+		}
+		m.buf.WriteByte('}')
+	}
+	m.buf.WriteByte(' ')
+	m.buf.WriteString(formatValue(value))
+	m.buf.WriteByte('\n')
+}
+
+// labelEscaper escapes label values as required by the text format
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// formatValue formats a sample value or bucket bound
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// boolValue returns 1 for true and 0 for false
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// sortedKeys returns the keys of a map in order, so metrics are written in a stable order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
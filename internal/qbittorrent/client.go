@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -12,6 +13,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/raainshe/akira/internal/logging"
@@ -26,6 +28,25 @@ type Client struct {
 	cookieJar  http.CookieJar
 	timeout    time.Duration
 	logger     *logging.Logger
+
+	// Optional file the session cookie is cached in between invocations
+	sessionFile string
+
+	// Retries for requests that cannot reach qBittorrent
+	maxRetries int
+	retryDelay time.Duration
+
+	healthMutex sync.Mutex
+	health      ConnectionHealth
+
+	// Versions cached after the first fetch
+	versionMutex sync.Mutex
+	appVersion   string
+	apiVersion   string
+
+	// Request counts and latencies per endpoint, see RequestStats
+	statsMutex   sync.Mutex
+	requestStats map[string]*RequestStats
 }
 
 // ClientOption represents a configuration option for the qBittorrent client
@@ -48,17 +69,19 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 
 // NewClient creates a new qBittorrent API client
 func NewClient(baseURL, username, password string, options ...ClientOption) (*Client, error) {
-	parsedURL, err := url.Parse(baseURL)
+	parsedURL, err := NormalizeBaseURL(baseURL)
 	if err != nil {
-		return nil, fmt.Errorf("invalid base URL: %w", err)
+		return nil, err
 	}
 
 	client := &Client{
-		baseURL:  parsedURL,
-		username: username,
-		password: password,
-		timeout:  30 * time.Second,
-		logger:   logging.GetQBittorrentLogger(),
+		baseURL:    parsedURL,
+		username:   username,
+		password:   password,
+		timeout:    30 * time.Second,
+		logger:     logging.GetQBittorrentLogger(),
+		maxRetries: DefaultMaxRetries,
+		retryDelay: DefaultRetryDelay,
 	}
 
 	// Create HTTP client with cookie jar for session management
@@ -79,7 +102,7 @@ func NewClient(baseURL, username, password string, options ...ClientOption) (*Cl
 	}
 
 	client.logger.WithFields(map[string]interface{}{
-		"base_url": baseURL,
+		"base_url": parsedURL.String(),
 		"username": username,
 		"timeout":  client.timeout,
 	}).Info("qBittorrent client created")
@@ -87,66 +110,158 @@ func NewClient(baseURL, username, password string, options ...ClientOption) (*Cl
 	return client, nil
 }
 
-// makeRequest performs an HTTP request with error handling and retries
+// NormalizeBaseURL validates a qBittorrent WebUI URL and returns it in canonical form.
+// A missing scheme defaults to http:// and trailing slashes are removed, so that
+// "localhost:8080/" becomes "http://localhost:8080".
+func NormalizeBaseURL(rawURL string) (*url.URL, error) {
+	trimmed := strings.TrimSpace(rawURL)
+	if trimmed == "" {
+		return nil, fmt.Errorf("invalid qBittorrent URL: URL is empty")
+	}
+
+	if !strings.Contains(trimmed, "://") {
+		trimmed = "http://" + trimmed
+	}
+
+	parsedURL, err := url.Parse(trimmed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid qBittorrent URL '%s': %w", rawURL, err)
+	}
+
+	parsedURL.Scheme = strings.ToLower(parsedURL.Scheme)
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return nil, fmt.Errorf("invalid qBittorrent URL '%s': scheme must be http or https, got '%s'", rawURL, parsedURL.Scheme)
+	}
+	if parsedURL.Hostname() == "" {
+		return nil, fmt.Errorf("invalid qBittorrent URL '%s': missing host", rawURL)
+	}
+	if parsedURL.RawQuery != "" || parsedURL.Fragment != "" {
+		return nil, fmt.Errorf("invalid qBittorrent URL '%s': query strings and fragments are not supported", rawURL)
+	}
+
+	parsedURL.Path = strings.TrimRight(parsedURL.Path, "/")
+	parsedURL.RawPath = ""
+
+	return parsedURL, nil
+}
+
+// endpointURL returns the full URL of an API endpoint. The endpoint is appended to the
+// base URL's path so that qBittorrent behind a reverse proxy sub-path works. A query
+// string in the endpoint, e.g. "/api/v2/sync/maindata?rid=0", is kept as the query
+// rather than escaped into the path.
+func (c *Client) endpointURL(endpoint string) *url.URL {
+	path, query, _ := strings.Cut(endpoint, "?")
+
+	endpointURL := *c.baseURL
+	endpointURL.Path = c.baseURL.Path + path
+	endpointURL.RawQuery = query
+	return &endpointURL
+}
+
+// makeRequest performs an API request. When qBittorrent answers 403 because the
+// session expired, it logs in again and retries the request once.
 func (c *Client) makeRequest(ctx context.Context, method, endpoint string, data interface{}, result interface{}) error {
-	reqURL := c.baseURL.ResolveReference(&url.URL{Path: endpoint})
+	start := time.Now()
+	err := c.doRequest(ctx, method, endpoint, data, result)
+	c.recordRequest(endpoint, time.Since(start), err)
 
-	var body io.Reader
-	var contentType string
+	var apiErr *APIError
+	if strings.HasPrefix(endpoint, authEndpointPrefix) || !errors.As(err, &apiErr) || apiErr.Code != http.StatusForbidden {
+		return err
+	}
 
-	// Prepare request body based on data type
+	c.logger.WithField("endpoint", endpoint).Info("qBittorrent session expired, logging in again")
+	if err := c.Login(ctx); err != nil {
+		return err
+	}
+	start = time.Now()
+	err = c.doRequest(ctx, method, endpoint, data, result)
+	c.recordRequest(endpoint, time.Since(start), err)
+	return err
+}
+
+// multipartForm is a request body encoded as multipart/form-data, for endpoints
+// such as /api/v2/torrents/add that expect it instead of a URL-encoded form
+type multipartForm struct {
+	body        []byte
+	contentType string // Includes the boundary
+}
+
+// authEndpointPrefix is the prefix of the login and logout endpoints, which are never retried after a 403
+const authEndpointPrefix = "/api/v2/auth/"
+
+// doRequest performs an HTTP request with error handling and retries
+func (c *Client) doRequest(ctx context.Context, method, endpoint string, data interface{}, result interface{}) error {
+	reqURL := c.endpointURL(endpoint)
+
+	// Prepare request body based on data type. It is kept as bytes so that
+	// retries can send it again.
+	var bodyBytes []byte
+	var contentType string
 	if data != nil {
 		switch v := data.(type) {
 		case url.Values:
-			body = strings.NewReader(v.Encode())
+			bodyBytes = []byte(v.Encode())
 			contentType = "application/x-www-form-urlencoded"
-		case *bytes.Buffer:
-			body = v
-			contentType = "multipart/form-data"
+		case *multipartForm:
+			bodyBytes = v.body
+			contentType = v.contentType
 		default:
 			jsonData, err := json.Marshal(data)
 			if err != nil {
 				return fmt.Errorf("failed to marshal request data: %w", err)
 			}
-			body = bytes.NewReader(jsonData)
+			bodyBytes = jsonData
 			contentType = "application/json"
 		}
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), body)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-
-	if contentType != "" {
-		req.Header.Set("Content-Type", contentType)
-	}
-
 	c.logger.WithFields(map[string]interface{}{
 		"method":   method,
 		"endpoint": endpoint,
 		"url":      reqURL.String(),
 	}).Debug("Making API request")
 
-	// Perform request with retries
+	// Perform request with retries, backing off exponentially between attempts
 	var resp *http.Response
-	maxRetries := 3
+	maxRetries := max(c.maxRetries, 1)
 	for attempt := 1; attempt <= maxRetries; attempt++ {
-		resp, err = c.httpClient.Do(req)
+		var body io.Reader
+		if data != nil {
+			body = bytes.NewReader(bodyBytes)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), body)
 		if err != nil {
-			if attempt == maxRetries {
-				return fmt.Errorf("request failed after %d attempts: %w", maxRetries, err)
-			}
-			c.logger.WithFields(map[string]interface{}{
-				"attempt": attempt,
-				"error":   err,
-			}).Warn("Request attempt failed, retrying")
-			time.Sleep(time.Duration(attempt) * time.Second)
-			continue
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+
+		resp, err = c.httpClient.Do(req)
+		if err == nil {
+			break
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("request cancelled: %w", ctx.Err())
+		}
+		if attempt == maxRetries {
+			c.recordFailure(err)
+			return fmt.Errorf("%w: request failed after %d attempts: %w", ErrConnection, maxRetries, err)
+		}
+
+		delay := c.backoff(attempt)
+		c.logger.WithFields(map[string]interface{}{
+			"attempt": attempt,
+			"delay":   delay,
+			"error":   err,
+		}).Warn("Request attempt failed, retrying")
+		if err := sleepContext(ctx, delay); err != nil {
+			return fmt.Errorf("request cancelled: %w", err)
 		}
-		break
 	}
 	defer resp.Body.Close()
+	c.recordSuccess()
 
 	// Read response body
 	respBody, err := io.ReadAll(resp.Body)
@@ -169,6 +284,12 @@ func (c *Client) makeRequest(ctx context.Context, method, endpoint string, data
 		return apiErr
 	}
 
+	// A *[]byte result receives the raw body, e.g. for .torrent file downloads
+	if raw, ok := result.(*[]byte); ok {
+		*raw = respBody
+		return nil
+	}
+
 	// Parse response if result is provided
 	if result != nil && len(respBody) > 0 {
 		if err := json.Unmarshal(respBody, result); err != nil {
@@ -194,6 +315,7 @@ func (c *Client) Login(ctx context.Context) error {
 	}
 
 	c.logger.Info("Authentication successful")
+	c.saveSession()
 	return nil
 }
 
@@ -206,6 +328,7 @@ func (c *Client) Logout(ctx context.Context) error {
 		c.logger.WithError(err).Warn("Logout request failed")
 		// Don't return error as logout might fail if not logged in
 	}
+	c.clearSession()
 
 	c.logger.Info("Logout completed")
 	return nil
@@ -213,19 +336,31 @@ func (c *Client) Logout(ctx context.Context) error {
 
 // IsAuthenticated checks if the client is currently authenticated
 func (c *Client) IsAuthenticated(ctx context.Context) bool {
-	// Try to make a simple authenticated request
-	err := c.makeRequest(ctx, "GET", "/api/v2/app/version", nil, nil)
+	// Try to make a simple authenticated request, without logging in again on 403
+	err := c.doRequest(ctx, "GET", "/api/v2/app/version", nil, nil)
 	return err == nil
 }
 
-// ensureAuthenticated ensures the client is authenticated before making API calls
+// ensureAuthenticated logs in before making API calls when there is no session
+// yet. An expired session is renewed by makeRequest when qBittorrent answers 403,
+// so no extra request is made to check it.
 func (c *Client) ensureAuthenticated(ctx context.Context) error {
-	if c.IsAuthenticated(ctx) {
+	if c.hasSession() {
 		return nil
 	}
 	return c.Login(ctx)
 }
 
+// hasSession reports whether the cookie jar holds a session cookie. The cookie's
+// name depends on the qBittorrent version (SID, or QBT_SID_<port>), so any cookie
+// counts. Without a cookie jar there is nothing to check and requests are just attempted.
+func (c *Client) hasSession() bool {
+	if c.httpClient.Jar == nil {
+		return true
+	}
+	return len(c.httpClient.Jar.Cookies(c.endpointURL("/api/v2/"))) > 0
+}
+
 // GetTorrents retrieves all torrents from qBittorrent
 func (c *Client) GetTorrents(ctx context.Context) ([]Torrent, error) {
 	if err := c.ensureAuthenticated(ctx); err != nil {
@@ -267,6 +402,183 @@ func (c *Client) GetTorrentProperties(ctx context.Context, hash string) (*Torren
 	return &properties, nil
 }
 
+// GetTorrentTrackers retrieves the trackers of a torrent, including the DHT, PeX and LSD pseudo-trackers
+func (c *Client) GetTorrentTrackers(ctx context.Context, hash string) ([]TorrentTracker, error) {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	c.logger.WithField("hash", hash).Debug("Fetching torrent trackers")
+
+	data := url.Values{}
+	data.Set("hash", hash)
+
+	var trackers []TorrentTracker
+	err := c.makeRequest(ctx, "GET", "/api/v2/torrents/trackers?"+data.Encode(), nil, &trackers)
+	if err != nil {
+		c.logger.WithError(err).WithField("hash", hash).Error("Failed to fetch torrent trackers")
+		return nil, fmt.Errorf("failed to fetch torrent trackers: %w", err)
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hash":  hash,
+		"count": len(trackers),
+	}).Debug("Torrent trackers fetched successfully")
+	return trackers, nil
+}
+
+// ExportTorrent downloads the .torrent file of a torrent. qBittorrent can only
+// export torrents whose metadata has been downloaded.
+func (c *Client) ExportTorrent(ctx context.Context, hash string) ([]byte, error) {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	c.logger.WithField("hash", hash).Info("Exporting torrent")
+
+	data := url.Values{}
+	data.Set("hash", hash)
+
+	var torrentFile []byte
+	err := c.makeRequest(ctx, "GET", "/api/v2/torrents/export?"+data.Encode(), nil, &torrentFile)
+	if err != nil {
+		c.logger.WithError(err).WithField("hash", hash).Error("Failed to export torrent")
+		return nil, fmt.Errorf("failed to export torrent: %w", err)
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hash": hash,
+		"size": len(torrentFile),
+	}).Info("Torrent exported successfully")
+	return torrentFile, nil
+}
+
+// GetTorrentFiles retrieves the files of a torrent. The list is empty until the torrent's metadata is available.
+func (c *Client) GetTorrentFiles(ctx context.Context, hash string) ([]TorrentFile, error) {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	c.logger.WithField("hash", hash).Debug("Fetching torrent files")
+
+	data := url.Values{}
+	data.Set("hash", hash)
+
+	var files []TorrentFile
+	err := c.makeRequest(ctx, "GET", "/api/v2/torrents/files?"+data.Encode(), nil, &files)
+	if err != nil {
+		c.logger.WithError(err).WithField("hash", hash).Error("Failed to fetch torrent files")
+		return nil, fmt.Errorf("failed to fetch torrent files: %w", err)
+	}
+
+	// qBittorrent versions before 4.1.5 don't report the index, which is the file's position
+	for i := range files {
+		if files[i].Index == 0 {
+			files[i].Index = i
+		}
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hash":  hash,
+		"count": len(files),
+	}).Debug("Torrent files fetched successfully")
+	return files, nil
+}
+
+// SetFilePriority sets the priority of files in a torrent, identified by their index
+func (c *Client) SetFilePriority(ctx context.Context, hash string, fileIndexes []int, priority int) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hash":     hash,
+		"count":    len(fileIndexes),
+		"priority": priority,
+	}).Info("Setting file priority")
+
+	ids := make([]string, len(fileIndexes))
+	for i, index := range fileIndexes {
+		ids[i] = strconv.Itoa(index)
+	}
+
+	data := url.Values{}
+	data.Set("hash", hash)
+	data.Set("id", strings.Join(ids, "|"))
+	data.Set("priority", strconv.Itoa(priority))
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/filePrio", data, nil)
+	if err != nil {
+		c.logger.WithError(err).WithField("hash", hash).Error("Failed to set file priority")
+		return fmt.Errorf("failed to set file priority: %w", err)
+	}
+
+	c.logger.WithField("count", len(fileIndexes)).Info("File priority set successfully")
+	return nil
+}
+
+// RenameTorrent changes the display name of a torrent in qBittorrent
+func (c *Client) RenameTorrent(ctx context.Context, hash, newName string) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hash": hash,
+		"name": newName,
+	}).Info("Renaming torrent")
+
+	data := url.Values{}
+	data.Set("hash", hash)
+	data.Set("name", newName)
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/rename", data, nil)
+	if err != nil {
+		c.logger.WithError(err).WithField("hash", hash).Error("Failed to rename torrent")
+		return fmt.Errorf("failed to rename torrent: %w", err)
+	}
+
+	c.logger.WithField("hash", hash).Info("Torrent renamed successfully")
+	return nil
+}
+
+// RenameFile renames or moves a file inside a torrent; paths are relative to the torrent's root
+func (c *Client) RenameFile(ctx context.Context, hash, oldPath, newPath string) error {
+	return c.renamePath(ctx, "/api/v2/torrents/renameFile", "file", hash, oldPath, newPath)
+}
+
+// RenameFolder renames or moves a folder inside a torrent; paths are relative to the torrent's root
+func (c *Client) RenameFolder(ctx context.Context, hash, oldPath, newPath string) error {
+	return c.renamePath(ctx, "/api/v2/torrents/renameFolder", "folder", hash, oldPath, newPath)
+}
+
+// renamePath calls one of the file or folder rename endpoints, which share their parameters
+func (c *Client) renamePath(ctx context.Context, endpoint, kind, hash, oldPath, newPath string) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hash":     hash,
+		"old_path": oldPath,
+		"new_path": newPath,
+	}).Infof("Renaming torrent %s", kind)
+
+	data := url.Values{}
+	data.Set("hash", hash)
+	data.Set("oldPath", oldPath)
+	data.Set("newPath", newPath)
+
+	err := c.makeRequest(ctx, "POST", endpoint, data, nil)
+	if err != nil {
+		c.logger.WithError(err).WithField("hash", hash).Errorf("Failed to rename torrent %s", kind)
+		return fmt.Errorf("failed to rename %s: %w", kind, err)
+	}
+
+	c.logger.WithField("hash", hash).Infof("Torrent %s renamed successfully", kind)
+	return nil
+}
+
 // AddMagnet adds a magnet link to qBittorrent
 func (c *Client) AddMagnet(ctx context.Context, magnetURI string, options AddTorrentRequest) error {
 	if err := c.ensureAuthenticated(ctx); err != nil {
@@ -307,6 +619,7 @@ func (c *Client) AddMagnet(ctx context.Context, magnetURI string, options AddTor
 	}
 	if options.Paused {
 		writer.WriteField("paused", "true")
+		writer.WriteField("stopped", "true") // qBittorrent 5.x name for the same option
 	}
 	if options.RootFolder {
 		writer.WriteField("root_folder", "true")
@@ -337,58 +650,41 @@ func (c *Client) AddMagnet(ctx context.Context, magnetURI string, options AddTor
 	}
 
 	writer.Close()
+	form := &multipartForm{body: buf.Bytes(), contentType: writer.FormDataContentType()}
 
-	// Set content type for multipart form
-	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL.ResolveReference(&url.URL{Path: "/api/v2/torrents/add"}).String(), &buf)
-	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
-	}
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
+	// Sent through makeRequest for the session renewal, retries and metrics
+	var respBody []byte
+	if err := c.makeRequest(ctx, "POST", "/api/v2/torrents/add", form, &respBody); err != nil {
 		c.logger.WithError(err).Error("Failed to add magnet link")
 		return fmt.Errorf("failed to add magnet link: %w", err)
 	}
-	defer resp.Body.Close()
-
-	// Read response body
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
-	}
 
 	c.logger.WithFields(map[string]interface{}{
-		"status_code": resp.StatusCode,
 		"body_length": len(respBody),
 		"response":    string(respBody),
 	}).Debug("Add magnet response")
 
-	// Check for HTTP errors
-	if resp.StatusCode >= 400 {
-		c.logger.WithFields(map[string]interface{}{
-			"status_code": resp.StatusCode,
-			"response":    string(respBody),
-		}).Error("Add magnet request failed")
-		return &APIError{
-			Code:    resp.StatusCode,
-			Message: resp.Status,
-			Details: string(respBody),
-		}
-	}
-
-	// Check for qBittorrent errors in response body (even with 200 status)
-	// qBittorrent returns error messages in the response body
+	// qBittorrent reports a failed add in the body of a successful response
 	if len(respBody) > 0 {
 		respText := strings.TrimSpace(string(respBody))
+		if respText == "Fails." {
+			// qBittorrent doesn't say why the add failed, so explain the usual causes
+			c.logger.WithFields(map[string]interface{}{
+				"response": respText,
+			}).Error("qBittorrent rejected the magnet link")
+			return &APIError{
+				Code:    http.StatusOK, // Errors come with a success status
+				Message: "qBittorrent Error",
+				Details: "torrent was not added (the magnet link may be invalid or the torrent may already exist)",
+			}
+		}
 		if respText != "" && respText != "Ok." {
 			// This is an error response from qBittorrent
 			c.logger.WithFields(map[string]interface{}{
-				"status_code": resp.StatusCode,
-				"response":    respText,
+				"response": respText,
 			}).Error("qBittorrent returned error in response body")
 			return &APIError{
-				Code:    resp.StatusCode,
+				Code:    http.StatusOK, // Errors come with a success status
 				Message: "qBittorrent Error",
 				Details: respText,
 			}
@@ -497,6 +793,456 @@ func (c *Client) ResumeTorrents(ctx context.Context, hashes []string) error {
 	return nil
 }
 
+// SetTorrentPriorityTop moves torrents to the top of the download queue
+func (c *Client) SetTorrentPriorityTop(ctx context.Context, hashes []string) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hashes": hashes,
+		"count":  len(hashes),
+	}).Info("Moving torrents to top of queue")
+
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/topPrio", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to move torrents to top of queue")
+		return fmt.Errorf("failed to set top priority: %w", err)
+	}
+
+	c.logger.WithField("count", len(hashes)).Info("Torrents moved to top of queue successfully")
+	return nil
+}
+
+// RecheckTorrents forces a hash recheck of torrents in qBittorrent
+func (c *Client) RecheckTorrents(ctx context.Context, hashes []string) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hashes": hashes,
+		"count":  len(hashes),
+	}).Info("Rechecking torrents")
+
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/recheck", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to recheck torrents")
+		return fmt.Errorf("failed to recheck torrents: %w", err)
+	}
+
+	c.logger.WithField("count", len(hashes)).Info("Torrents recheck started successfully")
+	return nil
+}
+
+// ReannounceTorrents forces torrents in qBittorrent to reannounce to their trackers
+func (c *Client) ReannounceTorrents(ctx context.Context, hashes []string) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hashes": hashes,
+		"count":  len(hashes),
+	}).Info("Reannouncing torrents")
+
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/reannounce", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to reannounce torrents")
+		return fmt.Errorf("failed to reannounce torrents: %w", err)
+	}
+
+	c.logger.WithField("count", len(hashes)).Info("Torrents reannounced successfully")
+	return nil
+}
+
+// SetTorrentDownloadLimit sets the download speed limit (bytes/s, 0 for no limit) of torrents in qBittorrent
+func (c *Client) SetTorrentDownloadLimit(ctx context.Context, hashes []string, limit int64) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hashes": hashes,
+		"count":  len(hashes),
+		"limit":  limit,
+	}).Info("Setting torrent download limit")
+
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("limit", strconv.FormatInt(limit, 10))
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/setDownloadLimit", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to set torrent download limit")
+		return fmt.Errorf("failed to set torrent download limit: %w", err)
+	}
+
+	c.logger.WithField("count", len(hashes)).Info("Torrent download limit set successfully")
+	return nil
+}
+
+// SetTorrentUploadLimit sets the upload speed limit (bytes/s, 0 for no limit) of torrents in qBittorrent
+func (c *Client) SetTorrentUploadLimit(ctx context.Context, hashes []string, limit int64) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hashes": hashes,
+		"count":  len(hashes),
+		"limit":  limit,
+	}).Info("Setting torrent upload limit")
+
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("limit", strconv.FormatInt(limit, 10))
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/setUploadLimit", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to set torrent upload limit")
+		return fmt.Errorf("failed to set torrent upload limit: %w", err)
+	}
+
+	c.logger.WithField("count", len(hashes)).Info("Torrent upload limit set successfully")
+	return nil
+}
+
+// SetGlobalDownloadLimit sets qBittorrent's global download speed limit (bytes/s, 0 for no limit)
+func (c *Client) SetGlobalDownloadLimit(ctx context.Context, limit int64) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithField("limit", limit).Info("Setting global download limit")
+
+	data := url.Values{}
+	data.Set("limit", strconv.FormatInt(limit, 10))
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/transfer/setDownloadLimit", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to set global download limit")
+		return fmt.Errorf("failed to set global download limit: %w", err)
+	}
+
+	c.logger.WithField("limit", limit).Info("Global download limit set successfully")
+	return nil
+}
+
+// SetGlobalUploadLimit sets qBittorrent's global upload speed limit (bytes/s, 0 for no limit)
+func (c *Client) SetGlobalUploadLimit(ctx context.Context, limit int64) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithField("limit", limit).Info("Setting global upload limit")
+
+	data := url.Values{}
+	data.Set("limit", strconv.FormatInt(limit, 10))
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/transfer/setUploadLimit", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to set global upload limit")
+		return fmt.Errorf("failed to set global upload limit: %w", err)
+	}
+
+	c.logger.WithField("limit", limit).Info("Global upload limit set successfully")
+	return nil
+}
+
+// GetSpeedLimitsMode reports whether qBittorrent's alternative speed limits are active
+func (c *Client) GetSpeedLimitsMode(ctx context.Context) (bool, error) {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return false, err
+	}
+
+	c.logger.Debug("Fetching speed limits mode")
+
+	// The endpoint answers with a bare 1 (alternative limits) or 0 (normal limits)
+	var mode int
+	err := c.makeRequest(ctx, "GET", "/api/v2/transfer/speedLimitsMode", nil, &mode)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to fetch speed limits mode")
+		return false, fmt.Errorf("failed to fetch speed limits mode: %w", err)
+	}
+
+	c.logger.WithField("alternative", mode == 1).Debug("Speed limits mode fetched successfully")
+	return mode == 1, nil
+}
+
+// ToggleAlternativeSpeedLimits switches qBittorrent between its normal and alternative speed limits
+func (c *Client) ToggleAlternativeSpeedLimits(ctx context.Context) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.Info("Toggling alternative speed limits")
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/transfer/toggleSpeedLimitsMode", url.Values{}, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to toggle alternative speed limits")
+		return fmt.Errorf("failed to toggle alternative speed limits: %w", err)
+	}
+
+	c.logger.Info("Alternative speed limits toggled successfully")
+	return nil
+}
+
+// SetTorrentLocation moves the data of torrents to a new directory in qBittorrent
+func (c *Client) SetTorrentLocation(ctx context.Context, hashes []string, location string) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hashes":   hashes,
+		"count":    len(hashes),
+		"location": location,
+	}).Info("Setting torrent location")
+
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("location", location)
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/setLocation", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to set torrent location")
+		return fmt.Errorf("failed to set torrent location: %w", err)
+	}
+
+	c.logger.WithField("count", len(hashes)).Info("Torrent location set successfully")
+	return nil
+}
+
+// SetShareLimits sets the share ratio and seeding time (in minutes) at which
+// qBittorrent stops seeding the given torrents. Use ShareLimitGlobal to follow
+// qBittorrent's global settings or ShareLimitNone to remove a limit.
+func (c *Client) SetShareLimits(ctx context.Context, hashes []string, ratioLimit float64, seedingTimeLimit int64) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hashes":             hashes,
+		"count":              len(hashes),
+		"ratio_limit":        ratioLimit,
+		"seeding_time_limit": seedingTimeLimit,
+	}).Info("Setting torrent share limits")
+
+	if err := c.RequireAPIVersion(ctx, APIVersionShareLimits, "setting share limits"); err != nil {
+		return err
+	}
+
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("ratioLimit", strconv.FormatFloat(ratioLimit, 'f', -1, 64))
+	data.Set("seedingTimeLimit", strconv.FormatInt(seedingTimeLimit, 10))
+	// Required since qBittorrent 4.6, ignored by older versions
+	data.Set("inactiveSeedingTimeLimit", strconv.Itoa(ShareLimitGlobal))
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/setShareLimits", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to set torrent share limits")
+		return fmt.Errorf("failed to set share limits: %w", err)
+	}
+
+	c.logger.WithField("count", len(hashes)).Info("Torrent share limits set successfully")
+	return nil
+}
+
+// GetCategories retrieves all categories defined in qBittorrent, keyed by name
+func (c *Client) GetCategories(ctx context.Context) (map[string]Category, error) {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("Fetching categories")
+
+	categories := make(map[string]Category)
+	err := c.makeRequest(ctx, "GET", "/api/v2/torrents/categories", nil, &categories)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to fetch categories")
+		return nil, fmt.Errorf("failed to fetch categories: %w", err)
+	}
+
+	c.logger.WithField("count", len(categories)).Debug("Categories fetched successfully")
+	return categories, nil
+}
+
+// SetCategory assigns the specified torrents to a category. The category must
+// already exist in qBittorrent; an empty category removes the torrents' category.
+func (c *Client) SetCategory(ctx context.Context, hashes []string, category string) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hashes":   hashes,
+		"count":    len(hashes),
+		"category": category,
+	}).Info("Setting torrent category")
+
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("category", category)
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/setCategory", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to set torrent category")
+		return fmt.Errorf("failed to set torrent category: %w", err)
+	}
+
+	c.logger.WithField("count", len(hashes)).Info("Torrent category set successfully")
+	return nil
+}
+
+// CreateCategory creates a new category in qBittorrent with the given save path
+func (c *Client) CreateCategory(ctx context.Context, name, savePath string) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"category":  name,
+		"save_path": savePath,
+	}).Info("Creating category")
+
+	data := url.Values{}
+	data.Set("category", name)
+	data.Set("savePath", savePath)
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/createCategory", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to create category")
+		return fmt.Errorf("failed to create category: %w", err)
+	}
+
+	c.logger.WithField("category", name).Info("Category created successfully")
+	return nil
+}
+
+// GetAllTags retrieves all tags defined in qBittorrent
+func (c *Client) GetAllTags(ctx context.Context) ([]string, error) {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	c.logger.Debug("Fetching tags")
+
+	var tags []string
+	err := c.makeRequest(ctx, "GET", "/api/v2/torrents/tags", nil, &tags)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to fetch tags")
+		return nil, fmt.Errorf("failed to fetch tags: %w", err)
+	}
+
+	c.logger.WithField("count", len(tags)).Debug("Tags fetched successfully")
+	return tags, nil
+}
+
+// AddTorrentTags adds tags to torrents in qBittorrent, creating tags that don't exist yet
+func (c *Client) AddTorrentTags(ctx context.Context, hashes []string, tags []string) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hashes": hashes,
+		"count":  len(hashes),
+		"tags":   tags,
+	}).Info("Adding torrent tags")
+
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("tags", strings.Join(tags, ","))
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/addTags", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to add torrent tags")
+		return fmt.Errorf("failed to add torrent tags: %w", err)
+	}
+
+	c.logger.WithField("count", len(hashes)).Info("Torrent tags added successfully")
+	return nil
+}
+
+// RemoveTorrentTags removes tags from torrents in qBittorrent; the tags themselves are kept
+func (c *Client) RemoveTorrentTags(ctx context.Context, hashes []string, tags []string) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"hashes": hashes,
+		"count":  len(hashes),
+		"tags":   tags,
+	}).Info("Removing torrent tags")
+
+	data := url.Values{}
+	data.Set("hashes", strings.Join(hashes, "|"))
+	data.Set("tags", strings.Join(tags, ","))
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/removeTags", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to remove torrent tags")
+		return fmt.Errorf("failed to remove torrent tags: %w", err)
+	}
+
+	c.logger.WithField("count", len(hashes)).Info("Torrent tags removed successfully")
+	return nil
+}
+
+// CreateTags creates new tags in qBittorrent
+func (c *Client) CreateTags(ctx context.Context, tags []string) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithField("tags", tags).Info("Creating tags")
+
+	data := url.Values{}
+	data.Set("tags", strings.Join(tags, ","))
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/createTags", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to create tags")
+		return fmt.Errorf("failed to create tags: %w", err)
+	}
+
+	c.logger.WithField("tags", tags).Info("Tags created successfully")
+	return nil
+}
+
+// DeleteTags deletes tags from qBittorrent, removing them from every torrent
+func (c *Client) DeleteTags(ctx context.Context, tags []string) error {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return err
+	}
+
+	c.logger.WithField("tags", tags).Info("Deleting tags")
+
+	data := url.Values{}
+	data.Set("tags", strings.Join(tags, ","))
+
+	err := c.makeRequest(ctx, "POST", "/api/v2/torrents/deleteTags", data, nil)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to delete tags")
+		return fmt.Errorf("failed to delete tags: %w", err)
+	}
+
+	c.logger.WithField("tags", tags).Info("Tags deleted successfully")
+	return nil
+}
+
 // GetServerState retrieves global server state information
 func (c *Client) GetServerState(ctx context.Context) (*ServerState, error) {
 	if err := c.ensureAuthenticated(ctx); err != nil {
@@ -505,27 +1251,67 @@ func (c *Client) GetServerState(ctx context.Context) (*ServerState, error) {
 
 	c.logger.Debug("Fetching server state")
 
-	var state ServerState
-	err := c.makeRequest(ctx, "GET", "/api/v2/sync/maindata", nil, &state)
+	// The server state is nested inside the maindata payload
+	var mainData struct {
+		ServerState ServerState `json:"server_state"`
+	}
+	err := c.makeRequest(ctx, "GET", "/api/v2/sync/maindata", nil, &mainData)
 	if err != nil {
 		c.logger.WithError(err).Error("Failed to fetch server state")
 		return nil, fmt.Errorf("failed to fetch server state: %w", err)
 	}
 
 	c.logger.Debug("Server state fetched successfully")
-	return &state, nil
+	return &mainData.ServerState, nil
 }
 
-// GetDiskSpace retrieves disk space information for a given path
-func (c *Client) GetDiskSpace(ctx context.Context, path string) (*DiskSpace, error) {
+// GetDefaultSavePath retrieves qBittorrent's default save path from its preferences
+func (c *Client) GetDefaultSavePath(ctx context.Context) (string, error) {
 	if err := c.ensureAuthenticated(ctx); err != nil {
-		return nil, err
+		return "", err
 	}
 
-	c.logger.WithField("path", path).Debug("Fetching disk space")
+	c.logger.Debug("Fetching default save path")
+
+	var preferences struct {
+		SavePath string `json:"save_path"`
+	}
+	err := c.makeRequest(ctx, "GET", "/api/v2/app/preferences", nil, &preferences)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to fetch preferences")
+		return "", fmt.Errorf("failed to fetch preferences: %w", err)
+	}
+
+	return preferences.SavePath, nil
+}
+
+// GetDiskSpace retrieves the free disk space qBittorrent reports. qBittorrent only
+// measures the disk of its default save path and doesn't report the total size,
+// so path is only used for logging and Total and Used are always 0.
+func (c *Client) GetDiskSpace(ctx context.Context, path string) (*DiskSpace, error) {
+	state, err := c.GetServerState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch disk space: %w", err)
+	}
+	if state.FreeSpaceOnDisk <= 0 {
+		return nil, fmt.Errorf("qBittorrent did not report free disk space")
+	}
+
+	diskSpace := &DiskSpace{Free: state.FreeSpaceOnDisk}
+
+	// The save path only labels the result, so it's fine if it can't be fetched
+	savePath, err := c.GetDefaultSavePath(ctx)
+	if err != nil {
+		c.logger.WithError(err).Warn("Failed to fetch default save path for disk space")
+	} else {
+		diskSpace.Path = savePath
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"path":      path,
+		"save_path": diskSpace.Path,
+		"free":      FormatBytes(diskSpace.Free),
+	}).Debug("Disk space fetched from qBittorrent")
 
-	// qBittorrent doesn't have a direct disk space API, so we'll use a system call
-	// This is a placeholder - in a real implementation, you'd use syscall or a library
-	// For now, we'll return an error indicating this needs platform-specific implementation
-	return nil, fmt.Errorf("disk space checking not implemented - requires platform-specific code")
+	return diskSpace, nil
 }
@@ -0,0 +1,151 @@
+package qbittorrent
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeBaseURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr string
+	}{
+		{name: "full URL", input: "http://localhost:8080", want: "http://localhost:8080"},
+		{name: "missing scheme", input: "localhost:8080", want: "http://localhost:8080"},
+		{name: "trailing slash", input: "http://localhost:8080/", want: "http://localhost:8080"},
+		{name: "sub-path", input: "https://example.com/qbt//", want: "https://example.com/qbt"},
+		{name: "uppercase scheme", input: "HTTPS://example.com", want: "https://example.com"},
+		{name: "surrounding spaces", input: "  localhost:8080  ", want: "http://localhost:8080"},
+		{name: "empty", input: "", wantErr: "URL is empty"},
+		{name: "blank", input: "   ", wantErr: "URL is empty"},
+		{name: "unsupported scheme", input: "ftp://localhost:8080", wantErr: "scheme must be http or https"},
+		{name: "missing host", input: "http://:8080", wantErr: "missing host"},
+		{name: "query string", input: "http://localhost:8080/?a=b", wantErr: "query strings and fragments are not supported"},
+		{name: "fragment", input: "http://localhost:8080/#top", wantErr: "query strings and fragments are not supported"},
+		{name: "unparsable", input: "http://local host:8080", wantErr: "invalid qBittorrent URL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeBaseURL(tt.input)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("NormalizeBaseURL(%q) error = %v, want it to contain %q", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NormalizeBaseURL(%q) unexpected error: %v", tt.input, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("NormalizeBaseURL(%q) = %q, want %q", tt.input, got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestEndpointURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseURL  string
+		endpoint string
+		want     string
+	}{
+		{name: "plain endpoint", baseURL: "http://localhost:8080", endpoint: "/api/v2/app/version", want: "http://localhost:8080/api/v2/app/version"},
+		{name: "sub-path", baseURL: "https://example.com/qbt/", endpoint: "/api/v2/app/version", want: "https://example.com/qbt/api/v2/app/version"},
+		{name: "query", baseURL: "http://localhost:8080", endpoint: "/api/v2/sync/maindata?rid=0", want: "http://localhost:8080/api/v2/sync/maindata?rid=0"},
+		{name: "query with sub-path", baseURL: "http://localhost/qbt", endpoint: "/api/v2/torrents/files?hash=abc", want: "http://localhost/qbt/api/v2/torrents/files?hash=abc"},
+		{name: "escaped query", baseURL: "http://localhost:8080", endpoint: "/api/v2/torrents/info?category=tv%20shows&tag=a%2Cb", want: "http://localhost:8080/api/v2/torrents/info?category=tv%20shows&tag=a%2Cb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient(tt.baseURL, "admin", "secret")
+			if err != nil {
+				t.Fatalf("NewClient(%q) unexpected error: %v", tt.baseURL, err)
+			}
+			if got := client.endpointURL(tt.endpoint).String(); got != tt.want {
+				t.Errorf("endpointURL(%q) = %q, want %q", tt.endpoint, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddMagnetFailsBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{name: "ok", body: "Ok."},
+		{name: "fails", body: "Fails.", wantErr: "torrent was not added"},
+		{name: "fails with newline", body: "Fails.\n", wantErr: "torrent was not added"},
+		{name: "other message", body: "Torrent file is not valid", wantErr: "Torrent file is not valid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v2/torrents/add" {
+					http.NotFound(w, r)
+					return
+				}
+				w.Write([]byte(tt.body))
+			})
+
+			err := client.AddMagnet(context.Background(), "magnet:?xt=urn:btih:0123456789abcdef0123456789abcdef01234567", AddTorrentRequest{})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("AddMagnet unexpected error: %v", err)
+				}
+				return
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("AddMagnet error = %v, want an *APIError", err)
+			}
+			if !strings.Contains(apiErr.Details, tt.wantErr) {
+				t.Errorf("AddMagnet error details = %q, want them to contain %q", apiErr.Details, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAddMagnetRenewsExpiredSession(t *testing.T) {
+	const magnet = "magnet:?xt=urn:btih:0123456789abcdef0123456789abcdef01234567"
+	attempts := 0
+	var gotURLs, gotCategory string
+	client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/torrents/add" {
+			http.NotFound(w, r)
+			return
+		}
+		attempts++
+		if attempts == 1 {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		gotURLs, gotCategory = r.FormValue("urls"), r.FormValue("category")
+		w.Write([]byte("Ok."))
+	})
+
+	if err := client.AddMagnet(context.Background(), magnet, AddTorrentRequest{Category: "movies"}); err != nil {
+		t.Fatalf("AddMagnet unexpected error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("add attempts = %d, want 2 (one retry after logging in again)", attempts)
+	}
+	if gotURLs != magnet || gotCategory != "movies" {
+		t.Errorf("multipart form urls = %q, category = %q; want %q, %q", gotURLs, gotCategory, magnet, "movies")
+	}
+}
@@ -0,0 +1,61 @@
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// dashboardConcurrency bounds how many requests GetDashboardData runs at once
+const dashboardConcurrency = 3
+
+// DashboardData combines everything needed to render a full dashboard refresh
+type DashboardData struct {
+	Torrents    []Torrent           `json:"torrents"`
+	ServerState *ServerState        `json:"server_state"`
+	Categories  map[string]Category `json:"categories,omitempty"`
+}
+
+// GetDashboardData fetches torrents, server state and, if includeCategories is
+// set, categories concurrently. The first failure cancels the remaining
+// requests and is returned.
+func (c *Client) GetDashboardData(ctx context.Context, includeCategories bool) (*DashboardData, error) {
+	// Authenticate once up front so the concurrent requests don't all log in
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	c.logger.WithField("include_categories", includeCategories).Debug("Fetching dashboard data")
+
+	var data DashboardData
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(dashboardConcurrency)
+
+	group.Go(func() error {
+		torrents, err := c.GetTorrents(groupCtx)
+		data.Torrents = torrents
+		return err
+	})
+
+	group.Go(func() error {
+		state, err := c.GetServerState(groupCtx)
+		data.ServerState = state
+		return err
+	})
+
+	if includeCategories {
+		group.Go(func() error {
+			categories, err := c.GetCategories(groupCtx)
+			data.Categories = categories
+			return err
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, fmt.Errorf("failed to fetch dashboard data: %w", err)
+	}
+
+	c.logger.WithField("torrents", len(data.Torrents)).Debug("Dashboard data fetched successfully")
+	return &data, nil
+}
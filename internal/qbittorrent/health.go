@@ -0,0 +1,121 @@
+package qbittorrent
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	DefaultMaxRetries = 3               // Attempts per request before giving up
+	DefaultRetryDelay = 1 * time.Second // Delay before the first retry, doubled for each further one
+	maxRetryDelay     = 30 * time.Second
+)
+
+// ErrConnection is wrapped by errors of requests that could not reach
+// qBittorrent at all, as opposed to requests qBittorrent rejected
+var ErrConnection = errors.New("cannot reach qBittorrent")
+
+// ConnectionHealth describes whether requests have been reaching qBittorrent
+type ConnectionHealth struct {
+	Connected   bool      `json:"connected"`            // The last request reached qBittorrent
+	LastSuccess time.Time `json:"last_success"`         // When a request last reached qBittorrent
+	LastError   string    `json:"last_error,omitempty"` // Why the last failed request could not reach qBittorrent
+	Failures    int       `json:"failures"`             // Requests in a row that could not reach qBittorrent
+}
+
+// WithRetries sets how many times a request that cannot reach qBittorrent is
+// attempted, and the delay before the first retry
+func WithRetries(maxRetries int, retryDelay time.Duration) ClientOption {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.retryDelay = retryDelay
+	}
+}
+
+// Health returns the current connection health
+func (c *Client) Health() ConnectionHealth {
+	c.healthMutex.Lock()
+	defer c.healthMutex.Unlock()
+	return c.health
+}
+
+// WaitForConnection logs in again until it succeeds or the context is done,
+// backing off between attempts. It gives up straight away when qBittorrent
+// answers but rejects the login, since retrying would not help.
+func (c *Client) WaitForConnection(ctx context.Context) error {
+	for attempt := 1; ; attempt++ {
+		err := c.Connect(ctx)
+		if err == nil {
+			// qBittorrent may have been upgraded while it was unreachable
+			c.clearVersions()
+			return nil
+		}
+
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		delay := c.backoff(attempt)
+		c.logger.WithFields(map[string]interface{}{
+			"attempt": attempt,
+			"delay":   delay,
+			"error":   err,
+		}).Warn("qBittorrent unreachable, waiting to reconnect")
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// backoff returns the delay before the given retry: the retry delay doubled for
+// every earlier attempt, capped, with up to half of it taken off at random so
+// that clients do not retry in lockstep
+func (c *Client) backoff(attempt int) time.Duration {
+	delay := c.retryDelay
+	if delay <= 0 {
+		delay = DefaultRetryDelay
+	}
+	for i := 1; i < attempt && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, maxRetryDelay)
+	return delay - time.Duration(rand.Int64N(int64(delay)/2+1))
+}
+
+// recordSuccess marks qBittorrent as reachable
+func (c *Client) recordSuccess() {
+	c.healthMutex.Lock()
+	defer c.healthMutex.Unlock()
+	c.health.Connected = true
+	c.health.LastSuccess = time.Now()
+	c.health.LastError = ""
+	c.health.Failures = 0
+}
+
+// recordFailure marks qBittorrent as unreachable
+func (c *Client) recordFailure(err error) {
+	c.healthMutex.Lock()
+	defer c.healthMutex.Unlock()
+	c.health.Connected = false
+	c.health.LastError = err.Error()
+	c.health.Failures++
+}
+
+// sleepContext waits for the given duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,65 @@
+package qbittorrent
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// RequestLatencyBuckets are the upper bounds, in seconds, of the request
+// latency histogram kept per endpoint
+var RequestLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// RequestStats counts the API requests made to a single endpoint
+type RequestStats struct {
+	Endpoint        string   // API path without the query string, e.g. /api/v2/torrents/info
+	Requests        uint64   // Requests made, including failed ones
+	Errors          uint64   // Requests that failed or that qBittorrent rejected
+	DurationSeconds float64  // Total time spent on the requests
+	BucketCounts    []uint64 // Requests per RequestLatencyBuckets bound, cumulative
+}
+
+// recordRequest adds a finished request to the statistics of its endpoint
+func (c *Client) recordRequest(endpoint string, duration time.Duration, err error) {
+	endpoint, _, _ = strings.Cut(endpoint, "?")
+	seconds := duration.Seconds()
+
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+
+	if c.requestStats == nil {
+		c.requestStats = make(map[string]*RequestStats)
+	}
+	stats, ok := c.requestStats[endpoint]
+	if !ok {
+		stats = &RequestStats{Endpoint: endpoint, BucketCounts: make([]uint64, len(RequestLatencyBuckets))}
+		c.requestStats[endpoint] = stats
+	}
+
+	stats.Requests++
+	if err != nil {
+		stats.Errors++
+	}
+	stats.DurationSeconds += seconds
+	for i, bound := range RequestLatencyBuckets {
+		if seconds <= bound {
+			stats.BucketCounts[i]++
+		}
+	}
+}
+
+// RequestStats returns a copy of the request statistics of every endpoint
+// used so far, sorted by endpoint
+func (c *Client) RequestStats() []RequestStats {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+
+	stats := make([]RequestStats, 0, len(c.requestStats))
+	for _, s := range c.requestStats {
+		copied := *s
+		copied.BucketCounts = append([]uint64(nil), s.BucketCounts...)
+		stats = append(stats, copied)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Endpoint < stats[j].Endpoint })
+	return stats
+}
@@ -0,0 +1,139 @@
+package qbittorrent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// sessionCache is the content of the session file. Cookies are only reused for the
+// base URL they were issued for.
+type sessionCache struct {
+	BaseURL string         `json:"base_url"`
+	Cookies []*http.Cookie `json:"cookies"`
+	SavedAt time.Time      `json:"saved_at"`
+}
+
+// WithSessionFile caches the authentication cookie in path, so that separate Akira
+// invocations can reuse a session instead of logging in every time
+func WithSessionFile(path string) ClientOption {
+	return func(c *Client) {
+		c.sessionFile = path
+	}
+}
+
+// CachesSession returns true if the session cookie is persisted between invocations
+func (c *Client) CachesSession() bool {
+	return c.sessionFile != "" && c.httpClient.Jar != nil
+}
+
+// Connect authenticates with qBittorrent, reusing the cached session when it is still valid
+func (c *Client) Connect(ctx context.Context) error {
+	if c.loadSession() {
+		if c.IsAuthenticated(ctx) {
+			c.logger.Debug("Reusing cached qBittorrent session")
+			return nil
+		}
+		c.logger.Debug("Cached qBittorrent session expired, logging in again")
+	}
+	return c.Login(ctx)
+}
+
+// loadSession adds the cached session cookies to the cookie jar and reports whether any were found
+func (c *Client) loadSession() bool {
+	if !c.CachesSession() {
+		return false
+	}
+
+	data, err := os.ReadFile(c.sessionFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			c.logger.WithError(err).Warn("Failed to read qBittorrent session file")
+		}
+		return false
+	}
+
+	var cache sessionCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		c.logger.WithError(err).Warn("Ignoring invalid qBittorrent session file")
+		return false
+	}
+	if cache.BaseURL != c.baseURL.String() || len(cache.Cookies) == 0 {
+		return false
+	}
+
+	c.httpClient.Jar.SetCookies(c.baseURL, cache.Cookies)
+	return true
+}
+
+// saveSession writes the current session cookies to the session file, readable only by the owner
+func (c *Client) saveSession() {
+	if !c.CachesSession() {
+		return
+	}
+
+	// The jar only returns name and value; that is all that is needed to send the cookie again
+	cache := sessionCache{
+		BaseURL: c.baseURL.String(),
+		Cookies: c.httpClient.Jar.Cookies(c.baseURL),
+		SavedAt: time.Now(),
+	}
+	if len(cache.Cookies) == 0 {
+		return
+	}
+
+	if err := writeSessionFile(c.sessionFile, cache); err != nil {
+		c.logger.WithError(err).Warn("Failed to save qBittorrent session")
+	}
+}
+
+// clearSession removes the session file
+func (c *Client) clearSession() {
+	if !c.CachesSession() {
+		return
+	}
+	if err := os.Remove(c.sessionFile); err != nil && !os.IsNotExist(err) {
+		c.logger.WithError(err).Warn("Failed to remove qBittorrent session file")
+	}
+}
+
+// writeSessionFile atomically writes the session cache with 0600 permissions
+func writeSessionFile(path string, cache sessionCache) error {
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal session: %w", err)
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return fmt.Errorf("failed to create session directory: %w", err)
+		}
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".akira-session-*")
+	if err != nil {
+		return fmt.Errorf("failed to create session file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to set session file permissions: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write session file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write session file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write session file: %w", err)
+	}
+	return nil
+}
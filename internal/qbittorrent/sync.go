@@ -0,0 +1,118 @@
+package qbittorrent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// MainDataUpdate is one response of qBittorrent's incremental sync endpoint.
+// Torrents holds the added and changed torrents keyed by hash; changed torrents
+// only include the fields that changed since the requested rid.
+type MainDataUpdate struct {
+	Rid             int64                      `json:"rid"`              // Response ID to pass to the next sync
+	FullUpdate      bool                       `json:"full_update"`      // Whether this is a complete snapshot rather than a delta
+	Torrents        map[string]json.RawMessage `json:"torrents"`         // Added or changed torrents (partial objects)
+	TorrentsRemoved []string                   `json:"torrents_removed"` // Hashes of removed torrents
+	ServerState     json.RawMessage            `json:"server_state"`     // Changed server state fields
+}
+
+// SyncMainData fetches the changes since rid. A rid of 0 requests a full update,
+// and qBittorrent also answers with a full update when rid is unknown to it.
+func (c *Client) SyncMainData(ctx context.Context, rid int64) (*MainDataUpdate, error) {
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+
+	c.logger.WithField("rid", rid).Debug("Syncing main data")
+
+	data := url.Values{}
+	data.Set("rid", strconv.FormatInt(rid, 10))
+
+	var update MainDataUpdate
+	err := c.makeRequest(ctx, "GET", "/api/v2/sync/maindata?"+data.Encode(), nil, &update)
+	if err != nil {
+		c.logger.WithError(err).Error("Failed to sync main data")
+		return nil, fmt.Errorf("failed to sync main data: %w", err)
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"rid":         update.Rid,
+		"full_update": update.FullUpdate,
+		"changed":     len(update.Torrents),
+		"removed":     len(update.TorrentsRemoved),
+	}).Debug("Main data synced successfully")
+	return &update, nil
+}
+
+// MainDataSnapshot is the torrent list and server state rebuilt from sync updates.
+// It is not safe for concurrent use.
+type MainDataSnapshot struct {
+	rid         int64
+	torrents    map[string]Torrent
+	serverState ServerState
+}
+
+// NewMainDataSnapshot creates an empty snapshot whose first sync is a full update
+func NewMainDataSnapshot() *MainDataSnapshot {
+	return &MainDataSnapshot{torrents: make(map[string]Torrent)}
+}
+
+// Rid returns the response ID to request the next update with
+func (s *MainDataSnapshot) Rid() int64 {
+	return s.rid
+}
+
+// Reset discards the snapshot so the next sync starts with a full update
+func (s *MainDataSnapshot) Reset() {
+	s.rid = 0
+	s.torrents = make(map[string]Torrent)
+	s.serverState = ServerState{}
+}
+
+// Apply merges an update into the snapshot. A full update replaces it entirely.
+func (s *MainDataSnapshot) Apply(update *MainDataUpdate) error {
+	if update.FullUpdate {
+		s.torrents = make(map[string]Torrent, len(update.Torrents))
+		s.serverState = ServerState{}
+	}
+
+	// Decoding a partial object onto the previous value only overwrites the changed fields
+	for hash, raw := range update.Torrents {
+		torrent := s.torrents[hash]
+		if err := json.Unmarshal(raw, &torrent); err != nil {
+			return fmt.Errorf("failed to decode torrent %s: %w", hash, err)
+		}
+		torrent.Hash = hash
+		s.torrents[hash] = torrent
+	}
+
+	for _, hash := range update.TorrentsRemoved {
+		delete(s.torrents, hash)
+	}
+
+	if len(update.ServerState) > 0 {
+		if err := json.Unmarshal(update.ServerState, &s.serverState); err != nil {
+			return fmt.Errorf("failed to decode server state: %w", err)
+		}
+	}
+
+	s.rid = update.Rid
+	return nil
+}
+
+// Torrents returns a copy of the torrents in the snapshot, in no particular order
+func (s *MainDataSnapshot) Torrents() []Torrent {
+	torrents := make([]Torrent, 0, len(s.torrents))
+	for _, torrent := range s.torrents {
+		torrents = append(torrents, torrent)
+	}
+	return torrents
+}
+
+// ServerState returns a copy of the server state in the snapshot
+func (s *MainDataSnapshot) ServerState() ServerState {
+	return s.serverState
+}
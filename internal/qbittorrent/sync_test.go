@@ -0,0 +1,54 @@
+package qbittorrent
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestServer starts a fake qBittorrent that accepts any login and serves the
+// other endpoints with handler. It returns a client connected to it.
+func newTestServer(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/auth/login", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "SID", Value: "test", Path: "/"})
+		w.Write([]byte("Ok."))
+	})
+	mux.HandleFunc("/", handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(server.URL, "admin", "secret")
+	if err != nil {
+		t.Fatalf("NewClient unexpected error: %v", err)
+	}
+	return client
+}
+
+func TestSyncMainDataSendsRid(t *testing.T) {
+	var gotPath, gotRid string
+	client := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		gotPath, gotRid = r.URL.Path, r.URL.Query().Get("rid")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"rid": 8, "full_update": true, "torrents": {"abc": {"name": "Ubuntu"}}}`))
+	})
+
+	update, err := client.SyncMainData(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("SyncMainData unexpected error: %v", err)
+	}
+
+	if gotPath != "/api/v2/sync/maindata" {
+		t.Errorf("request path = %q, want /api/v2/sync/maindata", gotPath)
+	}
+	if gotRid != "7" {
+		t.Errorf("rid query = %q, want 7", gotRid)
+	}
+	if update.Rid != 8 || !update.FullUpdate || len(update.Torrents) != 1 {
+		t.Errorf("update = rid %d, full %t, %d torrents; want rid 8, full, 1 torrent", update.Rid, update.FullUpdate, len(update.Torrents))
+	}
+}
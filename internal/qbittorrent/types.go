@@ -2,6 +2,8 @@ package qbittorrent
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -27,6 +29,8 @@ const (
 	StateForcedDL           TorrentState = "forcedDL"           // Torrent is forced to downloading to ignore queue limit
 	StateCheckingResumeData TorrentState = "checkingResumeData" // Checking resume data on qBt startup
 	StateMoving             TorrentState = "moving"             // Torrent is moving to another location
+	StateStoppedUP          TorrentState = "stoppedUP"          // Torrent is stopped and has finished downloading (qBittorrent 5.x)
+	StateStoppedDL          TorrentState = "stoppedDL"          // Torrent is stopped and has NOT finished downloading (qBittorrent 5.x)
 	StateUnknown            TorrentState = "unknown"            // Unknown status
 )
 
@@ -60,10 +64,10 @@ type Torrent struct {
 	Priority          int          `json:"priority"`           // Torrent priority. Returns -1 if queuing is disabled or torrent is in seed mode
 	Progress          float64      `json:"progress"`           // Torrent progress (percentage/100)
 	Ratio             float64      `json:"ratio"`              // Torrent share ratio. Max ratio value: 9999.
-	RatioLimit        float64      `json:"ratio_limit"`        // TODO (what is different from max_ratio?)
+	RatioLimit        float64      `json:"ratio_limit"`        // Per-torrent share ratio limit (-2 global, -1 none); max_ratio is the effective value
 	SavePath          string       `json:"save_path"`          // Path where this torrent's data is stored
 	SeedingTime       int64        `json:"seeding_time"`       // Torrent seeding time (seconds)
-	SeedingTimeLimit  int64        `json:"seeding_time_limit"` // TODO (what is different from max_seeding_time?)
+	SeedingTimeLimit  int64        `json:"seeding_time_limit"` // Per-torrent seeding time limit in minutes (-2 global, -1 none); max_seeding_time is the effective value
 	SeenComplete      int64        `json:"seen_complete"`      // Time (Unix Timestamp) when this torrent was last seen complete
 	SeqDl             bool         `json:"seq_dl"`             // True if sequential download is enabled
 	Size              int64        `json:"size"`               // Total size (bytes) of files selected for download
@@ -129,6 +133,31 @@ type TorrentFile struct {
 	Availability float64 `json:"availability"` // Percentage of file pieces currently available (percentage/100)
 }
 
+// File priorities accepted by the qBittorrent API
+const (
+	FilePriorityDoNotDownload = 0
+	FilePriorityNormal        = 1
+	FilePriorityHigh          = 6
+	FilePriorityMaximum       = 7
+)
+
+// ParseFilePriority converts a priority name (skip, normal, high, maximal) or
+// its numeric API value into a file priority
+func ParseFilePriority(name string) (int, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "skip", "none", "0":
+		return FilePriorityDoNotDownload, nil
+	case "normal", "1":
+		return FilePriorityNormal, nil
+	case "high", "6":
+		return FilePriorityHigh, nil
+	case "maximal", "maximum", "max", "7":
+		return FilePriorityMaximum, nil
+	default:
+		return 0, fmt.Errorf("invalid file priority '%s' (must be one of: skip, normal, high, maximal)", name)
+	}
+}
+
 // TorrentTracker represents a tracker for a torrent
 type TorrentTracker struct {
 	URL           string `json:"url"`            // Tracker url
@@ -141,6 +170,33 @@ type TorrentTracker struct {
 	Msg           string `json:"msg"`            // Tracker message (there is no way of knowing what this message is - it's up to tracker admins)
 }
 
+// Tracker statuses reported by the qBittorrent API
+const (
+	TrackerStatusDisabled     = 0 // Disabled (used for DHT, PeX and LSD)
+	TrackerStatusNotContacted = 1 // Not contacted yet
+	TrackerStatusWorking      = 2 // Contacted and working
+	TrackerStatusUpdating     = 3 // Updating
+	TrackerStatusNotWorking   = 4 // Contacted but not working
+)
+
+// GetStatusName returns a human-readable name for the tracker's status
+func (t *TorrentTracker) GetStatusName() string {
+	switch t.Status {
+	case TrackerStatusDisabled:
+		return "Disabled"
+	case TrackerStatusNotContacted:
+		return "Not contacted"
+	case TrackerStatusWorking:
+		return "Working"
+	case TrackerStatusUpdating:
+		return "Updating"
+	case TrackerStatusNotWorking:
+		return "Not working"
+	default:
+		return fmt.Sprintf("Unknown (%d)", t.Status)
+	}
+}
+
 // AddTorrentRequest represents a request to add a torrent
 type AddTorrentRequest struct {
 	URLs                   []string `json:"urls,omitempty"`                   // URLs separated with newlines
@@ -184,12 +240,14 @@ type LoginResponse struct {
 type ServerState struct {
 	ConnectionStatus     string `json:"connection_status"`      // Server connection status
 	DhtNodes             int64  `json:"dht_nodes"`              // DHT nodes connected to
+	FreeSpaceOnDisk      int64  `json:"free_space_on_disk"`     // Free space on the default save path's disk (bytes)
 	DlInfoData           int64  `json:"dl_info_data"`           // Data downloaded this session (bytes)
 	DlInfoSpeed          int64  `json:"dl_info_speed"`          // Global download rate (bytes/s)
 	DlRateLimit          int64  `json:"dl_rate_limit"`          // Download rate limit (bytes/s)
 	UpInfoData           int64  `json:"up_info_data"`           // Data uploaded this session (bytes)
 	UpInfoSpeed          int64  `json:"up_info_speed"`          // Global upload rate (bytes/s)
 	UpRateLimit          int64  `json:"up_rate_limit"`          // Upload rate limit (bytes/s)
+	UseAltSpeedLimits    bool   `json:"use_alt_speed_limits"`   // Whether the alternative speed limits are active
 	QueuedIoJobs         int64  `json:"queued_io_jobs"`         // Queued I/O jobs
 	ReadCacheHits        string `json:"read_cache_hits"`        // Read cache hits
 	ReadCacheOverload    string `json:"read_cache_overload"`    // Read cache overload
@@ -198,11 +256,18 @@ type ServerState struct {
 	WriteCacheOverload   string `json:"write_cache_overload"`   // Write cache overload
 }
 
+// Category represents a torrent category in qBittorrent
+type Category struct {
+	Name     string `json:"name"`     // Category name
+	SavePath string `json:"savePath"` // Default save path for the category
+}
+
 // DiskSpace represents disk space information
 type DiskSpace struct {
-	Total int64 `json:"total"` // Total space in bytes
-	Used  int64 `json:"used"`  // Used space in bytes
-	Free  int64 `json:"free"`  // Free space in bytes
+	Path  string `json:"path,omitempty"` // Path the space was measured for
+	Total int64  `json:"total"`          // Total space in bytes
+	Used  int64  `json:"used"`           // Used space in bytes
+	Free  int64  `json:"free"`           // Free space in bytes
 }
 
 // APIError represents an error from the qBittorrent API
@@ -219,6 +284,20 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("qBittorrent API error %d: %s", e.Code, e.Message)
 }
 
+// Special share limit values accepted by SetShareLimits
+const (
+	ShareLimitGlobal = -2 // Use qBittorrent's global share limit
+	ShareLimitNone   = -1 // No share limit
+)
+
+// Reasons recorded when seeding of a tracked torrent is stopped
+const (
+	SeedingStopReasonTime   = "time"   // The seeding time limit was reached
+	SeedingStopReasonRatio  = "ratio"  // The share ratio limit was reached
+	SeedingStopReasonManual = "manual" // Seeding was stopped by hand
+	SeedingStopReasonExit   = "exit"   // Seeding was stopped when Akira exited
+)
+
 // SeedingTrackingData represents data for tracking torrent seeding times
 type SeedingTrackingData struct {
 	Hash                 string        `json:"hash"`                   // Torrent hash
@@ -228,10 +307,26 @@ type SeedingTrackingData struct {
 	DownloadDuration     time.Duration `json:"download_duration"`      // How long download took
 	SeedingStopTime      time.Time     `json:"seeding_stop_time"`      // When seeding should stop
 	AutoStopped          bool          `json:"auto_stopped"`           // Whether this torrent has been auto-stopped
+	StopReason           string        `json:"stop_reason,omitempty"`  // Which limit stopped seeding, see SeedingStopReason*
 	CreatedAt            time.Time     `json:"created_at"`             // When this tracking record was created
 	UpdatedAt            time.Time     `json:"updated_at"`             // When this tracking record was last updated
 }
 
+// GetTags returns the torrent's tags as a list
+func (t *Torrent) GetTags() []string {
+	return SplitTags(t.Tags)
+}
+
+// HasTag returns true if the torrent has the given tag (case-insensitive)
+func (t *Torrent) HasTag(tag string) bool {
+	for _, torrentTag := range t.GetTags() {
+		if strings.EqualFold(torrentTag, tag) {
+			return true
+		}
+	}
+	return false
+}
+
 // IsDownloading returns true if the torrent is currently downloading
 func (t *Torrent) IsDownloading() bool {
 	return t.State == StateDownloading || t.State == StateMetaDL ||
@@ -254,7 +349,42 @@ func (t *Torrent) IsCompleted() bool {
 
 // IsPaused returns true if the torrent is paused
 func (t *Torrent) IsPaused() bool {
-	return t.State == StatePausedDL || t.State == StatePausedUP
+	return t.State == StatePausedDL || t.State == StatePausedUP ||
+		t.State == StateStoppedDL || t.State == StateStoppedUP
+}
+
+// HasError returns true if the torrent is errored or missing its files
+func (t *Torrent) HasError() bool {
+	return t.State == StateError || t.State == StateMissingFiles
+}
+
+// StalledDuration returns how long a stalled download has gone without activity,
+// measured from LastActivity (or AddedOn if it never had any). It returns 0 for
+// torrents that are not stalled while downloading.
+func (t *Torrent) StalledDuration(now time.Time) time.Duration {
+	if t.State != StateStalledDL {
+		return 0
+	}
+
+	since := t.LastActivity
+	if since <= 0 {
+		since = t.AddedOn
+	}
+	if since <= 0 {
+		return 0
+	}
+
+	duration := now.Sub(time.Unix(since, 0))
+	if duration < 0 {
+		return 0
+	}
+	return duration
+}
+
+// IsStalledFor returns true if the torrent is a stalled download that has been
+// inactive for at least threshold
+func (t *Torrent) IsStalledFor(threshold time.Duration, now time.Time) bool {
+	return t.State == StateStalledDL && t.StalledDuration(now) >= threshold
 }
 
 // IsActive returns true if the torrent is actively transferring data
@@ -267,6 +397,33 @@ func (t *Torrent) GetProgressPercentage() float64 {
 	return t.Progress * 100
 }
 
+// GetRemainingBytes returns the bytes still to download for the selected files
+func (t *Torrent) GetRemainingBytes() int64 {
+	if t.AmountLeft < 0 {
+		return 0
+	}
+	return t.AmountLeft
+}
+
+// GetCompletedBytes returns the bytes already downloaded for the selected files
+func (t *Torrent) GetCompletedBytes() int64 {
+	completed := t.Size - t.GetRemainingBytes()
+	if completed < 0 {
+		return 0
+	}
+	return completed
+}
+
+// GetEstimatedETA returns the seconds left to download based on AmountLeft and
+// the current download speed, or 0 when it cannot be estimated
+func (t *Torrent) GetEstimatedETA() int64 {
+	remaining := t.GetRemainingBytes()
+	if remaining == 0 || t.Dlspeed <= 0 {
+		return 0
+	}
+	return remaining / t.Dlspeed
+}
+
 // GetFormattedETA returns a human-readable ETA string
 func (t *Torrent) GetFormattedETA() string {
 	if t.Eta <= 0 || t.Eta == 8640000 { // 8640000 is qBittorrent's "infinity" value
@@ -352,3 +509,75 @@ func FormatSpeed(bytesPerSecond int64) string {
 	}
 	return FormatBytes(bytesPerSecond) + "/s"
 }
+
+// FormatSpeedLimit formats a speed limit in bytes per second, rendering no limit (0 or -1) as "∞"
+func FormatSpeedLimit(limit int64) string {
+	if limit <= 0 {
+		return "∞"
+	}
+	return FormatSpeed(limit)
+}
+
+// SplitTags splits a comma-separated tag list such as "foo, bar" into its
+// trimmed, non-empty tags
+func SplitTags(value string) []string {
+	var tags []string
+	for _, tag := range strings.Split(value, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// ParseBytes parses a human-readable size such as "512K", "1.5 MB" or "2GiB" into bytes.
+// Units are powers of 1024 to match FormatBytes; a bare number is taken as bytes.
+func ParseBytes(value string) (int64, error) {
+	s := strings.ToUpper(strings.TrimSpace(value))
+	if s == "" {
+		return 0, fmt.Errorf("empty size")
+	}
+
+	// Split the numeric part from the unit
+	i := 0
+	for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.') {
+		i++
+	}
+	number, err := strconv.ParseFloat(s[:i], 64)
+	if err != nil || number < 0 {
+		return 0, fmt.Errorf("invalid size '%s'", value)
+	}
+
+	unit := strings.TrimSpace(s[i:])
+	unit = strings.TrimSuffix(unit, "IB")
+	unit = strings.TrimSuffix(unit, "B")
+
+	multiplier := float64(1)
+	switch unit {
+	case "":
+	case "K":
+		multiplier = 1 << 10
+	case "M":
+		multiplier = 1 << 20
+	case "G":
+		multiplier = 1 << 30
+	case "T":
+		multiplier = 1 << 40
+	default:
+		return 0, fmt.Errorf("invalid size unit in '%s' (use B, K, M, G or T)", value)
+	}
+
+	return int64(number * multiplier), nil
+}
+
+// ParseSpeedLimit parses a speed limit such as "500K", "2 MB/s" or "∞" into bytes per
+// second. Empty input, "0", "-1", "∞", "none" and "unlimited" all mean no limit (0).
+func ParseSpeedLimit(value string) (int64, error) {
+	s := strings.ToLower(strings.TrimSpace(value))
+	switch s {
+	case "", "0", "-1", "∞", "none", "unlimited":
+		return 0, nil
+	}
+
+	return ParseBytes(strings.TrimSuffix(s, "/s"))
+}
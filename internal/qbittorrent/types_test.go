@@ -0,0 +1,186 @@
+package qbittorrent
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseBytes(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{input: "0", want: 0},
+		{input: "512", want: 512},
+		{input: "512B", want: 512},
+		{input: "1K", want: 1 << 10},
+		{input: "1KB", want: 1 << 10},
+		{input: "1KiB", want: 1 << 10},
+		{input: "1k", want: 1 << 10},
+		{input: "2M", want: 2 << 20},
+		{input: "1.5 MB", want: 3 << 19},
+		{input: "2GiB", want: 2 << 30},
+		{input: "0.5G", want: 1 << 29},
+		{input: "1T", want: 1 << 40},
+		{input: " 10 kb ", want: 10 << 10},
+		{input: "", wantErr: true},
+		{input: "   ", wantErr: true},
+		{input: "-1", wantErr: true},
+		{input: "-5M", wantErr: true},
+		{input: "M", wantErr: true},
+		{input: "1.2.3K", wantErr: true},
+		{input: "10X", wantErr: true},
+		{input: "10PB", wantErr: true},
+		{input: "10 MBs", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseBytes(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseBytes(%q) = %d, want an error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseBytes(%q) unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseBytes(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSpeedLimit(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{input: "", want: 0},
+		{input: "0", want: 0},
+		{input: "-1", want: 0},
+		{input: "∞", want: 0},
+		{input: "unlimited", want: 0},
+		{input: "500K", want: 500 << 10},
+		{input: "2 MB/s", want: 2 << 20},
+		{input: "-2M", wantErr: true},
+		{input: "fast", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseSpeedLimit(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseSpeedLimit(%q) = %d, want an error", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseSpeedLimit(%q) unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseSpeedLimit(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStalledDuration(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	ago := func(d time.Duration) int64 { return now.Add(-d).Unix() }
+	threshold := 30 * time.Minute
+
+	tests := []struct {
+		name        string
+		torrent     Torrent
+		want        time.Duration
+		wantStalled bool
+	}{
+		{
+			name:    "zero LastActivity and AddedOn",
+			torrent: Torrent{State: StateStalledDL},
+			want:    0,
+		},
+		{
+			name:        "zero LastActivity falls back to AddedOn",
+			torrent:     Torrent{State: StateStalledDL, AddedOn: ago(2 * time.Hour)},
+			want:        2 * time.Hour,
+			wantStalled: true,
+		},
+		{
+			name:    "recent LastActivity",
+			torrent: Torrent{State: StateStalledDL, AddedOn: ago(48 * time.Hour), LastActivity: ago(5 * time.Minute)},
+			want:    5 * time.Minute,
+		},
+		{
+			name:        "old LastActivity",
+			torrent:     Torrent{State: StateStalledDL, AddedOn: ago(48 * time.Hour), LastActivity: ago(3 * time.Hour)},
+			want:        3 * time.Hour,
+			wantStalled: true,
+		},
+		{
+			name:        "exactly at threshold",
+			torrent:     Torrent{State: StateStalledDL, LastActivity: ago(threshold)},
+			want:        threshold,
+			wantStalled: true,
+		},
+		{
+			name:    "LastActivity in the future",
+			torrent: Torrent{State: StateStalledDL, LastActivity: now.Add(time.Minute).Unix()},
+			want:    0,
+		},
+		{
+			name:    "old LastActivity but downloading",
+			torrent: Torrent{State: StateDownloading, LastActivity: ago(3 * time.Hour)},
+			want:    0,
+		},
+		{
+			name:    "old LastActivity but stalled seeding",
+			torrent: Torrent{State: StateStalledUP, LastActivity: ago(3 * time.Hour)},
+			want:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.torrent.StalledDuration(now); got != tt.want {
+				t.Errorf("StalledDuration() = %s, want %s", got, tt.want)
+			}
+			if got := tt.torrent.IsStalledFor(threshold, now); got != tt.wantStalled {
+				t.Errorf("IsStalledFor(%s) = %t, want %t", threshold, got, tt.wantStalled)
+			}
+		})
+	}
+}
+
+func TestEstimatedETAPartialSelection(t *testing.T) {
+	// 10 GiB torrent with only 4 GiB of files selected, 3 GiB of them done
+	torrent := Torrent{
+		TotalSize:  10 << 30,
+		Size:       4 << 30,
+		AmountLeft: 1 << 30,
+		Progress:   0.75,
+		Dlspeed:    1 << 20,
+	}
+
+	if got, want := torrent.GetRemainingBytes(), int64(1<<30); got != want {
+		t.Errorf("GetRemainingBytes() = %d, want %d", got, want)
+	}
+	if got, want := torrent.GetCompletedBytes(), int64(3<<30); got != want {
+		t.Errorf("GetCompletedBytes() = %d, want %d", got, want)
+	}
+	// 1 GiB left at 1 MiB/s; the deselected 6 GiB must not count
+	if got, want := torrent.GetEstimatedETA(), int64(1024); got != want {
+		t.Errorf("GetEstimatedETA() = %d, want %d", got, want)
+	}
+
+	torrent.Dlspeed = 0
+	if got := torrent.GetEstimatedETA(); got != 0 {
+		t.Errorf("GetEstimatedETA() without download speed = %d, want 0", got)
+	}
+}
@@ -0,0 +1,116 @@
+package qbittorrent
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Web API versions that features depend on
+const (
+	APIVersionShareLimits = "2.0.1" // torrents/setShareLimits
+)
+
+// GetAppVersion returns the qBittorrent version, e.g. "v4.6.2". The value is
+// fetched once and cached, since it cannot change while qBittorrent is running.
+func (c *Client) GetAppVersion(ctx context.Context) (string, error) {
+	return c.cachedVersion(ctx, "/api/v2/app/version", &c.appVersion, "application")
+}
+
+// GetAPIVersion returns the qBittorrent Web API version, e.g. "2.9.3". The value
+// is fetched once and cached, since it cannot change while qBittorrent is running.
+func (c *Client) GetAPIVersion(ctx context.Context) (string, error) {
+	return c.cachedVersion(ctx, "/api/v2/app/webapiVersion", &c.apiVersion, "Web API")
+}
+
+// RequireAPIVersion returns an error naming the feature when the Web API is
+// older than minimum. If the version cannot be fetched the feature is assumed
+// to be available, so the request itself reports the real problem.
+func (c *Client) RequireAPIVersion(ctx context.Context, minimum, feature string) error {
+	version, err := c.GetAPIVersion(ctx)
+	if err != nil {
+		return nil
+	}
+	if CompareVersions(version, minimum) < 0 {
+		c.logger.WithFields(map[string]interface{}{
+			"feature":     feature,
+			"api_version": version,
+			"required":    minimum,
+		}).Warn("qBittorrent Web API is too old for feature")
+		return fmt.Errorf("%s requires qBittorrent Web API %s or newer, but the server has %s", feature, minimum, version)
+	}
+	return nil
+}
+
+// cachedVersion returns the plain-text version served by endpoint, fetching it
+// on first use
+func (c *Client) cachedVersion(ctx context.Context, endpoint string, cached *string, kind string) (string, error) {
+	c.versionMutex.Lock()
+	version := *cached
+	c.versionMutex.Unlock()
+	if version != "" {
+		return version, nil
+	}
+
+	if err := c.ensureAuthenticated(ctx); err != nil {
+		return "", err
+	}
+
+	var body []byte
+	if err := c.makeRequest(ctx, "GET", endpoint, nil, &body); err != nil {
+		c.logger.WithError(err).Errorf("Failed to fetch %s version", kind)
+		return "", fmt.Errorf("failed to fetch %s version: %w", kind, err)
+	}
+	version = strings.TrimSpace(string(body))
+
+	c.versionMutex.Lock()
+	*cached = version
+	c.versionMutex.Unlock()
+
+	c.logger.WithField("version", version).Debugf("qBittorrent %s version fetched", kind)
+	return version, nil
+}
+
+// clearVersions forgets the cached versions, e.g. after qBittorrent restarted
+func (c *Client) clearVersions() {
+	c.versionMutex.Lock()
+	defer c.versionMutex.Unlock()
+	c.appVersion = ""
+	c.apiVersion = ""
+}
+
+// CompareVersions compares dotted version numbers such as "2.9.3" or "v4.6.2",
+// returning -1, 0 or 1. Missing components count as 0, and anything after the
+// leading digits of a component (e.g. "beta1") is ignored.
+func CompareVersions(a, b string) int {
+	partsA := strings.Split(strings.TrimPrefix(strings.TrimSpace(a), "v"), ".")
+	partsB := strings.Split(strings.TrimPrefix(strings.TrimSpace(b), "v"), ".")
+
+	for i := 0; i < max(len(partsA), len(partsB)); i++ {
+		numA, numB := versionPart(partsA, i), versionPart(partsB, i)
+		if numA != numB {
+			if numA < numB {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// versionPart returns the numeric value of the i-th version component, or 0
+func versionPart(parts []string, i int) int {
+	if i >= len(parts) {
+		return 0
+	}
+	digits := parts[i]
+	for j, r := range digits {
+		if r < '0' || r > '9' {
+			digits = digits[:j]
+			break
+		}
+	}
+	n, _ := strconv.Atoi(digits)
+	return n
+}
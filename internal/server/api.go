@@ -0,0 +1,211 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/raainshe/akira/internal/cli"
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/qbittorrent"
+)
+
+// maxRequestBodySize bounds the JSON body of API requests
+const maxRequestBodySize = 1024 * 1024
+
+// addTorrentBody is the JSON body of POST /torrents
+type addTorrentBody struct {
+	MagnetURI string `json:"magnet_uri"`
+	Category  string `json:"category,omitempty"`
+	Paused    bool   `json:"paused,omitempty"`
+}
+
+// torrentResult identifies the torrent an API request acted on
+type torrentResult struct {
+	Hash         string               `json:"hash"`
+	Name         string               `json:"name"`
+	Torrent      *qbittorrent.Torrent `json:"torrent,omitempty"`       // Full details when qBittorrent already lists the torrent
+	DeletedFiles bool                 `json:"deleted_files,omitempty"` // Whether the downloaded files were deleted too
+	Warning      string               `json:"warning,omitempty"`
+}
+
+// errorBody is the JSON body of failed API requests
+type errorBody struct {
+	Error string `json:"error"`
+}
+
+// requireToken wraps a handler so that requests must send the token as a
+// bearer token. An empty token disables authentication.
+func requireToken(token string, next http.Handler) http.Handler {
+	if token == "" {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		given, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="akira"`)
+			writeError(w, http.StatusUnauthorized, errors.New("missing or invalid bearer token"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handleListTorrents lists torrents, optionally filtered by the category, tag,
+// state and search query parameters
+func (s *Server) handleListTorrents(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := &core.TorrentFilter{
+		Category:    query.Get("category"),
+		Tag:         query.Get("tag"),
+		State:       qbittorrent.TorrentState(query.Get("state")),
+		NamePattern: query.Get("search"),
+	}
+
+	torrents, err := s.torrentService.GetTorrents(r.Context(), filter)
+	switch {
+	case errors.Is(err, core.ErrInvalidPattern):
+		writeError(w, http.StatusBadRequest, err)
+		return
+	case err != nil:
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	if torrents == nil {
+		torrents = []qbittorrent.Torrent{}
+	}
+	writeJSON(w, http.StatusOK, torrents)
+}
+
+// handleAddTorrent adds a magnet from the JSON body and starts seeding tracking for it
+func (s *Server) handleAddTorrent(w http.ResponseWriter, r *http.Request) {
+	var body addTorrentBody
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	magnetInfo, err := cli.ExtractMagnetInfo(body.MagnetURI)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	torrent, err := s.torrentService.AddMagnet(r.Context(), &core.AddTorrentRequest{
+		MagnetURI: body.MagnetURI,
+		Category:  strings.ToLower(body.Category),
+		Paused:    body.Paused,
+	})
+	var existsErr *core.TorrentExistsError
+	switch {
+	case errors.As(err, &existsErr):
+		writeError(w, http.StatusConflict, err)
+		return
+	case errors.Is(err, core.ErrInvalidMagnet), errors.Is(err, core.ErrInvalidCategory):
+		writeError(w, http.StatusBadRequest, err)
+		return
+	case err != nil:
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	result := torrentResult{Hash: core.NormalizeInfoHash(magnetInfo.Hash), Name: magnetInfo.DisplayName, Torrent: torrent}
+	if torrent != nil {
+		result.Hash = torrent.Hash
+		result.Name = torrent.Name
+	}
+
+	if err := s.seedingService.StartTracking(r.Context(), result.Hash, result.Name); err != nil {
+		result.Warning = fmt.Sprintf("failed to start seeding tracking: %v", err)
+	}
+
+	s.logger.WithFields(map[string]interface{}{
+		"hash":        result.Hash,
+		"name":        result.Name,
+		"remote_addr": r.RemoteAddr,
+	}).Info("Torrent added through the API")
+
+	writeJSON(w, http.StatusCreated, result)
+}
+
+// handleDeleteTorrent deletes a torrent and stops its seeding tracking. The
+// downloaded files are only deleted with ?delete_files=true.
+func (s *Server) handleDeleteTorrent(w http.ResponseWriter, r *http.Request) {
+	deleteFiles := false
+	if value := r.URL.Query().Get("delete_files"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid delete_files value '%s'", value))
+			return
+		}
+		deleteFiles = parsed
+	}
+
+	torrent, err := s.torrentService.FindTorrentByHash(r.Context(), r.PathValue("hash"))
+	switch {
+	case errors.Is(err, core.ErrTorrentNotFound):
+		writeError(w, http.StatusNotFound, err)
+		return
+	case err != nil:
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	if err := s.torrentService.DeleteTorrents(r.Context(), []string{torrent.Hash}, deleteFiles); err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	result := torrentResult{Hash: torrent.Hash, Name: torrent.Name, DeletedFiles: deleteFiles}
+	if err := s.seedingService.StopTracking(torrent.Hash); err != nil {
+		result.Warning = fmt.Sprintf("failed to stop seeding tracking: %v", err)
+	}
+
+	s.logger.WithFields(map[string]interface{}{
+		"hash":         torrent.Hash,
+		"name":         torrent.Name,
+		"delete_files": deleteFiles,
+		"remote_addr":  r.RemoteAddr,
+	}).Info("Torrent deleted through the API")
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// handleDisk returns the disk space summary of all configured paths
+func (s *Server) handleDisk(w http.ResponseWriter, r *http.Request) {
+	summary, err := s.diskService.GetAllDiskSpaces(r.Context())
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, summary)
+}
+
+// handleSeeding returns the seeding status of all tracked torrents
+func (s *Server) handleSeeding(w http.ResponseWriter, r *http.Request) {
+	status, err := s.seedingService.GetSeedingStatus(r.Context())
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+// writeJSON writes value as the JSON response body
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+// writeError writes err as a JSON error response
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorBody{Error: err.Error()})
+}
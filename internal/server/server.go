@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/logging"
+)
+
+const (
+	// eventBufferSize is how many events a slow client may fall behind before events are dropped
+	eventBufferSize = 64
+
+	// keepAliveInterval is how often an SSE comment is sent to keep idle connections open
+	keepAliveInterval = 30 * time.Second
+
+	// shutdownTimeout bounds how long the server waits for handlers to finish
+	shutdownTimeout = 5 * time.Second
+)
+
+// Server is the HTTP server used by 'akira serve'
+type Server struct {
+	addr           string
+	authToken      string
+	events         *core.EventBus
+	torrentService *core.TorrentService
+	diskService    *core.DiskService
+	seedingService *core.SeedingService
+	mux            *http.ServeMux
+	logger         *logging.Logger
+}
+
+// NewServer creates a server listening on addr that streams events from bus
+// and exposes the services as a JSON API. When authToken is set, every request
+// must send it as a bearer token.
+func NewServer(addr, authToken string, bus *core.EventBus, torrentService *core.TorrentService,
+	diskService *core.DiskService, seedingService *core.SeedingService) *Server {
+	s := &Server{
+		addr:           addr,
+		authToken:      authToken,
+		events:         bus,
+		torrentService: torrentService,
+		diskService:    diskService,
+		seedingService: seedingService,
+		mux:            http.NewServeMux(),
+		logger:         logging.GetServerLogger(),
+	}
+
+	s.mux.HandleFunc("/events", s.handleEvents)
+	s.mux.HandleFunc("GET /torrents", s.handleListTorrents)
+	s.mux.HandleFunc("POST /torrents", s.handleAddTorrent)
+	s.mux.HandleFunc("DELETE /torrents/{hash}", s.handleDeleteTorrent)
+	s.mux.HandleFunc("GET /disk", s.handleDisk)
+	s.mux.HandleFunc("GET /seeding", s.handleSeeding)
+
+	return s
+}
+
+// Run serves requests until the context is cancelled, then shuts down gracefully.
+// Open event streams are closed when the context is cancelled.
+func (s *Server) Run(ctx context.Context) error {
+	httpServer := &http.Server{
+		Addr:              s.addr,
+		Handler:           requireToken(s.authToken, s.mux),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		s.logger.WithField("addr", s.addr).Info("HTTP server listening")
+		errChan <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errChan:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return fmt.Errorf("HTTP server failed: %w", err)
+	case <-ctx.Done():
+	}
+
+	s.logger.Info("Shutting down HTTP server")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down HTTP server: %w", err)
+	}
+	return nil
+}
+
+// handleEvents streams events to the client as Server-Sent Events. Each event is
+// sent with its type as the SSE event name and the JSON-encoded event as data.
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	events, unsubscribe := s.events.Subscribe(eventBufferSize)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	s.logger.WithFields(map[string]interface{}{
+		"remote_addr": r.RemoteAddr,
+		"clients":     s.events.SubscriberCount(),
+	}).Info("Event stream client connected")
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			s.logger.WithField("remote_addr", r.RemoteAddr).Info("Event stream client disconnected")
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case event, open := <-events:
+			if !open {
+				return
+			}
+
+			data, err := json.Marshal(event)
+			if err != nil {
+				s.logger.WithError(err).Error("Failed to marshal event")
+				continue
+			}
+
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
@@ -2,12 +2,16 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/raainshe/akira/internal/cache"
 	"github.com/raainshe/akira/internal/config"
 	"github.com/raainshe/akira/internal/core"
 	"github.com/raainshe/akira/internal/qbittorrent"
@@ -51,13 +55,8 @@ type (
 	tickMsg time.Time
 
 	// Data update messages
-	torrentsUpdatedMsg struct {
-		torrents []qbittorrent.Torrent
-		err      error
-	}
-
 	statsUpdatedMsg struct {
-		stats *shared.AppStats
+		stats *core.TorrentStats
 		err   error
 	}
 
@@ -71,6 +70,37 @@ type (
 		err    error
 	}
 
+	dashboardUpdatedMsg struct {
+		data *qbittorrent.DashboardData
+		err  error
+	}
+
+	torrentLimitsUpdatedMsg struct {
+		err error
+	}
+
+	torrentActionDoneMsg struct {
+		err error
+	}
+
+	seedingPauseToggledMsg struct {
+		err error
+	}
+
+	logOrderSavedMsg struct {
+		err error
+	}
+
+	reconnectedMsg struct {
+		err error
+	}
+
+	versionFetchedMsg struct {
+		appVersion string
+		apiVersion string
+		err        error
+	}
+
 	// Navigation messages
 	switchViewMsg ViewType
 
@@ -82,6 +112,13 @@ type (
 
 // Note: CachedData and AppStats are now defined in types.go
 
+// speedHistorySize is how many speed samples the dashboard graph keeps
+const speedHistorySize = 60
+
+// fetchTimeout bounds a single data fetch, so a stalled qBittorrent shows an
+// error instead of leaving the view stale; the next tick tries again
+const fetchTimeout = 10 * time.Second
+
 // AppModel is the main TUI model
 type AppModel struct {
 	// Context and services
@@ -91,6 +128,7 @@ type AppModel struct {
 	diskService    *core.DiskService
 	seedingService *core.SeedingService
 	qbClient       *qbittorrent.Client
+	cacheManager   *cache.CacheManager
 
 	// UI state
 	currentView ViewType
@@ -100,15 +138,22 @@ type AppModel struct {
 
 	// Data and caching
 	cache         *shared.CachedData
+	cachedAt      time.Time // When the torrents reloaded from disk were fetched; zero once fresh data arrived
 	updatesPaused bool
+	reconnecting  bool // qBittorrent is unreachable and the app is waiting for it to come back
 	lastTick      time.Time
 
-	// Sub-models
-	dashboard models.DashboardModel
-	torrents  models.TorrentsModel
-	seeding   models.SeedingModel
-	disk      models.DiskModel
-	logs      models.LogsModel
+	// qBittorrent versions shown in the status bar, empty until fetched
+	qbVersion  string
+	apiVersion string
+
+	// Sub-models, shared by pointer so the selection and scroll state that
+	// View adjusts survive between frames
+	dashboard *models.DashboardModel
+	torrents  *models.TorrentsModel
+	seeding   *models.SeedingModel
+	disk      *models.DiskModel
+	logs      *models.LogsModel
 
 	// Error handling
 	lastError      error
@@ -117,15 +162,17 @@ type AppModel struct {
 
 // NewAppModel creates a new TUI application model
 func NewAppModel(ctx context.Context, config *config.Config, torrentService *core.TorrentService,
-	diskService *core.DiskService, seedingService *core.SeedingService, qbClient *qbittorrent.Client) *AppModel {
+	diskService *core.DiskService, seedingService *core.SeedingService, qbClient *qbittorrent.Client,
+	cacheManager *cache.CacheManager) *AppModel {
 
-	return &AppModel{
+	m := &AppModel{
 		ctx:            ctx,
 		config:         config,
 		torrentService: torrentService,
 		diskService:    diskService,
 		seedingService: seedingService,
 		qbClient:       qbClient,
+		cacheManager:   cacheManager,
 		currentView:    DashboardView,
 		cache: &shared.CachedData{
 			LastFetch: map[string]time.Time{
@@ -133,25 +180,62 @@ func NewAppModel(ctx context.Context, config *config.Config, torrentService *cor
 				"stats":    time.Time{},
 				"disk":     time.Time{},
 				"seeding":  time.Time{},
+				"server":   time.Time{},
 			},
 		},
 		// Initialize sub-models
-		dashboard: models.NewDashboardModel(),
-		torrents:  models.NewTorrentsModel(),
+		dashboard: models.NewDashboardModel(config.UI),
+		torrents:  models.NewTorrentsModel(config.Categories(), torrentService.TorrentCategory, config.QBittorrent.StalledThreshold, config.UI),
 		seeding:   models.NewSeedingModel(),
 		disk:      models.NewDiskModel(),
-		logs:      models.NewLogsModel(),
+		logs:      models.NewLogsModel(config.Logging.File, config.TUI.OldestLogsFirst(), config.UI),
+	}
+
+	m.loadPersistedCache()
+	return m
+}
+
+// loadPersistedCache shows the torrents saved by the previous run until the first
+// fetch completes. LastFetch stays zero, so fresh data is still fetched immediately.
+func (m *AppModel) loadPersistedCache() {
+	if m.cacheManager == nil {
+		return
 	}
+
+	data, err := m.cacheManager.LoadPersistedData()
+	if err != nil || data == nil || len(data.Torrents) == 0 {
+		return
+	}
+
+	m.cache.Torrents = data.Torrents
+	m.cache.ServerState = data.ServerState
+	m.cachedAt = data.SavedAt
+	m.updateStatsFromTorrents()
+}
+
+// SavePersistedCache saves the last fetched torrents for the next run. Data that
+// was only reloaded from disk is not saved again, so its timestamp stays honest.
+func (m AppModel) SavePersistedCache() error {
+	if m.cacheManager == nil || !m.cachedAt.IsZero() || m.cache.LastFetch["torrents"].IsZero() {
+		return nil
+	}
+
+	return m.cacheManager.SavePersistedData(&cache.PersistedData{
+		Torrents:    m.cache.Torrents,
+		ServerState: m.cache.ServerState,
+		SavedAt:     m.cache.LastFetch["torrents"],
+	})
 }
 
 // Init implements tea.Model
 func (m AppModel) Init() tea.Cmd {
 	return tea.Batch(
 		// Initial data fetch
-		m.fetchTorrentsCmd(),
+		m.fetchDashboardCmd(),
 		m.fetchStatsCmd(),
 		m.fetchDiskCmd(),
 		m.fetchSeedingCmd(),
+		m.fetchVersionCmd(),
 		// Start periodic updates
 		m.tickCmd(),
 	)
@@ -169,6 +253,14 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.ready = true
 
 	case tea.KeyMsg:
+		// Let the limit editor capture keys that are normally global shortcuts
+		if m.currentView == TorrentsView && m.torrents.IsEditing() {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			break
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
@@ -184,7 +276,7 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "r":
 			if !m.updatesPaused {
 				cmds = append(cmds, tea.Batch(
-					m.fetchTorrentsCmd(),
+					m.fetchDashboardCmd(),
 					m.fetchStatsCmd(),
 					m.fetchDiskCmd(),
 					m.fetchSeedingCmd(),
@@ -208,14 +300,19 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case tickMsg:
-		if !m.updatesPaused {
+		if m.reconnecting && !m.updatesPaused {
+			// Nothing can be fetched until qBittorrent is back
+			cmds = append(cmds, m.tickCmd())
+		} else if !m.updatesPaused {
 			m.lastTick = time.Time(msg)
+			m.recordSpeedSample()
 
 			// Determine what needs updating based on intervals
 			var updateCmds []tea.Cmd
 
-			if m.shouldUpdateTorrents() {
-				updateCmds = append(updateCmds, m.fetchTorrentsCmd())
+			// Torrents and server state arrive together in one incremental sync
+			if m.shouldUpdateTorrents() || m.shouldUpdateServerState() {
+				updateCmds = append(updateCmds, m.fetchDashboardCmd())
 			}
 
 			if m.shouldUpdateStats() {
@@ -236,63 +333,133 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, tea.Batch(updateCmds...))
 		}
 
-	case torrentsUpdatedMsg:
+	case statsUpdatedMsg:
 		if msg.err != nil {
-			m.lastError = msg.err
-			m.errorDisplayed = time.Now()
+			cmds = append(cmds, m.handleFetchError(msg.err))
+		} else {
+			m.applyTorrentStats(msg.stats)
+			m.cache.LastFetch["stats"] = time.Now()
+		}
+
+	case diskUpdatedMsg:
+		if msg.err != nil {
+			cmds = append(cmds, m.handleFetchError(msg.err))
+		} else {
+			m.cache.DiskInfo = msg.diskInfo
+			m.cache.LastFetch["disk"] = time.Now()
+		}
+
+	case seedingUpdatedMsg:
+		if msg.err != nil {
+			cmds = append(cmds, m.handleFetchError(msg.err))
 		} else {
-			m.cache.Torrents = msg.torrents
+			m.cache.SeedingInfo = msg.status
+			m.cache.LastFetch["seeding"] = time.Now()
+		}
+
+	case dashboardUpdatedMsg:
+		if msg.err != nil {
+			cmds = append(cmds, m.handleFetchError(msg.err))
+		} else {
+			m.cache.Torrents = msg.data.Torrents
+			m.cache.ServerState = msg.data.ServerState
+			m.cachedAt = time.Time{}
 			m.cache.LastFetch["torrents"] = time.Now()
+			m.cache.LastFetch["server"] = time.Now()
 
 			// Update stats from torrents
 			m.updateStatsFromTorrents()
 		}
 
-	case statsUpdatedMsg:
+	case reconnectedMsg:
+		m.reconnecting = false
 		if msg.err != nil {
 			m.lastError = msg.err
 			m.errorDisplayed = time.Now()
 		} else {
-			m.cache.Stats = msg.stats
-			m.cache.LastFetch["stats"] = time.Now()
+			cmds = append(cmds, tea.Batch(
+				m.fetchDashboardCmd(),
+				m.fetchStatsCmd(),
+				m.fetchDiskCmd(),
+				m.fetchSeedingCmd(),
+				m.fetchVersionCmd(),
+			))
 		}
 
-	case diskUpdatedMsg:
+	case versionFetchedMsg:
+		// Not worth an error message; the status bar simply leaves the version out
+		if msg.err == nil {
+			m.qbVersion = msg.appVersion
+			m.apiVersion = msg.apiVersion
+		}
+
+	case models.SetTorrentLimitsMsg:
+		cmds = append(cmds, m.setTorrentLimitsCmd(msg))
+
+	case models.ToggleTorrentPauseMsg:
+		cmds = append(cmds, m.toggleTorrentPauseCmd(msg))
+
+	case models.DeleteTorrentMsg:
+		cmds = append(cmds, m.deleteTorrentCmd(msg))
+
+	case torrentActionDoneMsg:
 		if msg.err != nil {
 			m.lastError = msg.err
 			m.errorDisplayed = time.Now()
 		} else {
-			m.cache.DiskInfo = msg.diskInfo
-			m.cache.LastFetch["disk"] = time.Now()
+			cmds = append(cmds, m.fetchDashboardCmd())
 		}
 
-	case seedingUpdatedMsg:
+	case models.ToggleSeedingPauseMsg:
+		cmds = append(cmds, m.toggleSeedingPauseCmd())
+
+	case models.LogOrderChangedMsg:
+		m.config.TUI.LogOrder = config.LogOrderNewestFirst
+		if msg.OldestFirst {
+			m.config.TUI.LogOrder = config.LogOrderOldestFirst
+		}
+		cmds = append(cmds, m.saveLogOrderCmd(m.config.TUI.LogOrder))
+
+	case logOrderSavedMsg:
+		if msg.err != nil {
+			m.lastError = msg.err
+			m.errorDisplayed = time.Now()
+		}
+
+	case seedingPauseToggledMsg:
 		if msg.err != nil {
 			m.lastError = msg.err
 			m.errorDisplayed = time.Now()
 		} else {
-			m.cache.SeedingInfo = msg.status
-			m.cache.LastFetch["seeding"] = time.Now()
+			cmds = append(cmds, m.fetchSeedingCmd())
+		}
+
+	case torrentLimitsUpdatedMsg:
+		if msg.err != nil {
+			m.lastError = msg.err
+			m.errorDisplayed = time.Now()
+		} else {
+			cmds = append(cmds, m.fetchDashboardCmd())
 		}
 	}
 
 	// Update current view model
 	switch m.currentView {
 	case DashboardView:
-		m.dashboard, cmd = m.dashboard.Update(msg)
+		cmd = m.dashboard.Update(msg)
 		cmds = append(cmds, cmd)
 	case TorrentsView:
-		m.torrents, cmd = m.torrents.Update(msg)
+		cmd = m.torrents.Update(msg, m.cache)
 		cmds = append(cmds, cmd)
 
 	case SeedingView:
-		m.seeding, cmd = m.seeding.Update(msg)
+		cmd = m.seeding.Update(msg, m.cache)
 		cmds = append(cmds, cmd)
 	case DiskView:
-		m.disk, cmd = m.disk.Update(msg)
+		cmd = m.disk.Update(msg)
 		cmds = append(cmds, cmd)
 	case LogsView:
-		m.logs, cmd = m.logs.Update(msg)
+		cmd = m.logs.Update(msg)
 		cmds = append(cmds, cmd)
 	}
 
@@ -329,12 +496,23 @@ func (m AppModel) renderHeader() string {
 	warningStyle := lipgloss.NewStyle().Foreground(styles.Warning)
 	successStyle := lipgloss.NewStyle().Foreground(styles.Success)
 
-	if m.updatesPaused {
+	if m.reconnecting {
+		status = lipgloss.NewStyle().Foreground(styles.Error).Render("🔌 RECONNECTING")
+	} else if m.updatesPaused {
 		status = warningStyle.Render("⏸️  PAUSED")
 	} else {
 		status = successStyle.Render("🔄 LIVE")
 	}
 
+	if m.cache.ServerState != nil && m.cache.ServerState.UseAltSpeedLimits {
+		status = warningStyle.Render("🐢 ALT SPEED") + "  " + status
+	}
+
+	if !m.cachedAt.IsZero() {
+		age := time.Since(m.cachedAt).Round(time.Minute)
+		status = warningStyle.Render(fmt.Sprintf("📦 CACHED (%s old)", age)) + "  " + status
+	}
+
 	headerContent := lipgloss.JoinHorizontal(lipgloss.Center,
 		title,
 		lipgloss.NewStyle().Width(m.width-len(title)-len(status)-4).Render(""),
@@ -452,7 +630,7 @@ func (m AppModel) renderStatusBar() string {
 	var parts []string
 
 	// Current time
-	parts = append(parts, time.Now().Format("15:04:05"))
+	parts = append(parts, m.config.UI.FormatClock(time.Now()))
 
 	// Update status
 	if m.updatesPaused {
@@ -462,6 +640,11 @@ func (m AppModel) renderStatusBar() string {
 			time.Since(m.lastTick).Truncate(time.Second)))
 	}
 
+	// qBittorrent version
+	if m.qbVersion != "" {
+		parts = append(parts, fmt.Sprintf("qBittorrent %s (API %s)", m.qbVersion, m.apiVersion))
+	}
+
 	// Error display
 	if m.lastError != nil && time.Since(m.errorDisplayed) < 5*time.Second {
 		errorStyle := lipgloss.NewStyle().Foreground(styles.Error)
@@ -530,6 +713,27 @@ func (m AppModel) shouldUpdateSeeding() bool {
 	return time.Since(lastFetch) > 5*time.Second
 }
 
+func (m AppModel) shouldUpdateServerState() bool {
+	lastFetch := m.cache.LastFetch["server"]
+	if lastFetch.IsZero() {
+		return true
+	}
+	return time.Since(lastFetch) > 5*time.Second
+}
+
+// handleFetchError shows a failed fetch and, when qBittorrent has become
+// unreachable, starts waiting for it to come back
+func (m *AppModel) handleFetchError(err error) tea.Cmd {
+	m.lastError = err
+	m.errorDisplayed = time.Now()
+
+	if m.reconnecting || m.qbClient == nil || m.qbClient.Health().Connected {
+		return nil
+	}
+	m.reconnecting = true
+	return m.waitForConnectionCmd()
+}
+
 // Command generators
 func (m AppModel) tickCmd() tea.Cmd {
 	return tea.Tick(m.getUpdateInterval(), func(t time.Time) tea.Msg {
@@ -537,17 +741,93 @@ func (m AppModel) tickCmd() tea.Cmd {
 	})
 }
 
-func (m AppModel) fetchTorrentsCmd() tea.Cmd {
+func (m AppModel) setTorrentLimitsCmd(limits models.SetTorrentLimitsMsg) tea.Cmd {
+	return func() tea.Msg {
+		hashes := []string{limits.Hash}
+		if err := m.torrentService.SetTorrentDownloadLimit(m.ctx, hashes, limits.DownloadLimit); err != nil {
+			return torrentLimitsUpdatedMsg{err: err}
+		}
+		if err := m.torrentService.SetTorrentUploadLimit(m.ctx, hashes, limits.UploadLimit); err != nil {
+			return torrentLimitsUpdatedMsg{err: err}
+		}
+		return torrentLimitsUpdatedMsg{}
+	}
+}
+
+func (m AppModel) toggleTorrentPauseCmd(toggle models.ToggleTorrentPauseMsg) tea.Cmd {
+	return func() tea.Msg {
+		hashes := []string{toggle.Hash}
+		if toggle.Resume {
+			return torrentActionDoneMsg{err: m.torrentService.ResumeTorrents(m.ctx, hashes)}
+		}
+		return torrentActionDoneMsg{err: m.torrentService.PauseTorrents(m.ctx, hashes)}
+	}
+}
+
+// saveLogOrderCmd stores the logs view order as TUI_LOG_ORDER in the config
+// file. Without a config file (environment variables only) it only lasts for
+// this session.
+func (m AppModel) saveLogOrderCmd(order string) tea.Cmd {
+	return func() tea.Msg {
+		err := config.SetConfigValue(config.ConfigPath(), "TUI_LOG_ORDER", order)
+		if errors.Is(err, fs.ErrNotExist) {
+			err = nil
+		}
+		return logOrderSavedMsg{err: err}
+	}
+}
+
+func (m AppModel) deleteTorrentCmd(deletion models.DeleteTorrentMsg) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.torrentService.DeleteTorrents(m.ctx, []string{deletion.Hash}, deletion.DeleteFiles); err != nil {
+			return torrentActionDoneMsg{err: err}
+		}
+		// Best effort, the torrent may not have been tracked
+		_ = m.seedingService.StopTracking(deletion.Hash)
+		return torrentActionDoneMsg{}
+	}
+}
+
+func (m AppModel) waitForConnectionCmd() tea.Cmd {
+	return func() tea.Msg {
+		return reconnectedMsg{err: m.qbClient.WaitForConnection(m.ctx)}
+	}
+}
+
+// fetchContext returns a context for one fetch, cancelled after fetchTimeout
+func (m AppModel) fetchContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(m.ctx, fetchTimeout)
+}
+
+// fetchError names the data that could not be fetched in time; other errors are returned as is
+func fetchError(what string, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("fetching %s timed out after %s: %w", what, fetchTimeout, err)
+	}
+	return err
+}
+
+func (m AppModel) fetchVersionCmd() tea.Cmd {
 	return func() tea.Msg {
-		torrents, err := m.torrentService.GetTorrents(m.ctx, &core.TorrentFilter{})
-		return torrentsUpdatedMsg{torrents: torrents, err: err}
+		ctx, cancel := m.fetchContext()
+		defer cancel()
+
+		appVersion, err := m.qbClient.GetAppVersion(ctx)
+		if err != nil {
+			return versionFetchedMsg{err: err}
+		}
+		apiVersion, err := m.qbClient.GetAPIVersion(ctx)
+		return versionFetchedMsg{appVersion: appVersion, apiVersion: apiVersion, err: err}
 	}
 }
 
 func (m AppModel) fetchStatsCmd() tea.Cmd {
 	return func() tea.Msg {
-		// This will be calculated from torrents for now
-		return statsUpdatedMsg{stats: m.cache.Stats, err: nil}
+		ctx, cancel := m.fetchContext()
+		defer cancel()
+
+		stats, err := m.torrentService.GetTorrentStats(ctx)
+		return statsUpdatedMsg{stats: stats, err: fetchError("statistics", err)}
 	}
 }
 
@@ -555,21 +835,21 @@ func (m AppModel) fetchDiskCmd() tea.Cmd {
 	return func() tea.Msg {
 		diskInfo := make(map[string]*core.DiskInfo)
 
-		// Get disk space for all configured paths
-		paths := []string{
-			m.config.QBittorrent.SavePaths.Default,
-			m.config.QBittorrent.SavePaths.Series,
-			m.config.QBittorrent.SavePaths.Movies,
-			m.config.QBittorrent.SavePaths.Anime,
+		ctx, cancel := m.fetchContext()
+		defer cancel()
+
+		// Show each physical disk once, labelled with the categories stored on it
+		disks, err := m.diskService.GetPhysicalDisks(ctx)
+		if err != nil {
+			return diskUpdatedMsg{err: fetchError("disk space", err)}
 		}
 
-		for _, path := range paths {
-			if path != "" {
-				space, err := m.diskService.GetDiskSpace(m.ctx, path)
-				if err == nil {
-					diskInfo[path] = space
-				}
+		for _, disk := range disks {
+			label := disk.MountPoint
+			if len(disk.Categories) > 0 {
+				label = fmt.Sprintf("%s (%s)", disk.MountPoint, strings.Join(disk.Categories, ", "))
 			}
+			diskInfo[label] = disk.Info
 		}
 
 		return diskUpdatedMsg{diskInfo: diskInfo, err: nil}
@@ -578,11 +858,79 @@ func (m AppModel) fetchDiskCmd() tea.Cmd {
 
 func (m AppModel) fetchSeedingCmd() tea.Cmd {
 	return func() tea.Msg {
-		status, err := m.seedingService.GetSeedingStatus(m.ctx)
-		return seedingUpdatedMsg{status: status, err: err}
+		ctx, cancel := m.fetchContext()
+		defer cancel()
+
+		status, err := m.seedingService.GetSeedingStatus(ctx)
+		return seedingUpdatedMsg{status: status, err: fetchError("seeding status", err)}
+	}
+}
+
+func (m AppModel) toggleSeedingPauseCmd() tea.Cmd {
+	return func() tea.Msg {
+		paused, _ := m.seedingService.PauseState()
+		if paused {
+			return seedingPauseToggledMsg{err: m.seedingService.Resume()}
+		}
+		return seedingPauseToggledMsg{err: m.seedingService.Pause()}
+	}
+}
+
+// fetchDashboardCmd refreshes torrents and server state with an incremental sync,
+// so only the changes since the last refresh are transferred
+func (m AppModel) fetchDashboardCmd() tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := m.fetchContext()
+		defer cancel()
+
+		torrents, state, err := m.torrentService.SyncTorrents(ctx)
+		if err != nil {
+			return dashboardUpdatedMsg{err: fetchError("torrents", err)}
+		}
+		return dashboardUpdatedMsg{data: &qbittorrent.DashboardData{Torrents: torrents, ServerState: state}}
 	}
 }
 
+// recordSpeedSample adds the current total speeds to the dashboard graph history
+func (m *AppModel) recordSpeedSample() {
+	if m.cache.Stats == nil {
+		return
+	}
+
+	m.cache.SpeedHistory = append(m.cache.SpeedHistory, shared.SpeedSample{
+		DownSpeed: m.cache.Stats.TotalDownSpeed,
+		UpSpeed:   m.cache.Stats.TotalUpSpeed,
+	})
+	if len(m.cache.SpeedHistory) > speedHistorySize {
+		m.cache.SpeedHistory = m.cache.SpeedHistory[len(m.cache.SpeedHistory)-speedHistorySize:]
+	}
+}
+
+// applyTorrentStats stores the totals calculated by the torrent service. The
+// counts and speeds come from the more frequent torrent sync once it has run.
+func (m *AppModel) applyTorrentStats(torrentStats *core.TorrentStats) {
+	if torrentStats == nil {
+		return
+	}
+
+	if m.cache.Stats == nil {
+		m.cache.Stats = &shared.AppStats{
+			TotalTorrents:   torrentStats.Total,
+			ActiveDownloads: torrentStats.Downloading,
+			ActiveSeeds:     torrentStats.Seeding,
+			PausedTorrents:  torrentStats.Paused,
+			ErroredTorrents: torrentStats.Error,
+			TotalDownSpeed:  torrentStats.DownloadSpeed,
+			TotalUpSpeed:    torrentStats.UploadSpeed,
+			LastUpdate:      time.Now(),
+		}
+	}
+
+	m.cache.Stats.TotalSize = torrentStats.TotalSize
+	m.cache.Stats.TotalDownloaded = torrentStats.Downloaded
+	m.cache.Stats.TotalUploaded = torrentStats.Uploaded
+}
+
 // updateStatsFromTorrents calculates stats from torrent data
 func (m *AppModel) updateStatsFromTorrents() {
 	if len(m.cache.Torrents) == 0 {
@@ -593,6 +941,13 @@ func (m *AppModel) updateStatsFromTorrents() {
 		LastUpdate: time.Now(),
 	}
 
+	// Byte totals only come from the stats fetch, keep the last ones
+	if m.cache.Stats != nil {
+		stats.TotalSize = m.cache.Stats.TotalSize
+		stats.TotalDownloaded = m.cache.Stats.TotalDownloaded
+		stats.TotalUploaded = m.cache.Stats.TotalUploaded
+	}
+
 	for _, torrent := range m.cache.Torrents {
 		stats.TotalTorrents++
 		stats.TotalDownSpeed += torrent.Dlspeed
@@ -2,11 +2,13 @@ package models
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/raainshe/akira/internal/config"
 	"github.com/raainshe/akira/internal/qbittorrent"
 	"github.com/raainshe/akira/internal/tui/shared"
 	"github.com/raainshe/akira/internal/tui/styles"
@@ -15,15 +17,16 @@ import (
 // DashboardModel represents the dashboard view
 type DashboardModel struct {
 	scrollOffset int
+	ui           config.UIConfig
 }
 
 // NewDashboardModel creates a new dashboard model
-func NewDashboardModel() DashboardModel {
-	return DashboardModel{}
+func NewDashboardModel(ui config.UIConfig) *DashboardModel {
+	return &DashboardModel{ui: ui}
 }
 
 // Update implements tea.Model for dashboard
-func (m DashboardModel) Update(msg tea.Msg) (DashboardModel, tea.Cmd) {
+func (m *DashboardModel) Update(msg tea.Msg) tea.Cmd {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
@@ -50,13 +53,13 @@ func (m DashboardModel) Update(msg tea.Msg) (DashboardModel, tea.Cmd) {
 			m.scrollOffset += 5
 		}
 	}
-	return m, nil
+	return nil
 }
 
 // Note: CachedData and AppStats are defined in app.go to avoid circular imports
 
 // View renders the dashboard view
-func (m DashboardModel) View(cache interface{}, width, height int) string {
+func (m *DashboardModel) View(cache interface{}, width, height int) string {
 	// Type assert the cache
 	appCache, ok := cache.(*shared.CachedData)
 	if !ok {
@@ -77,6 +80,9 @@ func (m DashboardModel) View(cache interface{}, width, height int) string {
 	// System status section
 	sections = append(sections, m.renderSystemStatus(appCache, width))
 
+	// Connection section
+	sections = append(sections, m.renderConnection(appCache, width))
+
 	// Join all content
 	fullContent := lipgloss.JoinVertical(lipgloss.Left, sections...)
 
@@ -84,7 +90,7 @@ func (m DashboardModel) View(cache interface{}, width, height int) string {
 	return m.applyScrolling(fullContent, width, height)
 }
 
-func (m DashboardModel) renderOverview(cache *shared.CachedData, width int) string {
+func (m *DashboardModel) renderOverview(cache *shared.CachedData, width int) string {
 	title := "📊 Torrent Overview"
 
 	var stats []string
@@ -112,18 +118,39 @@ func (m DashboardModel) renderOverview(cache *shared.CachedData, width int) stri
 		infoStyle := lipgloss.NewStyle().Foreground(styles.Info).Bold(true)
 		successStyle := lipgloss.NewStyle().Foreground(styles.Success).Bold(true)
 
+		downLine := fmt.Sprintf("⬇️  Download Speed: %s", infoStyle.Render(fmt.Sprintf("%-12s", downSpeed)))
+		upLine := fmt.Sprintf("⬆️  Upload Speed:   %s", successStyle.Render(fmt.Sprintf("%-12s", upSpeed)))
+
+		// Speed history graphs, fitted to the space left on the line
+		graphWidth := min(int(float64(width)*0.95)-lipgloss.Width(downLine)-6, len(cache.SpeedHistory))
+		if graphWidth > 1 {
+			downSpeeds := make([]int64, len(cache.SpeedHistory))
+			upSpeeds := make([]int64, len(cache.SpeedHistory))
+			for i, sample := range cache.SpeedHistory {
+				downSpeeds[i] = sample.DownSpeed
+				upSpeeds[i] = sample.UpSpeed
+			}
+			downLine += " " + infoStyle.UnsetBold().Render(sparkline(downSpeeds, graphWidth))
+			upLine += " " + successStyle.UnsetBold().Render(sparkline(upSpeeds, graphWidth))
+		}
+
 		stats = append(stats, "")
-		stats = append(stats,
-			fmt.Sprintf("⬇️  Download Speed: %s", infoStyle.Render(downSpeed)),
-			fmt.Sprintf("⬆️  Upload Speed: %s", successStyle.Render(upSpeed)),
-		)
+		stats = append(stats, downLine, upLine)
+
+		// Byte totals, once the torrent service has reported them
+		if cache.Stats.TotalSize > 0 || cache.Stats.TotalDownloaded > 0 || cache.Stats.TotalUploaded > 0 {
+			stats = append(stats, fmt.Sprintf("💾 Total Size: %s   📦 Downloaded: %s   📤 Uploaded: %s",
+				primaryStyle.Render(m.formatBytes(cache.Stats.TotalSize)),
+				infoStyle.Render(m.formatBytes(cache.Stats.TotalDownloaded)),
+				successStyle.Render(m.formatBytes(cache.Stats.TotalUploaded))))
+		}
 
 		if !cache.Stats.LastUpdate.IsZero() {
 			mutedStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
 			stats = append(stats, "")
 			stats = append(stats,
 				fmt.Sprintf("🕒 Last Updated: %s",
-					mutedStyle.Render(cache.Stats.LastUpdate.Format("15:04:05"))),
+					mutedStyle.Render(m.ui.FormatClock(cache.Stats.LastUpdate))),
 			)
 		}
 	} else {
@@ -139,7 +166,47 @@ func (m DashboardModel) renderOverview(cache *shared.CachedData, width int) stri
 	return styles.WithBorder(cardStyle, title).Render(content)
 }
 
-func (m DashboardModel) renderRecentActivity(cache *shared.CachedData, width int) string {
+// sparkBlocks are the bar heights used by sparkline, lowest first
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline renders values as a bar graph of the given width, scaled to the
+// largest value. Longer series are downsampled by averaging neighbouring values.
+func sparkline(values []int64, width int) string {
+	if len(values) == 0 || width <= 0 {
+		return ""
+	}
+
+	if len(values) > width {
+		buckets := make([]int64, width)
+		for i := range buckets {
+			start := i * len(values) / width
+			end := (i + 1) * len(values) / width
+			var sum int64
+			for _, value := range values[start:end] {
+				sum += value
+			}
+			buckets[i] = sum / int64(end-start)
+		}
+		values = buckets
+	}
+
+	var peak int64
+	for _, value := range values {
+		peak = max(peak, value)
+	}
+
+	var b strings.Builder
+	for _, value := range values {
+		level := 0
+		if peak > 0 {
+			level = int(value * int64(len(sparkBlocks)-1) / peak)
+		}
+		b.WriteRune(sparkBlocks[level])
+	}
+	return b.String()
+}
+
+func (m *DashboardModel) renderRecentActivity(cache *shared.CachedData, width int) string {
 	title := "🕒 Recent Activity"
 
 	var activities []string
@@ -205,7 +272,7 @@ func (m DashboardModel) renderRecentActivity(cache *shared.CachedData, width int
 	return styles.WithBorder(cardStyle, title).Render(content)
 }
 
-func (m DashboardModel) renderSystemStatus(cache *shared.CachedData, width int) string {
+func (m *DashboardModel) renderSystemStatus(cache *shared.CachedData, width int) string {
 	title := "💾 System Status"
 
 	var status []string
@@ -216,28 +283,16 @@ func (m DashboardModel) renderSystemStatus(cache *shared.CachedData, width int)
 		status = append(status, labelStyle.Render("Disk Usage:"))
 
 		for path, diskInfo := range cache.DiskInfo {
-			if diskInfo != nil {
-				percentage := float64(diskInfo.Used) / float64(diskInfo.Total) * 100
-
-				var healthColor lipgloss.Color
-				var healthText string
-
-				switch {
-				case percentage < 70:
-					healthColor = styles.Success
-					healthText = "🟢 HEALTHY"
-				case percentage < 85:
-					healthColor = styles.Warning
-					healthText = "🟡 WARNING"
-				case percentage < 95:
-					healthColor = styles.Error
-					healthText = "🟠 CRITICAL"
-				default:
-					healthColor = styles.Error
-					healthText = "🔴 FULL"
-				}
+			if diskInfo != nil && !diskInfo.HasCapacity() {
+				mutedStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
+				status = append(status,
+					fmt.Sprintf("%s: %s", m.truncateString(path, 15), mutedStyle.Render("N/A")),
+				)
+			} else if diskInfo != nil {
+				percentage := diskInfo.UsedPercent
+				healthColor, healthText := diskHealthStyle(diskInfo.Health)
 
-				progressBar := m.createProgressBar(percentage, 20)
+				progressBar := m.createProgressBar(percentage, 20, healthColor)
 				healthStyle := lipgloss.NewStyle().Foreground(healthColor).Bold(true)
 
 				status = append(status,
@@ -261,12 +316,16 @@ func (m DashboardModel) renderSystemStatus(cache *shared.CachedData, width int)
 		labelStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
 		status = append(status, labelStyle.Render("Seeding Service:"))
 
-		// We'll assume the service is running if we have seeding info
 		successStyle := lipgloss.NewStyle().Foreground(styles.Success).Bold(true)
 		primaryStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
 
+		serviceStatus := successStyle.Render("🟢 RUNNING")
+		if !cache.SeedingInfo.IsRunning {
+			serviceStatus = lipgloss.NewStyle().Foreground(styles.Error).Bold(true).Render("🔴 STOPPED")
+		}
+
 		status = append(status,
-			fmt.Sprintf("Status: %s", successStyle.Render("🟢 RUNNING")),
+			fmt.Sprintf("Status: %s", serviceStatus),
 			fmt.Sprintf("Tracked Torrents: %s",
 				primaryStyle.Render(fmt.Sprintf("%d", cache.SeedingInfo.TrackedTorrents))),
 			fmt.Sprintf("Active Seeding: %s",
@@ -295,8 +354,94 @@ func (m DashboardModel) renderSystemStatus(cache *shared.CachedData, width int)
 	return styles.WithBorder(cardStyle, title).Render(content)
 }
 
+func (m *DashboardModel) renderConnection(cache *shared.CachedData, width int) string {
+	title := "🌐 Connection"
+
+	var lines []string
+
+	if cache.ServerState != nil {
+		state := cache.ServerState
+		primaryStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
+		infoStyle := lipgloss.NewStyle().Foreground(styles.Info).Bold(true)
+		successStyle := lipgloss.NewStyle().Foreground(styles.Success).Bold(true)
+
+		var statusText string
+		var statusColor lipgloss.Color
+		switch state.ConnectionStatus {
+		case "connected":
+			statusText = "🟢 CONNECTED"
+			statusColor = styles.Success
+		case "firewalled":
+			statusText = "🟡 FIREWALLED"
+			statusColor = styles.Warning
+		default:
+			statusText = "🔴 DISCONNECTED"
+			statusColor = styles.Error
+		}
+		statusStyle := lipgloss.NewStyle().Foreground(statusColor).Bold(true)
+
+		lines = append(lines,
+			fmt.Sprintf("Status: %s", statusStyle.Render(statusText)),
+			fmt.Sprintf("DHT Nodes: %s", primaryStyle.Render(fmt.Sprintf("%d", state.DhtNodes))),
+			fmt.Sprintf("Peer Connections: %s", primaryStyle.Render(fmt.Sprintf("%d", state.TotalPeerConnections))),
+			"",
+			fmt.Sprintf("⬇️  Session Downloaded: %s", infoStyle.Render(qbittorrent.FormatBytes(state.DlInfoData))),
+			fmt.Sprintf("⬆️  Session Uploaded: %s", successStyle.Render(qbittorrent.FormatBytes(state.UpInfoData))),
+			"",
+			fmt.Sprintf("Read Cache Overload: %s", m.renderOverload(state.ReadCacheOverload)),
+			fmt.Sprintf("Write Cache Overload: %s", m.renderOverload(state.WriteCacheOverload)),
+		)
+	} else {
+		mutedStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
+		lines = append(lines, mutedStyle.Render("Loading connection status..."))
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+
+	// Use 95% of available width for cards to leave some margin
+	cardWidth := int(float64(width) * 0.95)
+	cardStyle := styles.CardStyle.Width(cardWidth).Height(10)
+	return styles.WithBorder(cardStyle, title).Render(content)
+}
+
+// renderOverload colors a cache overload percentage reported by qBittorrent
+func (m *DashboardModel) renderOverload(value string) string {
+	if value == "" {
+		value = "0"
+	}
+
+	color := styles.Success
+	if percent, err := strconv.ParseFloat(value, 64); err == nil && percent > 0 {
+		color = styles.Warning
+		if percent >= 50 {
+			color = styles.Error
+		}
+	}
+
+	return lipgloss.NewStyle().Foreground(color).Bold(true).Render(value + "%")
+}
+
 // Utility functions
-func (m DashboardModel) formatSpeed(bytesPerSecond int64) string {
+func (m *DashboardModel) formatBytes(bytes int64) string {
+	if bytes == 0 {
+		return "0 B"
+	}
+
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%d B", bytes)
+	}
+
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
+
+func (m *DashboardModel) formatSpeed(bytesPerSecond int64) string {
 	if bytesPerSecond == 0 {
 		return "0 B/s"
 	}
@@ -316,24 +461,12 @@ func (m DashboardModel) formatSpeed(bytesPerSecond int64) string {
 		float64(bytesPerSecond)/float64(div), "KMGTPE"[exp])
 }
 
-func (m DashboardModel) truncateString(s string, maxLen int) string {
-	// Use lipgloss.Width to account for character width variations (emojis, CJK, etc.)
-	if lipgloss.Width(s) <= maxLen {
-		return s
-	}
-
-	// Truncate based on actual display width
-	for i := len(s) - 1; i >= 0; i-- {
-		if lipgloss.Width(s[:i]) <= maxLen-3 {
-			return s[:i] + "..."
-		}
-	}
-
-	return "..."
+func (m *DashboardModel) truncateString(s string, maxLen int) string {
+	return truncateToWidth(s, maxLen)
 }
 
 // applyScrolling applies scrolling to content that exceeds the available height
-func (m DashboardModel) applyScrolling(content string, width, height int) string {
+func (m *DashboardModel) applyScrolling(content string, width, height int) string {
 	lines := strings.Split(content, "\n")
 	contentHeight := len(lines)
 
@@ -395,7 +528,8 @@ func (m DashboardModel) applyScrolling(content string, width, height int) string
 	return lipgloss.JoinVertical(lipgloss.Left, finalLines...)
 }
 
-func (m DashboardModel) createProgressBar(percentage float64, width int) string {
+// createProgressBar draws a disk usage bar in the color of the disk's health
+func (m *DashboardModel) createProgressBar(percentage float64, width int, color lipgloss.Color) string {
 	filled := int(percentage / 100 * float64(width))
 	if filled > width {
 		filled = width
@@ -403,21 +537,11 @@ func (m DashboardModel) createProgressBar(percentage float64, width int) string
 
 	bar := strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
 
-	var color lipgloss.Color
-	switch {
-	case percentage < 70:
-		color = styles.Success
-	case percentage < 85:
-		color = styles.Warning
-	default:
-		color = styles.Error
-	}
-
 	style := lipgloss.NewStyle().Foreground(color)
 	return style.Render(bar)
 }
 
-func (m DashboardModel) isDownloading(state qbittorrent.TorrentState) bool {
+func (m *DashboardModel) isDownloading(state qbittorrent.TorrentState) bool {
 	switch state {
 	case qbittorrent.StateDownloading, qbittorrent.StateMetaDL, qbittorrent.StateStalledDL,
 		qbittorrent.StateQueuedDL, qbittorrent.StateForcedDL, qbittorrent.StateCheckingDL,
@@ -428,7 +552,7 @@ func (m DashboardModel) isDownloading(state qbittorrent.TorrentState) bool {
 	}
 }
 
-func (m DashboardModel) isSeeding(state qbittorrent.TorrentState) bool {
+func (m *DashboardModel) isSeeding(state qbittorrent.TorrentState) bool {
 	switch state {
 	case qbittorrent.StateUploading, qbittorrent.StateStalledUP, qbittorrent.StateQueuedUP,
 		qbittorrent.StateForcedUP, qbittorrent.StateCheckingUP:
@@ -439,7 +563,7 @@ func (m DashboardModel) isSeeding(state qbittorrent.TorrentState) bool {
 }
 
 /*
-func (m DashboardModel) renderOverview_unused(cache interface{}, width int) string {
+func (m *DashboardModel) renderOverview_unused(cache interface{}, width int) string {
 	title := styles.TableHeaderStyle.Render("📊 Overview")
 
 	var stats []string
@@ -477,7 +601,7 @@ func (m DashboardModel) renderOverview_unused(cache interface{}, width int) stri
 			stats = append(stats, "")
 			stats = append(stats,
 				fmt.Sprintf("🕒 Last Updated: %s",
-					mutedStyle.Render(cache.Stats.LastUpdate.Format("15:04:05"))),
+					mutedStyle.Render(m.ui.FormatClock(cache.Stats.LastUpdate))),
 			)
 		}
 	} else {
@@ -493,7 +617,7 @@ func (m DashboardModel) renderOverview_unused(cache interface{}, width int) stri
 	).Render(lipgloss.JoinVertical(lipgloss.Left, title, "", content))
 }
 
-func (m DashboardModel) renderRecentActivity(cache *CachedData, width int) string {
+func (m *DashboardModel) renderRecentActivity(cache *CachedData, width int) string {
 	title := styles.TableHeaderStyle.Render("🕒 Recent Activity")
 
 	var activities []string
@@ -553,7 +677,7 @@ func (m DashboardModel) renderRecentActivity(cache *CachedData, width int) strin
 	).Render(lipgloss.JoinVertical(lipgloss.Left, title, "", content))
 }
 
-func (m DashboardModel) renderSystemStatus(cache *CachedData, width int) string {
+func (m *DashboardModel) renderSystemStatus(cache *CachedData, width int) string {
 	title := styles.TableHeaderStyle.Render("💾 System Status")
 
 	var status []string
@@ -632,7 +756,7 @@ func (m DashboardModel) renderSystemStatus(cache *CachedData, width int) string
 }
 
 // Utility functions
-func (m DashboardModel) formatSpeed(bytesPerSecond int64) string {
+func (m *DashboardModel) formatSpeed(bytesPerSecond int64) string {
 	if bytesPerSecond == 0 {
 		return "0 B/s"
 	}
@@ -654,7 +778,7 @@ func (m DashboardModel) formatSpeed(bytesPerSecond int64) string {
 
 
 
-func (m DashboardModel) createProgressBar(percentage float64, width int) string {
+func (m *DashboardModel) createProgressBar(percentage float64, width int) string {
 	filled := int(percentage / 100 * float64(width))
 	if filled > width {
 		filled = width
@@ -1,17 +1,18 @@
 package models
 
 import (
-	"encoding/json"
 	"fmt"
-	"os"
 	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/raainshe/akira/internal/config"
 	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/logging"
 	"github.com/raainshe/akira/internal/qbittorrent"
 	"github.com/raainshe/akira/internal/tui/shared"
 	"github.com/raainshe/akira/internal/tui/styles"
@@ -24,18 +25,95 @@ import (
 type TorrentsModel struct {
 	selectedIndex int
 	scrollOffset  int
-	filter        string
+	filter        string // Category filter, empty for all categories
+	search        string // Case-insensitive name filter
+	searching     bool   // Whether typed keys go to the search box
 	sortBy        string
 	sortDesc      bool
+	categories    []config.CategoryOption
+	ui            config.UIConfig
+
+	categoryOf func(qbittorrent.Torrent) string // Resolves a torrent's category, including from its save path
+
+	stalledThreshold time.Duration // Stalled downloads are only flagged after this long without activity
+
+	// Delete confirmation and detail popup for the selected torrent
+	confirmingDelete bool
+	showingDetails   bool
+	actionTorrent    qbittorrent.Torrent
+
+	// Speed limit editor for the selected torrent
+	editingLimits bool
+	limitHash     string
+	limitName     string
+	limitInputs   [2]string // Download and upload limit input
+	limitField    int       // Index of the focused input
+	limitErr      string
+}
+
+// SetTorrentLimitsMsg asks the app to apply speed limits (bytes/s, 0 for no limit) to a torrent
+type SetTorrentLimitsMsg struct {
+	Hash          string
+	Name          string
+	DownloadLimit int64
+	UploadLimit   int64
+}
+
+// ToggleTorrentPauseMsg asks the app to pause a running torrent or resume a paused one
+type ToggleTorrentPauseMsg struct {
+	Hash   string
+	Name   string
+	Resume bool
 }
 
-func NewTorrentsModel() TorrentsModel {
-	return TorrentsModel{
-		sortBy: "name", // Default sort by name
+// DeleteTorrentMsg asks the app to delete a torrent, optionally with its files
+type DeleteTorrentMsg struct {
+	Hash        string
+	Name        string
+	DeleteFiles bool
+}
+
+// NewTorrentsModel creates the torrent list view. categoryOf resolves the
+// category the filter matches, so torrents without a category in qBittorrent
+// are found through their save path like in 'akira list --category'.
+func NewTorrentsModel(categories []config.CategoryOption, categoryOf func(qbittorrent.Torrent) string,
+	stalledThreshold time.Duration, ui config.UIConfig) *TorrentsModel {
+	return &TorrentsModel{
+		sortBy:           "name", // Default sort by name
+		categories:       categories,
+		categoryOf:       categoryOf,
+		ui:               ui,
+		stalledThreshold: stalledThreshold,
 	}
 }
 
-func (m TorrentsModel) Update(msg tea.Msg) (TorrentsModel, tea.Cmd) {
+// IsEditing returns true while the search box, the limit editor, the delete
+// confirmation or the detail popup is capturing keyboard input
+func (m *TorrentsModel) IsEditing() bool {
+	return m.searching || m.editingLimits || m.confirmingDelete || m.showingDetails
+}
+
+func (m *TorrentsModel) Update(msg tea.Msg, cache *shared.CachedData) tea.Cmd {
+	if m.searching {
+		return m.updateSearch(msg)
+	}
+	if m.editingLimits {
+		return m.updateLimitEditor(msg)
+	}
+	if m.confirmingDelete {
+		return m.updateDeleteConfirmation(msg)
+	}
+	if m.showingDetails {
+		// Any of the closing keys hides the popup
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			switch keyMsg.String() {
+			case "esc", "enter", "q":
+				m.showingDetails = false
+			}
+		}
+		return nil
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
@@ -47,13 +125,15 @@ func (m TorrentsModel) Update(msg tea.Msg) (TorrentsModel, tea.Cmd) {
 				}
 			}
 		case "down", "j":
-			// We'll handle max in the View method when we know torrent count
-			m.selectedIndex++
+			if m.selectedIndex < m.torrentCount(cache)-1 {
+				m.selectedIndex++
+			}
 		case "home", "g":
 			m.selectedIndex = 0
 			m.scrollOffset = 0
 		case "end", "G":
-			// Will be handled in View when we know torrent count
+			// View scrolls the list to keep the selection visible
+			m.selectedIndex = max(m.torrentCount(cache)-1, 0)
 		case "n":
 			// Sort by name
 			if m.sortBy == "name" {
@@ -86,12 +166,340 @@ func (m TorrentsModel) Update(msg tea.Msg) (TorrentsModel, tea.Cmd) {
 				m.sortBy = "dlspeed"
 				m.sortDesc = true // Default descending for speed
 			}
+		case "c":
+			// Cycle through category filters (all -> each category -> all)
+			next := ""
+			for i, category := range m.categories {
+				if m.filter == "" {
+					next = category.Name
+					break
+				}
+				if category.Name == m.filter && i+1 < len(m.categories) {
+					next = m.categories[i+1].Name
+					break
+				}
+			}
+			m.filter = next
+			m.selectedIndex = 0
+			m.scrollOffset = 0
+		case "/":
+			// Start typing a name filter
+			m.searching = true
+		case "esc":
+			// Clear the name filter
+			if m.search != "" {
+				m.search = ""
+				m.selectedIndex = 0
+				m.scrollOffset = 0
+			}
+		case " ":
+			// Pause or resume the selected torrent
+			torrent, ok := m.selectedTorrent(cache)
+			if !ok {
+				break
+			}
+			toggle := ToggleTorrentPauseMsg{Hash: torrent.Hash, Name: torrent.Name, Resume: torrent.IsPaused()}
+			return func() tea.Msg { return toggle }
+		case "x":
+			// Ask before deleting the selected torrent
+			torrent, ok := m.selectedTorrent(cache)
+			if !ok {
+				break
+			}
+			m.confirmingDelete = true
+			m.actionTorrent = torrent
+		case "enter":
+			// Show the details of the selected torrent
+			torrent, ok := m.selectedTorrent(cache)
+			if !ok {
+				break
+			}
+			m.showingDetails = true
+			m.actionTorrent = torrent
+		case "l":
+			// Edit the selected torrent's speed limits
+			torrent, ok := m.selectedTorrent(cache)
+			if !ok {
+				break
+			}
+			m.editingLimits = true
+			m.limitHash = torrent.Hash
+			m.limitName = torrent.Name
+			m.limitInputs = [2]string{qbittorrent.FormatSpeedLimit(torrent.DlLimit), qbittorrent.FormatSpeedLimit(torrent.UpLimit)}
+			m.limitField = 0
+			m.limitErr = ""
 		}
 	}
-	return m, nil
+	return nil
 }
 
-func (m TorrentsModel) View(cache interface{}, width, height int) string {
+// updateSearch handles keyboard input while the search box is open. The list
+// is filtered as the user types; Enter keeps the filter and Esc clears it.
+func (m *TorrentsModel) updateSearch(msg tea.Msg) tea.Cmd {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+
+	switch keyMsg.Type {
+	case tea.KeyEsc:
+		m.searching = false
+		m.search = ""
+	case tea.KeyEnter:
+		m.searching = false
+	case tea.KeyBackspace:
+		search := []rune(m.search)
+		if len(search) > 0 {
+			m.search = string(search[:len(search)-1])
+		}
+	case tea.KeyCtrlU:
+		m.search = ""
+	case tea.KeySpace:
+		m.search += " "
+	case tea.KeyRunes:
+		m.search += string(keyMsg.Runes)
+	default:
+		return nil
+	}
+
+	m.selectedIndex = 0
+	m.scrollOffset = 0
+	return nil
+}
+
+// renderSearch renders the search box shown in place of the help text
+func (m *TorrentsModel) renderSearch() []string {
+	focusedStyle := lipgloss.NewStyle().Foreground(styles.Background).Background(styles.Primary)
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
+
+	return []string{
+		fmt.Sprintf("🔍 Search: [%s]", focusedStyle.Render(m.search+"▏")),
+		mutedStyle.Render("Type to filter by name • Enter: Keep filter • Esc: Clear"),
+	}
+}
+
+// torrentCount returns how many torrents the list shows
+func (m *TorrentsModel) torrentCount(cache *shared.CachedData) int {
+	if cache == nil {
+		return 0
+	}
+	return len(m.visibleTorrents(cache.Torrents))
+}
+
+// selectedTorrent returns the torrent under the cursor
+func (m *TorrentsModel) selectedTorrent(cache *shared.CachedData) (qbittorrent.Torrent, bool) {
+	if cache == nil {
+		return qbittorrent.Torrent{}, false
+	}
+	torrents := m.visibleTorrents(cache.Torrents)
+	if len(torrents) == 0 {
+		return qbittorrent.Torrent{}, false
+	}
+	index := m.selectedIndex
+	if index >= len(torrents) {
+		index = len(torrents) - 1
+	}
+	if index < 0 {
+		index = 0
+	}
+	return torrents[index], true
+}
+
+// updateDeleteConfirmation handles keyboard input while the delete confirmation is open
+func (m *TorrentsModel) updateDeleteConfirmation(msg tea.Msg) tea.Cmd {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+
+	switch keyMsg.String() {
+	case "y", "f":
+		m.confirmingDelete = false
+		deletion := DeleteTorrentMsg{
+			Hash:        m.actionTorrent.Hash,
+			Name:        m.actionTorrent.Name,
+			DeleteFiles: keyMsg.String() == "f",
+		}
+		return func() tea.Msg { return deletion }
+	case "n", "esc":
+		m.confirmingDelete = false
+	}
+
+	return nil
+}
+
+// renderDeleteConfirmation renders the delete confirmation shown in place of the help text
+func (m *TorrentsModel) renderDeleteConfirmation() []string {
+	warningStyle := lipgloss.NewStyle().Foreground(styles.Error).Bold(true)
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
+
+	return []string{
+		warningStyle.Render("🗑️  Delete " + m.truncateString(m.actionTorrent.Name, 50) + "?"),
+		mutedStyle.Render("Y: Delete torrent • F: Delete torrent and files • N/Esc: Cancel"),
+	}
+}
+
+// renderDetails renders the detail popup for the selected torrent, centered in the view
+func (m *TorrentsModel) renderDetails(width, height int) string {
+	torrent := m.actionTorrent
+	titleStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
+
+	category := torrent.Category
+	if category == "" {
+		category = "-"
+	}
+	tags := torrent.Tags
+	if tags == "" {
+		tags = "-"
+	}
+
+	fields := []struct {
+		label string
+		value string
+	}{
+		{"Hash", torrent.Hash},
+		{"State", m.formatState(torrent)},
+		{"Size", m.formatBytes(torrent.Size)},
+		{"Progress", fmt.Sprintf("%.1f%%", torrent.Progress*100)},
+		{"Speed ↓/↑", m.formatSpeed(torrent.Dlspeed) + " / " + m.formatSpeed(torrent.Upspeed)},
+		{"Limits ↓/↑", qbittorrent.FormatSpeedLimit(torrent.DlLimit) + " / " + qbittorrent.FormatSpeedLimit(torrent.UpLimit)},
+		{"ETA", m.formatETA(torrent.GetEstimatedETA())},
+		{"Ratio", fmt.Sprintf("%.2f", torrent.Ratio)},
+		{"Seeds/Peers", fmt.Sprintf("%d (%d) / %d (%d)", torrent.NumSeeds, torrent.NumComplete, torrent.NumLeechs, torrent.NumIncomplete)},
+		{"Category", category},
+		{"Tags", tags},
+		{"Save Path", torrent.SavePath},
+		{"Added", m.ui.FormatTime(time.Unix(torrent.AddedOn, 0))},
+	}
+	if torrent.CompletionOn > 0 {
+		fields = append(fields, struct {
+			label string
+			value string
+		}{"Completed", m.ui.FormatTime(time.Unix(torrent.CompletionOn, 0))})
+	}
+
+	lines := []string{titleStyle.Render("ℹ️  " + m.truncateString(torrent.Name, 60)), ""}
+	for _, field := range fields {
+		lines = append(lines, fmt.Sprintf("%s %s", labelStyle.Render(fmt.Sprintf("%-12s", field.label+":")), field.value))
+	}
+	lines = append(lines, "", labelStyle.Render("Esc/Enter: Close"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Primary).
+		Padding(0, 1).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// updateLimitEditor handles keyboard input while the limit editor is open
+func (m *TorrentsModel) updateLimitEditor(msg tea.Msg) tea.Cmd {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+
+	switch keyMsg.Type {
+	case tea.KeyEsc:
+		m.editingLimits = false
+	case tea.KeyTab, tea.KeyShiftTab, tea.KeyUp, tea.KeyDown:
+		m.limitField = (m.limitField + 1) % len(m.limitInputs)
+	case tea.KeyBackspace:
+		input := []rune(m.limitInputs[m.limitField])
+		if len(input) > 0 {
+			m.limitInputs[m.limitField] = string(input[:len(input)-1])
+		}
+	case tea.KeyCtrlU:
+		m.limitInputs[m.limitField] = ""
+	case tea.KeySpace:
+		m.limitInputs[m.limitField] += " "
+	case tea.KeyRunes:
+		m.limitInputs[m.limitField] += string(keyMsg.Runes)
+	case tea.KeyEnter:
+		downloadLimit, err := qbittorrent.ParseSpeedLimit(m.limitInputs[0])
+		if err != nil {
+			m.limitErr = fmt.Sprintf("Download: %v", err)
+			return nil
+		}
+		uploadLimit, err := qbittorrent.ParseSpeedLimit(m.limitInputs[1])
+		if err != nil {
+			m.limitErr = fmt.Sprintf("Upload: %v", err)
+			return nil
+		}
+
+		m.editingLimits = false
+		limits := SetTorrentLimitsMsg{
+			Hash:          m.limitHash,
+			Name:          m.limitName,
+			DownloadLimit: downloadLimit,
+			UploadLimit:   uploadLimit,
+		}
+		return func() tea.Msg { return limits }
+	}
+
+	return nil
+}
+
+// renderLimitEditor renders the speed limit editor shown in place of the help text
+func (m *TorrentsModel) renderLimitEditor() []string {
+	titleStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
+	mutedStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
+	errorStyle := lipgloss.NewStyle().Foreground(styles.Error)
+	focusedStyle := lipgloss.NewStyle().Foreground(styles.Background).Background(styles.Primary)
+
+	fields := []string{"↓ Download", "↑ Upload"}
+	var inputs []string
+	for i, label := range fields {
+		value := m.limitInputs[i]
+		if i == m.limitField {
+			value = focusedStyle.Render(value + "▏")
+		}
+		inputs = append(inputs, fmt.Sprintf("%s: [%s]", label, value))
+	}
+
+	lines := []string{
+		titleStyle.Render("⚙️  Speed limits for " + m.truncateString(m.limitName, 40)),
+		strings.Join(inputs, "   "),
+	}
+	if m.limitErr != "" {
+		lines = append(lines, errorStyle.Render("❌ "+m.limitErr))
+	} else {
+		lines = append(lines, mutedStyle.Render("Sizes like 500K, 2M or 1.5 MB/s • Empty, 0 or ∞ for no limit • Tab: Switch • Enter: Apply • Esc: Cancel"))
+	}
+	return lines
+}
+
+// visibleTorrents returns the torrents shown in the list, filtered by category
+// and name and sorted
+func (m *TorrentsModel) visibleTorrents(all []qbittorrent.Torrent) []qbittorrent.Torrent {
+	search := strings.ToLower(m.search)
+	torrents := make([]qbittorrent.Torrent, 0, len(all))
+	for _, torrent := range all {
+		if m.filter != "" && !strings.EqualFold(m.categoryOf(torrent), m.filter) {
+			continue
+		}
+		if search != "" && !strings.Contains(strings.ToLower(torrent.Name), search) {
+			continue
+		}
+		torrents = append(torrents, torrent)
+	}
+	m.sortTorrents(torrents)
+	return torrents
+}
+
+// categoryLabel returns the display name of the active category filter
+func (m *TorrentsModel) categoryLabel() string {
+	for _, category := range m.categories {
+		if category.Name == m.filter {
+			return category.DisplayName()
+		}
+	}
+	return "All"
+}
+
+func (m *TorrentsModel) View(cache interface{}, width, height int) string {
 	// Type assert the cache
 	appCache, ok := cache.(*shared.CachedData)
 	if !ok {
@@ -105,10 +513,22 @@ func (m TorrentsModel) View(cache interface{}, width, height int) string {
 		return "No torrents found.\n\nAdd a torrent using the 'Add Magnet' view (press 3) or the CLI command:\nakira add <magnet-uri>"
 	}
 
-	// Sort torrents
-	torrents := make([]qbittorrent.Torrent, len(appCache.Torrents))
-	copy(torrents, appCache.Torrents)
-	m.sortTorrents(torrents)
+	if m.showingDetails {
+		return m.renderDetails(width, height)
+	}
+
+	// Filter by category and sort torrents
+	torrents := m.visibleTorrents(appCache.Torrents)
+	if len(torrents) == 0 && (m.search != "" || m.searching) {
+		message := fmt.Sprintf("No torrents matching %q in category %s.", m.search, m.categoryLabel())
+		if m.searching {
+			return lipgloss.JoinVertical(lipgloss.Left, append([]string{message, ""}, m.renderSearch()...)...)
+		}
+		return message + "\n\nPress Esc to clear the search or / to change it."
+	}
+	if len(torrents) == 0 {
+		return fmt.Sprintf("No torrents in category %s.\n\nPress C to change the category filter.", m.categoryLabel())
+	}
 
 	// Adjust selection bounds
 	if m.selectedIndex >= len(torrents) {
@@ -120,6 +540,9 @@ func (m TorrentsModel) View(cache interface{}, width, height int) string {
 
 	// Calculate visible area
 	visibleHeight := height - 6 // Reserve space for header, help text, etc.
+	if m.editingLimits {
+		visibleHeight-- // The limit editor takes one more line than the help text
+	}
 	if m.selectedIndex >= m.scrollOffset+visibleHeight {
 		m.scrollOffset = m.selectedIndex - visibleHeight + 1
 	}
@@ -132,8 +555,8 @@ func (m TorrentsModel) View(cache interface{}, width, height int) string {
 
 	// Header
 	headerStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
-	header := fmt.Sprintf("%-30s %-8s %-8s %-10s %-8s %-12s %s",
-		"Name", "Size", "Progress", "Speed", "ETA", "State", "Ratio")
+	header := fmt.Sprintf("%-30s %-8s %-8s %-10s %-8s %-12s %-6s %s",
+		"Name", "Size", "Progress", "Speed", "ETA", "State", "Ratio", "Limits ↓/↑")
 	content = append(content, headerStyle.Render(header))
 	content = append(content, strings.Repeat("─", width-4))
 
@@ -154,11 +577,19 @@ func (m TorrentsModel) View(cache interface{}, width, height int) string {
 		content = append(content, "")
 	}
 
-	// Help text
-	helpStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
-	help := "↑/↓: Navigate • N: Sort by Name • S: Sort by Size • P: Sort by Progress • D: Sort by Speed"
-	content = append(content, "")
-	content = append(content, helpStyle.Render(help))
+	// Help text, or the search box, limit editor or delete confirmation while it is open
+	if m.searching {
+		content = append(content, m.renderSearch()...)
+	} else if m.editingLimits {
+		content = append(content, m.renderLimitEditor()...)
+	} else if m.confirmingDelete {
+		content = append(content, m.renderDeleteConfirmation()...)
+	} else {
+		helpStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
+		help := "↑/↓: Navigate • N/S/P/D: Sort by Name/Size/Progress/Speed • C: Category • L: Limits • Space: Pause/Resume • X: Delete • Enter: Details • /: Search"
+		content = append(content, "")
+		content = append(content, helpStyle.Render(help))
+	}
 
 	// Status
 	statusStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
@@ -166,8 +597,11 @@ func (m TorrentsModel) View(cache interface{}, width, height int) string {
 	if m.sortDesc {
 		sortIndicator = "↓"
 	}
-	status := fmt.Sprintf("Showing %d-%d of %d torrents • Sorted by %s %s • Selected: %d",
-		m.scrollOffset+1, endIndex, len(torrents), m.sortBy, sortIndicator, m.selectedIndex+1)
+	status := fmt.Sprintf("Showing %d-%d of %d torrents • Category: %s • Sorted by %s %s • Selected: %d",
+		m.scrollOffset+1, endIndex, len(torrents), m.categoryLabel(), m.sortBy, sortIndicator, m.selectedIndex+1)
+	if m.search != "" {
+		status += fmt.Sprintf(" • Search: %q (Esc to clear)", m.search)
+	}
 	content = append(content, statusStyle.Render(status))
 
 	// Ensure we don't exceed the total height
@@ -179,7 +613,7 @@ func (m TorrentsModel) View(cache interface{}, width, height int) string {
 }
 
 // sortTorrents sorts the torrent slice based on current sort settings
-func (m TorrentsModel) sortTorrents(torrents []qbittorrent.Torrent) {
+func (m *TorrentsModel) sortTorrents(torrents []qbittorrent.Torrent) {
 	sort.Slice(torrents, func(i, j int) bool {
 		var less bool
 		switch m.sortBy {
@@ -203,22 +637,23 @@ func (m TorrentsModel) sortTorrents(torrents []qbittorrent.Torrent) {
 }
 
 // formatTorrentRow formats a single torrent row for display
-func (m TorrentsModel) formatTorrentRow(torrent qbittorrent.Torrent, isSelected bool, maxWidth int) string {
+func (m *TorrentsModel) formatTorrentRow(torrent qbittorrent.Torrent, isSelected bool, maxWidth int) string {
 	// Format basic info
 	name := m.truncateString(torrent.Name, 28)
 	size := m.formatBytes(torrent.Size)
 	progress := fmt.Sprintf("%.1f%%", torrent.Progress*100)
 	speed := m.formatSpeed(torrent.Dlspeed)
-	eta := m.formatETA(torrent.Eta)
-	state := m.formatState(torrent.State)
+	eta := m.formatETA(torrent.GetEstimatedETA())
+	state := m.formatState(torrent)
 	ratio := fmt.Sprintf("%.2f", torrent.Ratio)
+	limits := qbittorrent.FormatSpeedLimit(torrent.DlLimit) + " / " + qbittorrent.FormatSpeedLimit(torrent.UpLimit)
 
 	// Create progress bar
 	progressBar := m.createProgressBar(torrent.Progress*100, 10)
 
 	// Format the row
-	row := fmt.Sprintf("%-28s %-8s %s %-8s %-8s %-8s %-12s %s",
-		name, size, progressBar, progress, speed, eta, state, ratio)
+	row := fmt.Sprintf("%-28s %-8s %s %-8s %-8s %-8s %-12s %-6s %s",
+		name, size, progressBar, progress, speed, eta, state, ratio, limits)
 
 	// Apply selection styling
 	if isSelected {
@@ -235,14 +670,18 @@ func (m TorrentsModel) formatTorrentRow(torrent qbittorrent.Torrent, isSelected
 }
 
 // Helper functions
-func (m TorrentsModel) truncateString(s string, maxLen int) string {
-	// Use lipgloss.Width to account for character width variations (emojis, CJK, etc.)
+
+// truncateToWidth shortens s to at most maxLen terminal columns, ending it with
+// "..." when cut. lipgloss.Width accounts for wide characters (CJK, emoji), and
+// s is only cut between runes so no multibyte character is split.
+func truncateToWidth(s string, maxLen int) string {
 	if lipgloss.Width(s) <= maxLen {
 		return s
 	}
 
-	// Truncate based on actual display width
-	for i := len(s) - 1; i >= 0; i-- {
+	for i := len(s); i > 0; {
+		_, size := utf8.DecodeLastRuneInString(s[:i])
+		i -= size
 		if lipgloss.Width(s[:i]) <= maxLen-3 {
 			return s[:i] + "..."
 		}
@@ -251,7 +690,11 @@ func (m TorrentsModel) truncateString(s string, maxLen int) string {
 	return "..."
 }
 
-func (m TorrentsModel) formatBytes(bytes int64) string {
+func (m *TorrentsModel) truncateString(s string, maxLen int) string {
+	return truncateToWidth(s, maxLen)
+}
+
+func (m *TorrentsModel) formatBytes(bytes int64) string {
 	if bytes == 0 {
 		return "0 B"
 	}
@@ -270,14 +713,14 @@ func (m TorrentsModel) formatBytes(bytes int64) string {
 	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
 }
 
-func (m TorrentsModel) formatSpeed(bytesPerSecond int64) string {
+func (m *TorrentsModel) formatSpeed(bytesPerSecond int64) string {
 	if bytesPerSecond == 0 {
 		return "0 B/s"
 	}
 	return m.formatBytes(bytesPerSecond) + "/s"
 }
 
-func (m TorrentsModel) formatETA(eta int64) string {
+func (m *TorrentsModel) formatETA(eta int64) string {
 	if eta <= 0 || eta == 8640000 { // qBittorrent uses 8640000 for infinite
 		return "∞"
 	}
@@ -293,8 +736,8 @@ func (m TorrentsModel) formatETA(eta int64) string {
 	}
 }
 
-func (m TorrentsModel) formatState(state qbittorrent.TorrentState) string {
-	switch state {
+func (m *TorrentsModel) formatState(torrent qbittorrent.Torrent) string {
+	switch state := torrent.State; state {
 	case qbittorrent.StateDownloading:
 		return "📥 Down"
 	case qbittorrent.StateUploading:
@@ -306,6 +749,10 @@ func (m TorrentsModel) formatState(state qbittorrent.TorrentState) string {
 	case qbittorrent.StateError:
 		return "❌ Error"
 	case qbittorrent.StateStalledDL:
+		// Briefly stalled downloads are usually still connecting to peers
+		if !torrent.IsStalledFor(m.stalledThreshold, time.Now()) {
+			return "📥 Conn"
+		}
 		return "📥 Stall"
 	case qbittorrent.StateStalledUP:
 		return "🌱 Stall"
@@ -320,7 +767,7 @@ func (m TorrentsModel) formatState(state qbittorrent.TorrentState) string {
 	}
 }
 
-func (m TorrentsModel) createProgressBar(percentage float64, width int) string {
+func (m *TorrentsModel) createProgressBar(percentage float64, width int) string {
 	filled := int(percentage / 100 * float64(width))
 	if filled > width {
 		filled = width
@@ -365,13 +812,25 @@ func (m AddMagnetModel) View(cache interface{}, width, height int) string {
 type SeedingModel struct {
 	selectedTorrent int
 	scrollOffset    int
+	leaderboardBy   core.LeaderboardSort // Ranking used by the top seeders section
 }
 
-func NewSeedingModel() SeedingModel {
-	return SeedingModel{}
+// leaderboardSize is how many torrents the top seeders section shows
+const leaderboardSize = 5
+
+// ToggleSeedingPauseMsg asks the app to pause or resume automatic seeding stops
+type ToggleSeedingPauseMsg struct{}
+
+func NewSeedingModel() *SeedingModel {
+	return &SeedingModel{leaderboardBy: core.LeaderboardByRatio}
 }
 
-func (m SeedingModel) Update(msg tea.Msg) (SeedingModel, tea.Cmd) {
+func (m *SeedingModel) Update(msg tea.Msg, cache *shared.CachedData) tea.Cmd {
+	count := 0
+	if cache != nil && cache.SeedingInfo != nil {
+		count = len(cache.SeedingInfo.Details)
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
@@ -380,18 +839,30 @@ func (m SeedingModel) Update(msg tea.Msg) (SeedingModel, tea.Cmd) {
 				m.selectedTorrent--
 			}
 		case "down", "j":
-			// Will be handled in View when we know torrent count
-			m.selectedTorrent++
+			if m.selectedTorrent < count-1 {
+				m.selectedTorrent++
+			}
 		case "home", "g":
 			m.selectedTorrent = 0
+			m.scrollOffset = 0
 		case "end", "G":
-			// Will be handled in View when we know torrent count
+			// View scrolls the list to keep the selection visible
+			m.selectedTorrent = max(count-1, 0)
+		case "a":
+			return func() tea.Msg { return ToggleSeedingPauseMsg{} }
+		case "t":
+			// Switch the top seeders ranking
+			if m.leaderboardBy == core.LeaderboardByRatio {
+				m.leaderboardBy = core.LeaderboardByUploaded
+			} else {
+				m.leaderboardBy = core.LeaderboardByRatio
+			}
 		}
 	}
-	return m, nil
+	return nil
 }
 
-func (m SeedingModel) View(cache interface{}, width, height int) string {
+func (m *SeedingModel) View(cache interface{}, width, height int) string {
 	// Reserve space for title, help text, and spacing (4 lines total)
 	reservedHeight := 4
 	availableHeight := height - reservedHeight
@@ -415,6 +886,13 @@ func (m SeedingModel) View(cache interface{}, width, height int) string {
 	// Service status
 	content = append(content, m.renderServiceStatus(appCache.SeedingInfo, width-4))
 
+	// Top seeders
+	leaderboard := m.renderLeaderboard(appCache.SeedingInfo, appCache.Torrents, width-4)
+	if leaderboard != "" {
+		content = append(content, leaderboard)
+		availableHeight -= lipgloss.Height(leaderboard)
+	}
+
 	// Tracked torrents
 	if len(appCache.SeedingInfo.Details) > 0 {
 		content = append(content, m.renderTrackedTorrents(appCache.SeedingInfo, width-4, availableHeight-2))
@@ -425,7 +903,7 @@ func (m SeedingModel) View(cache interface{}, width, height int) string {
 
 	// Help text
 	helpStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
-	help := "↑/↓: Navigate • Home/End: Jump to start/end"
+	help := "↑/↓: Navigate • Home/End: Jump to start/end • A: Pause/Resume auto-stop • T: Top seeders by ratio/uploaded"
 	content = append(content, helpStyle.Render(help))
 
 	// Ensure we don't exceed the total height
@@ -436,12 +914,23 @@ func (m SeedingModel) View(cache interface{}, width, height int) string {
 	return lipgloss.JoinVertical(lipgloss.Left, content...)
 }
 
-func (m SeedingModel) renderServiceStatus(info *core.SeedingStatus, width int) string {
+func (m *SeedingModel) renderServiceStatus(info *core.SeedingStatus, width int) string {
 	var lines []string
 
 	// Service status
 	statusStyle := lipgloss.NewStyle().Foreground(styles.Success).Bold(true)
-	lines = append(lines, fmt.Sprintf("Service Status: %s", statusStyle.Render("🟢 RUNNING")))
+	if info.IsRunning {
+		lines = append(lines, fmt.Sprintf("Service Status: %s", statusStyle.Render("🟢 RUNNING")))
+	} else {
+		stoppedStyle := lipgloss.NewStyle().Foreground(styles.Error).Bold(true)
+		lines = append(lines, fmt.Sprintf("Service Status: %s", stoppedStyle.Render("🔴 STOPPED")))
+	}
+	if info.Paused {
+		pausedStyle := lipgloss.NewStyle().Foreground(styles.Warning).Bold(true)
+		lines = append(lines, fmt.Sprintf("Auto-Stop: %s", pausedStyle.Render(fmt.Sprintf("⏸️  PAUSED (%s)", m.formatDuration(time.Since(info.PausedAt))))))
+	} else {
+		lines = append(lines, fmt.Sprintf("Auto-Stop: %s", statusStyle.Render("▶️  ACTIVE")))
+	}
 
 	// Statistics
 	statsStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
@@ -463,7 +952,41 @@ func (m SeedingModel) renderServiceStatus(info *core.SeedingStatus, width int) s
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
-func (m SeedingModel) renderTrackedTorrents(info *core.SeedingStatus, width, maxHeight int) string {
+// renderLeaderboard renders the best tracked torrents by ratio or uploaded data
+func (m *SeedingModel) renderLeaderboard(info *core.SeedingStatus, torrents []qbittorrent.Torrent, width int) string {
+	tracked := make([]qbittorrent.Torrent, 0, len(info.Details))
+	for _, torrent := range torrents {
+		if _, exists := info.Details[torrent.Hash]; exists {
+			tracked = append(tracked, torrent)
+		}
+	}
+	if len(tracked) == 0 {
+		return ""
+	}
+
+	by := m.leaderboardBy
+	if by == "" {
+		by = core.LeaderboardByRatio
+	}
+
+	headerStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
+	lines := []string{"", headerStyle.Render(fmt.Sprintf("🏆 Top Seeders (by %s):", by))}
+
+	medals := []string{"🥇", "🥈", "🥉"}
+	for _, entry := range core.RankSeeders(tracked, by, leaderboardSize) {
+		rank := fmt.Sprintf("%d.", entry.Rank)
+		if entry.Rank <= len(medals) {
+			rank = medals[entry.Rank-1]
+		}
+		lines = append(lines, fmt.Sprintf("%s %s | Ratio: %.2f | Uploaded: %s",
+			rank, m.truncateString(entry.Name, 40), entry.Ratio, qbittorrent.FormatBytes(entry.Uploaded)))
+	}
+	lines = append(lines, "")
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+func (m *SeedingModel) renderTrackedTorrents(info *core.SeedingStatus, width, maxHeight int) string {
 	var content []string
 
 	// Header
@@ -494,12 +1017,22 @@ func (m SeedingModel) renderTrackedTorrents(info *core.SeedingStatus, width, max
 		endIndex = len(info.Details)
 	}
 
-	index := 0
-	for hash, status := range info.Details {
-		if index >= m.scrollOffset && index < endIndex {
-			content = append(content, m.renderTorrentStatus(hash, status, index == m.selectedTorrent, width))
+	// List in a stable order, so the selection does not jump between refreshes
+	hashes := make([]string, 0, len(info.Details))
+	for hash := range info.Details {
+		hashes = append(hashes, hash)
+	}
+	sort.Slice(hashes, func(i, j int) bool {
+		a, b := info.Details[hashes[i]], info.Details[hashes[j]]
+		if !strings.EqualFold(a.Name, b.Name) {
+			return strings.ToLower(a.Name) < strings.ToLower(b.Name)
 		}
-		index++
+		return hashes[i] < hashes[j]
+	})
+
+	for index := m.scrollOffset; index < endIndex; index++ {
+		hash := hashes[index]
+		content = append(content, m.renderTorrentStatus(hash, info.Details[hash], index == m.selectedTorrent, width))
 	}
 
 	// Status
@@ -511,7 +1044,7 @@ func (m SeedingModel) renderTrackedTorrents(info *core.SeedingStatus, width, max
 	return lipgloss.JoinVertical(lipgloss.Left, content...)
 }
 
-func (m SeedingModel) renderTorrentStatus(hash string, status *core.SeedingTorrentStatus, isSelected bool, width int) string {
+func (m *SeedingModel) renderTorrentStatus(hash string, status *core.SeedingTorrentStatus, isSelected bool, width int) string {
 	// Format the torrent info
 	name := m.truncateString(status.Name, 30)
 	downloadTime := m.formatDuration(status.DownloadDuration)
@@ -534,6 +1067,9 @@ func (m SeedingModel) renderTorrentStatus(hash string, status *core.SeedingTorre
 
 	line := fmt.Sprintf("%s %s | %s | DL: %s | Seed: %s | Remaining: %s",
 		statusIcon, name, hash[:8], downloadTime, seedingTime, timeRemaining)
+	if status.RatioLimit > 0 {
+		line += fmt.Sprintf(" | Ratio: %.2f/%.2f", status.Ratio, status.RatioLimit)
+	}
 
 	// Apply selection styling
 	if isSelected {
@@ -549,7 +1085,7 @@ func (m SeedingModel) renderTorrentStatus(hash string, status *core.SeedingTorre
 	return statusStyle.Render(line)
 }
 
-func (m SeedingModel) formatDuration(d time.Duration) string {
+func (m *SeedingModel) formatDuration(d time.Duration) string {
 	if d <= 0 {
 		return "0s"
 	}
@@ -566,20 +1102,8 @@ func (m SeedingModel) formatDuration(d time.Duration) string {
 	}
 }
 
-func (m SeedingModel) truncateString(s string, maxLen int) string {
-	// Use lipgloss.Width to account for character width variations (emojis, CJK, etc.)
-	if lipgloss.Width(s) <= maxLen {
-		return s
-	}
-
-	// Truncate based on actual display width
-	for i := len(s) - 1; i >= 0; i-- {
-		if lipgloss.Width(s[:i]) <= maxLen-3 {
-			return s[:i] + "..."
-		}
-	}
-
-	return "..."
+func (m *SeedingModel) truncateString(s string, maxLen int) string {
+	return truncateToWidth(s, maxLen)
 }
 
 // DiskModel represents the disk usage view
@@ -587,15 +1111,15 @@ type DiskModel struct {
 	selectedPath int
 }
 
-func NewDiskModel() DiskModel {
-	return DiskModel{}
+func NewDiskModel() *DiskModel {
+	return &DiskModel{}
 }
 
-func (m DiskModel) Update(msg tea.Msg) (DiskModel, tea.Cmd) {
-	return m, nil
+func (m *DiskModel) Update(msg tea.Msg) tea.Cmd {
+	return nil
 }
 
-func (m DiskModel) View(cache interface{}, width, height int) string {
+func (m *DiskModel) View(cache interface{}, width, height int) string {
 	// Reserve space for title and help text (3 lines total)
 	reservedHeight := 3
 	availableHeight := height - reservedHeight
@@ -639,41 +1163,37 @@ func (m DiskModel) View(cache interface{}, width, height int) string {
 	return lipgloss.JoinVertical(lipgloss.Left, content...)
 }
 
-func (m DiskModel) renderDiskInfo(path string, diskInfo *core.DiskInfo, width int) string {
-	percentage := float64(diskInfo.Used) / float64(diskInfo.Total) * 100
-
-	// Health status
-	var healthColor lipgloss.Color
-	var healthText string
+func (m *DiskModel) renderDiskInfo(path string, diskInfo *core.DiskInfo, width int) string {
+	pathStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
 
-	switch {
-	case percentage < 70:
-		healthColor = styles.Success
-		healthText = "🟢 HEALTHY"
-	case percentage < 85:
-		healthColor = styles.Warning
-		healthText = "🟡 WARNING"
-	case percentage < 95:
-		healthColor = styles.Error
-		healthText = "🟠 CRITICAL"
-	default:
-		healthColor = styles.Error
-		healthText = "🔴 FULL"
+	// Paths without a total size can't be expressed as a percentage
+	if !diskInfo.HasCapacity() {
+		mutedStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
+		return lipgloss.JoinVertical(lipgloss.Left,
+			pathStyle.Render(fmt.Sprintf("📁 %s", path)),
+			mutedStyle.Render(fmt.Sprintf("%s N/A", strings.Repeat("░", 50))),
+			mutedStyle.Render("Used: N/A • Free: N/A • Total: N/A"),
+			fmt.Sprintf("Status: %s", mutedStyle.Render("⚪ N/A")),
+		)
 	}
 
+	percentage := diskInfo.UsedPercent
+
+	// Health status
+	healthColor, healthText := diskHealthStyle(diskInfo.Health)
+
 	// Format sizes
 	totalStr := m.formatBytes(diskInfo.Total)
 	usedStr := m.formatBytes(diskInfo.Used)
 	freeStr := m.formatBytes(diskInfo.Free)
 
 	// Create progress bar
-	progressBar := m.createDiskProgressBar(percentage, 50)
+	progressBar := m.createDiskProgressBar(percentage, 50, healthColor)
 
 	// Build the section
 	var lines []string
 
 	// Path header
-	pathStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
 	lines = append(lines, pathStyle.Render(fmt.Sprintf("📁 %s", path)))
 
 	// Progress bar with percentage
@@ -690,7 +1210,8 @@ func (m DiskModel) renderDiskInfo(path string, diskInfo *core.DiskInfo, width in
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
-func (m DiskModel) createDiskProgressBar(percentage float64, width int) string {
+// createDiskProgressBar draws the usage bar in the color of the disk's health
+func (m *DiskModel) createDiskProgressBar(percentage float64, width int, color lipgloss.Color) string {
 	filled := int(percentage / 100 * float64(width))
 	if filled > width {
 		filled = width
@@ -698,21 +1219,25 @@ func (m DiskModel) createDiskProgressBar(percentage float64, width int) string {
 
 	bar := strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
 
-	var color lipgloss.Color
-	switch {
-	case percentage < 70:
-		color = styles.Success
-	case percentage < 85:
-		color = styles.Warning
-	default:
-		color = styles.Error
-	}
-
 	style := lipgloss.NewStyle().Foreground(color)
 	return style.Render(bar)
 }
 
-func (m DiskModel) formatBytes(bytes int64) string {
+// diskHealthStyle returns the color and label for a disk health status
+func diskHealthStyle(health core.DiskHealthStatus) (lipgloss.Color, string) {
+	switch health {
+	case core.DiskHealthDanger:
+		return styles.Error, "🔴 DANGER"
+	case core.DiskHealthCritical:
+		return styles.Error, "🟠 CRITICAL"
+	case core.DiskHealthWarning:
+		return styles.Warning, "🟡 WARNING"
+	default:
+		return styles.Success, "🟢 HEALTHY"
+	}
+}
+
+func (m *DiskModel) formatBytes(bytes int64) string {
 	if bytes == 0 {
 		return "0 B"
 	}
@@ -731,32 +1256,41 @@ func (m DiskModel) formatBytes(bytes int64) string {
 	return fmt.Sprintf("%.1f %cB", float64(bytes)/float64(div), "KMGTPE"[exp])
 }
 
-// LogEntry represents a JSON log entry
-type LogEntry struct {
-	Component string                 `json:"component"`
-	Level     string                 `json:"level"`
-	Message   string                 `json:"msg"`
-	Time      string                 `json:"time"`
-	Extra     map[string]interface{} `json:"-"`
+// LogsModel represents the logs viewer
+// LogOrderChangedMsg asks the app to remember the logs view order in the config
+type LogOrderChangedMsg struct {
+	OldestFirst bool
 }
 
-// LogsModel represents the logs viewer
 type LogsModel struct {
 	scrollOffset int
 	selectedLine int
 	filterLevel  string
 	followMode   bool
+	oldestFirst  bool // Chronological order, with follow mode tracking the bottom
 	lastLogCount int
+	logFile      string
+	tail         *logging.Tailer // Keeps the read offset so only appended lines are read
+	ui           config.UIConfig
 }
 
-func NewLogsModel() LogsModel {
-	return LogsModel{
+const (
+	logTailBytes   = 256 * 1024 // How much of the end of the log file is read when the view opens
+	logBufferLines = 5000       // How many of the newest entries the view keeps
+)
+
+func NewLogsModel(logFile string, oldestFirst bool, ui config.UIConfig) *LogsModel {
+	return &LogsModel{
+		logFile:     logFile,
+		tail:        logging.NewTailer(logFile, logBufferLines, logTailBytes),
 		filterLevel: "all",
 		followMode:  true, // Start in follow mode by default
+		oldestFirst: oldestFirst,
+		ui:          ui,
 	}
 }
 
-func (m LogsModel) Update(msg tea.Msg) (LogsModel, tea.Cmd) {
+func (m *LogsModel) Update(msg tea.Msg) tea.Cmd {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
@@ -765,15 +1299,36 @@ func (m LogsModel) Update(msg tea.Msg) (LogsModel, tea.Cmd) {
 				m.selectedLine--
 			}
 		case "down", "j":
-			// Will be handled in View when we know line count
-			m.selectedLine++
+			if m.selectedLine < len(m.getLogLines())-1 {
+				m.selectedLine++
+			}
 		case "home", "g":
 			m.selectedLine = 0
+			m.scrollOffset = 0
+			if m.oldestFirst {
+				// Follow mode would jump straight back to the newest entry at the bottom
+				m.followMode = false
+			}
 		case "end", "G":
-			// Will be handled in View when we know line count
+			// View scrolls the list to keep the selection visible
+			m.selectedLine = max(len(m.getLogLines())-1, 0)
+			if !m.oldestFirst {
+				// Follow mode would jump straight back to the newest entry at the top
+				m.followMode = false
+			}
 		case "f":
 			// Toggle follow mode
 			m.followMode = !m.followMode
+		case "o":
+			// Toggle sort order and jump to the newest entry
+			m.oldestFirst = !m.oldestFirst
+			m.scrollOffset = 0
+			m.selectedLine = 0
+			if m.oldestFirst {
+				m.selectedLine = len(m.getLogLines()) - 1
+			}
+			order := LogOrderChangedMsg{OldestFirst: m.oldestFirst}
+			return func() tea.Msg { return order }
 		case "l":
 			// Cycle through filter levels
 			levels := []string{"all", "error", "warn", "info", "debug"}
@@ -789,22 +1344,26 @@ func (m LogsModel) Update(msg tea.Msg) (LogsModel, tea.Cmd) {
 			}
 		}
 	}
-	return m, nil
+	return nil
 }
 
-func (m LogsModel) View(cache interface{}, width, height int) string {
+func (m *LogsModel) View(cache interface{}, width, height int) string {
 	// Reserve space for header, filter, status, and help text (5 lines total)
 	reservedHeight := 5
 	availableHeight := height - reservedHeight
 
 	// Get real log content from file
-	logs := m.getSimulatedLogs()
-	filteredLogs := m.filterLogs(logs, m.filterLevel)
+	filteredLogs := m.getLogLines()
 
-	// Handle follow mode - auto-scroll to top (newest logs) if new logs appear
+	// Handle follow mode - auto-scroll to the newest logs if new logs appear
+	// (the top when newest-first, the bottom when oldest-first)
 	if m.followMode && len(filteredLogs) > m.lastLogCount {
-		m.selectedLine = 0
-		m.scrollOffset = 0
+		if m.oldestFirst {
+			m.selectedLine = len(filteredLogs) - 1
+		} else {
+			m.selectedLine = 0
+			m.scrollOffset = 0
+		}
 	}
 	m.lastLogCount = len(filteredLogs)
 
@@ -817,7 +1376,8 @@ func (m LogsModel) View(cache interface{}, width, height int) string {
 	content = append(content, titleStyle.Render("📋 Application Logs"))
 
 	// Status line with filter and follow mode
-	statusLine := fmt.Sprintf("Filter: %s | Follow: %s | Newest First", m.filterLevel, map[bool]string{true: "ON", false: "OFF"}[m.followMode])
+	statusLine := fmt.Sprintf("Filter: %s | Follow: %s | %s", m.filterLevel, map[bool]string{true: "ON", false: "OFF"}[m.followMode],
+		map[bool]string{true: "Oldest First", false: "Newest First"}[m.oldestFirst])
 	content = append(content, filterStyle.Render(statusLine))
 
 	if len(filteredLogs) == 0 {
@@ -853,11 +1413,10 @@ func (m LogsModel) View(cache interface{}, width, height int) string {
 					Foreground(styles.Background).
 					Background(styles.Primary).
 					Bold(true)
-				content = append(content, selectedStyle.Render(logLine))
+				content = append(content, selectedStyle.Render(logLine.text))
 			} else {
 				// Apply color coding based on log level
-				coloredLine := m.colorCodeLogLine(logLine)
-				content = append(content, coloredLine)
+				content = append(content, colorCodeLogLine(logLine))
 			}
 		}
 	}
@@ -872,7 +1431,10 @@ func (m LogsModel) View(cache interface{}, width, height int) string {
 
 	// Help text
 	helpStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
-	help := "↑/↓: Navigate • F: Toggle follow • L: Change filter • Home/End: Jump to newest/oldest"
+	help := "↑/↓: Navigate • F: Toggle follow • L: Change filter • O: Toggle order • Home/End: Jump to newest/oldest"
+	if m.oldestFirst {
+		help = "↑/↓: Navigate • F: Toggle follow • L: Change filter • O: Toggle order • Home/End: Jump to oldest/newest"
+	}
 	content = append(content, helpStyle.Render(help))
 
 	// Ensure we don't exceed the total height
@@ -883,143 +1445,78 @@ func (m LogsModel) View(cache interface{}, width, height int) string {
 	return lipgloss.JoinVertical(lipgloss.Left, content...)
 }
 
-func (m LogsModel) getSimulatedLogs() []string {
-	// Read from actual log file
-	logFile := "bot_activity.log"
+// logLine is a formatted log entry ready for display
+type logLine struct {
+	level string
+	text  string
+}
 
-	// Try to read the log file
-	content, err := os.ReadFile(logFile)
-	if err != nil {
+// getLogLines reads the lines appended to the log file since the last call and
+// formats the buffered entries matching the current filter in display order
+func (m *LogsModel) getLogLines() []logLine {
+	err := m.tail.Poll()
+	entries := m.tail.Entries()
+	if err != nil && len(entries) == 0 {
 		// If file doesn't exist or can't be read, return a helpful message
-		return []string{
-			fmt.Sprintf("[ERROR] Could not read log file '%s': %v", logFile, err),
-			"",
-			"[INFO] Make sure the log file exists and is readable.",
-			"[INFO] The application will create this file when logging is enabled.",
+		return []logLine{
+			{level: "error", text: fmt.Sprintf("[ERROR] Could not read log file '%s': %v", m.logFile, err)},
+			{text: ""},
+			{level: "info", text: "[INFO] Make sure the log file exists and is readable."},
+			{level: "info", text: "[INFO] The application will create this file when logging is enabled."},
 		}
 	}
 
-	// Split content into lines and filter out empty lines
-	lines := strings.Split(string(content), "\n")
-	var logLines []string
-
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" {
+	level := logging.NormalizeLevel(m.filterLevel)
+	lines := make([]logLine, 0, len(entries))
+	for _, entry := range entries {
+		if level != "" && entry.Level != level {
 			continue
 		}
-
-		// Try to parse as JSON log entry
-		formattedLine, err := m.parseJSONLogLine(line)
-		if err != nil {
-			// If JSON parsing fails, use the raw line
-			logLines = append(logLines, line)
-		} else {
-			logLines = append(logLines, formattedLine)
-		}
+		lines = append(lines, logLine{level: entry.Level, text: m.formatLogEntry(entry)})
 	}
 
-	// If no log lines found, return a message
-	if len(logLines) == 0 {
-		return []string{
-			"[INFO] Log file is empty or contains no valid log entries.",
-			"[INFO] Logs will appear here as the application runs.",
+	// Reverse the order to show newest logs first, unless chronological order is requested
+	if !m.oldestFirst {
+		for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+			lines[i], lines[j] = lines[j], lines[i]
 		}
 	}
 
-	// Reverse the order to show newest logs first
-	for i, j := 0, len(logLines)-1; i < j; i, j = i+1, j-1 {
-		logLines[i], logLines[j] = logLines[j], logLines[i]
-	}
-
-	return logLines
+	return lines
 }
 
-func (m LogsModel) parseJSONLogLine(line string) (string, error) {
-	// Parse the JSON log entry
-	var logEntry LogEntry
-	err := json.Unmarshal([]byte(line), &logEntry)
-	if err != nil {
-		return "", err
+// formatLogEntry formats a log entry as a single display line
+func (m *LogsModel) formatLogEntry(entry logging.LogEntry) string {
+	if entry.Level == "" {
+		return entry.Message
 	}
 
-	// Parse time
 	var timeStr string
-	if logEntry.Time != "" {
-		if t, err := time.Parse(time.RFC3339, logEntry.Time); err == nil {
-			timeStr = t.Format("15:04:05")
-		} else {
-			timeStr = logEntry.Time
-		}
-	}
-
-	// Format the log line - use full level names for better readability
-	var levelDisplay string
-	switch strings.ToLower(logEntry.Level) {
-	case "warn", "warning":
-		levelDisplay = "WARNING"
-	case "error":
-		levelDisplay = "ERROR"
-	case "info":
-		levelDisplay = "INFO"
-	case "debug":
-		levelDisplay = "DEBUG"
-	default:
-		levelDisplay = strings.ToUpper(logEntry.Level)
+	if !entry.Time.IsZero() {
+		timeStr = m.ui.FormatClock(entry.Time)
 	}
 
-	component := logEntry.Component
+	component := entry.Component
 	if component == "" {
 		component = "main"
 	}
 
-	// Create the formatted log line
-	formatted := fmt.Sprintf("%s [%s] %s: %s", timeStr, levelDisplay, component, logEntry.Message)
-
-	return formatted, nil
-}
-
-func (m LogsModel) filterLogs(logs []string, level string) []string {
-	if level == "all" {
-		return logs
-	}
-
-	var filtered []string
-
-	// Map filter levels to the actual log level patterns
-	levelMap := map[string]string{
-		"error":   "ERROR",
-		"warn":    "WARNING",
-		"warning": "WARNING", // Handle both "warn" and "warning"
-		"info":    "INFO",
-		"debug":   "DEBUG",
-	}
-
-	searchPattern := fmt.Sprintf("[%s]", levelMap[level])
-	for _, log := range logs {
-		if strings.Contains(log, searchPattern) {
-			filtered = append(filtered, log)
-		}
-	}
-	return filtered
+	return fmt.Sprintf("%s [%s] %s: %s", timeStr, strings.ToUpper(entry.Level), component, entry.Message)
 }
 
-func (m LogsModel) colorCodeLogLine(logLine string) string {
-	// Apply color coding based on log level
-	if strings.Contains(logLine, "[ERROR]") {
-		errorStyle := lipgloss.NewStyle().Foreground(styles.Error)
-		return errorStyle.Render(logLine)
-	} else if strings.Contains(logLine, "[WARNING]") {
-		warningStyle := lipgloss.NewStyle().Foreground(styles.Warning)
-		return warningStyle.Render(logLine)
-	} else if strings.Contains(logLine, "[INFO]") {
-		infoStyle := lipgloss.NewStyle().Foreground(styles.Info)
-		return infoStyle.Render(logLine)
-	} else if strings.Contains(logLine, "[DEBUG]") {
-		debugStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
-		return debugStyle.Render(logLine)
+// colorCodeLogLine applies color coding based on log level
+func colorCodeLogLine(line logLine) string {
+	switch line.level {
+	case "error", "fatal", "panic":
+		return lipgloss.NewStyle().Foreground(styles.Error).Render(line.text)
+	case "warning":
+		return lipgloss.NewStyle().Foreground(styles.Warning).Render(line.text)
+	case "info":
+		return lipgloss.NewStyle().Foreground(styles.Info).Render(line.text)
+	case "debug", "trace":
+		return lipgloss.NewStyle().Foreground(styles.TextMuted).Render(line.text)
 	}
 
 	// Default color for unknown levels
-	return logLine
+	return line.text
 }
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestTruncateToWidth(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		maxLen int
+		want   string
+	}{
+		{name: "fits", input: "Ubuntu", maxLen: 10, want: "Ubuntu"},
+		{name: "ASCII", input: "Ubuntu 24.04 Desktop", maxLen: 10, want: "Ubuntu ..."},
+		{name: "CJK fits", input: "進撃の巨人", maxLen: 10, want: "進撃の巨人"},
+		{name: "CJK is two columns wide", input: "進撃の巨人 第1話", maxLen: 10, want: "進撃の..."},
+		{name: "CJK odd boundary", input: "進撃の巨人 第1話", maxLen: 8, want: "進撃..."},
+		{name: "emoji", input: "🎬🎥📺🍿 movie night", maxLen: 8, want: "🎬🎥..."},
+		{name: "too small for any rune", input: "進撃の巨人", maxLen: 3, want: "..."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncateToWidth(tt.input, tt.maxLen)
+			if got != tt.want {
+				t.Errorf("truncateToWidth(%q, %d) = %q, want %q", tt.input, tt.maxLen, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("truncateToWidth(%q, %d) = %q is not valid UTF-8", tt.input, tt.maxLen, got)
+			}
+			if w := lipgloss.Width(got); w > tt.maxLen {
+				t.Errorf("truncateToWidth(%q, %d) is %d columns wide, want at most %d", tt.input, tt.maxLen, w, tt.maxLen)
+			}
+		})
+	}
+}
@@ -13,7 +13,17 @@ type CachedData struct {
 	Stats       *AppStats
 	DiskInfo    map[string]*core.DiskInfo
 	SeedingInfo *core.SeedingStatus
+	ServerState *qbittorrent.ServerState
 	LastFetch   map[string]time.Time
+
+	// SpeedHistory holds the most recent total speeds, oldest first
+	SpeedHistory []SpeedSample
+}
+
+// SpeedSample is the total transfer speed at one point in time
+type SpeedSample struct {
+	DownSpeed int64
+	UpSpeed   int64
 }
 
 // AppStats holds overall application statistics
@@ -26,4 +36,9 @@ type AppStats struct {
 	TotalDownSpeed  int64
 	TotalUpSpeed    int64
 	LastUpdate      time.Time
+
+	// Byte totals from TorrentService.GetTorrentStats, zero until it first returns
+	TotalSize       int64
+	TotalDownloaded int64
+	TotalUploaded   int64
 }
@@ -5,17 +5,20 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/raainshe/akira/internal/cache"
 	"github.com/raainshe/akira/internal/config"
 	"github.com/raainshe/akira/internal/core"
+	"github.com/raainshe/akira/internal/logging"
 	"github.com/raainshe/akira/internal/qbittorrent"
 )
 
 // Run starts the Bubbletea TUI application
 func Run(ctx context.Context, cfg *config.Config, torrentService *core.TorrentService,
-	diskService *core.DiskService, seedingService *core.SeedingService, qbClient *qbittorrent.Client) error {
+	diskService *core.DiskService, seedingService *core.SeedingService, qbClient *qbittorrent.Client,
+	cacheManager *cache.CacheManager) error {
 
 	// Create the main TUI model
-	model := NewAppModel(ctx, cfg, torrentService, diskService, seedingService, qbClient)
+	model := NewAppModel(ctx, cfg, torrentService, diskService, seedingService, qbClient, cacheManager)
 
 	// Create the Bubbletea program
 	program := tea.NewProgram(
@@ -25,6 +28,14 @@ func Run(ctx context.Context, cfg *config.Config, torrentService *core.TorrentSe
 	)
 
 	// Run the program
-	_, err := program.Run()
+	finalModel, err := program.Run()
+
+	// Keep the last fetched torrents for the next start
+	if app, ok := finalModel.(AppModel); ok {
+		if saveErr := app.SavePersistedCache(); saveErr != nil {
+			logging.GetCacheLogger().WithError(saveErr).Warn("Failed to save TUI cache")
+		}
+	}
+
 	return err
 }
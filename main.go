@@ -1,10 +1,12 @@
 package main
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -14,9 +16,11 @@ import (
 
 	"github.com/raainshe/akira/cmd"
 	"github.com/raainshe/akira/internal/cache"
+	"github.com/raainshe/akira/internal/cli"
 	"github.com/raainshe/akira/internal/config"
 	"github.com/raainshe/akira/internal/core"
 	"github.com/raainshe/akira/internal/logging"
+	"github.com/raainshe/akira/internal/metrics"
 	"github.com/raainshe/akira/internal/qbittorrent"
 	"github.com/raainshe/akira/internal/tui"
 )
@@ -43,6 +47,7 @@ type AppServices struct {
 	TorrentService *core.TorrentService
 	DiskService    *core.DiskService
 	SeedingService *core.SeedingService
+	MetricsServer  *metrics.Server // nil unless METRICS_ENABLED is set
 }
 
 func main() {
@@ -61,21 +66,39 @@ func main() {
 
 	// Check if this is a minimal command that doesn't need full service initialization
 	args := os.Args[1:]
-	if len(args) > 0 && (args[0] == "status" || args[0] == "stop" || args[0] == "--help" || args[0] == "-h") {
+	if len(args) > 0 && (args[0] == "status" || args[0] == "stop" || args[0] == "config" || args[0] == "--help" || args[0] == "-h") {
 		// Create minimal root command for status/stop commands
 		rootCmd := createMinimalRootCommand()
 		if err := rootCmd.Execute(); err != nil {
-			fmt.Fprintf(os.Stderr, "❌ Command failed: %v\n", err)
-			os.Exit(1)
+			if !cmd.IsReported(err) {
+				fmt.Fprintf(os.Stderr, "❌ Command failed: %v\n", err)
+			}
+			os.Exit(cmd.ExitCode(err))
 		}
 		return
 	}
 
+	// Services are created before flags are parsed, so look for flags that affect them here
+	noCookieCache := false
+	for _, arg := range args {
+		if arg == "--no-cookie-cache" {
+			noCookieCache = true
+		}
+	}
+
+	// The TUI starts while qBittorrent is unreachable and reconnects by itself,
+	// and version reports qBittorrent as unavailable
+	allowOffline := launchesTUI(args) || (len(args) > 0 && args[0] == "version")
+
 	// Initialize services for full commands
-	services, err := initializeServices(ctx)
+	services, err := initializeServices(ctx, noCookieCache, allowOffline)
+	if err != nil && !config.HasConfig() {
+		// First run: offer to create a config instead of showing a raw connection error
+		services, err = handleFirstRun(ctx, err, noCookieCache, allowOffline)
+	}
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "❌ Failed to initialize services: %v\n", err)
-		os.Exit(1)
+		os.Exit(cmd.ExitCode(err))
 	}
 
 	// Create root command
@@ -83,13 +106,15 @@ func main() {
 
 	// Execute command
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintf(os.Stderr, "❌ Command failed: %v\n", err)
-		cleanup(services)
-		os.Exit(1)
+		if !cmd.IsReported(err) {
+			fmt.Fprintf(os.Stderr, "❌ Command failed: %v\n", err)
+		}
+		cleanup(services, cmd.SessionRan())
+		os.Exit(cmd.ExitCode(err))
 	}
 
 	// Cleanup services
-	cleanup(services)
+	cleanup(services, cmd.SessionRan())
 }
 
 // createRootCommand creates the main Cobra root command
@@ -114,10 +139,11 @@ Examples:
   akira add "magnet:..."  # Add torrent via CLI
   akira seeding status    # Check seeding status`,
 		Version: fmt.Sprintf("%s (built: %s, commit: %s)", version, buildTime, gitCommit),
-		RunE: func(cmd *cobra.Command, args []string) error {
+		RunE: func(_ *cobra.Command, args []string) error {
 			// Default action: Launch TUI
+			cmd.MarkSessionRan()
 			return tui.Run(ctx, services.Config, services.TorrentService,
-				services.DiskService, services.SeedingService, services.QBClient)
+				services.DiskService, services.SeedingService, services.QBClient, services.Cache)
 		},
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			// Handle global flags
@@ -142,31 +168,63 @@ Examples:
 				services.Logger.SetLevel(logrus.WarnLevel)
 			}
 
+			// Keep stdout parseable for commands printing JSON or CSV
+			if machineReadableOutput(cmd) {
+				services.Logger.ConsoleToStderr()
+			}
+
 			return nil
 		},
 	}
 
+	// Unknown flags and unparseable flag values are rejected input
+	rootCmd.SetFlagErrorFunc(func(c *cobra.Command, err error) error {
+		return cmd.NewExitError(cmd.ExitValidationError, err)
+	})
+
 	// Global flags
 	rootCmd.PersistentFlags().StringVarP(&configFile, "config", "c", "", "config file path")
 	rootCmd.PersistentFlags().StringVarP(&logLevel, "log-level", "l", "", "log level (debug, info, warn, error) - default: warn")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "verbose output (shows all logs)")
+	// Read in main before services are created; registered so Cobra accepts it
+	rootCmd.PersistentFlags().Bool("no-cookie-cache", false, "log in to qBittorrent without reusing or saving the cached session")
+	// Read by cleanup once the command has finished
+	rootCmd.PersistentFlags().BoolVar(&services.Config.Seeding.StopOnExit, "stop-seeding-on-exit", services.Config.Seeding.StopOnExit,
+		"pause all tracked seeding torrents when the TUI, daemon or API server exits")
 
 	// Add all subcommands
 	rootCmd.AddCommand(
-		cmd.NewTUICommand(ctx, services.Config, services.TorrentService, services.DiskService, services.SeedingService, services.QBClient),
-		cmd.NewListCommand(ctx, services.TorrentService),
+		cmd.NewTUICommand(ctx, services.Config, services.TorrentService, services.DiskService, services.SeedingService, services.QBClient, services.Cache),
+		cmd.NewListCommand(ctx, services.TorrentService, services.SeedingService),
 		cmd.NewDownloadingCommand(ctx, services.TorrentService),
-		cmd.NewAddCommand(ctx, services.TorrentService, services.SeedingService),
+		cmd.NewDiffCommand(ctx, services.TorrentService),
+		cmd.NewAddCommand(ctx, services.Config, services.TorrentService, services.SeedingService),
+		cmd.NewAddBatchCommand(ctx, services.TorrentService, services.SeedingService),
 		cmd.NewDeleteCommand(ctx, services.TorrentService, services.SeedingService),
+		cmd.NewInfoCommand(ctx, services.Config, services.TorrentService, services.SeedingService),
+		cmd.NewFilesCommand(ctx, services.TorrentService),
+		cmd.NewFilePriorityCommand(ctx, services.TorrentService),
+		cmd.NewMagnetsCommand(ctx, services.TorrentService),
+		cmd.NewSetCategoryCommand(ctx, services.TorrentService),
+		cmd.NewTagCommand(ctx, services.TorrentService),
+		cmd.NewRenameCommand(ctx, services.TorrentService),
+		cmd.NewMoveCommand(ctx, services.Config, services.TorrentService, services.DiskService),
+		cmd.NewExportCommand(ctx, services.TorrentService),
+		cmd.NewRecheckCommand(ctx, services.TorrentService),
+		cmd.NewReannounceCommand(ctx, services.TorrentService),
+		cmd.NewLimitCommand(ctx, services.TorrentService),
+		cmd.NewAltSpeedCommand(ctx, services.TorrentService),
 		cmd.NewDiskCommand(ctx, services.DiskService),
 		cmd.NewLogsCommand(ctx, services.Config),
-		cmd.NewSeedingCommand(ctx, services.SeedingService),
+		cmd.NewSeedingCommand(ctx, services.Config, services.SeedingService),
+		cmd.NewServeCommand(ctx, services.Config, services.TorrentService, services.DiskService, services.SeedingService),
 		cmd.NewDaemonCommand(ctx, services.Config, services.TorrentService, services.DiskService, services.SeedingService, services.QBClient),
 		cmd.NewStatusCommand(),
 		cmd.NewStopCommand(),
 		cmd.NewRestartCommand(ctx, services.Config, services.TorrentService, services.DiskService, services.SeedingService, services.QBClient),
-		cmd.NewVersionCommand(version, buildTime, gitCommit),
+		cmd.NewVersionCommand(ctx, version, buildTime, gitCommit, services.QBClient),
 	)
+	cmd.ValidateArgs(rootCmd)
 
 	return rootCmd
 }
@@ -184,17 +242,77 @@ fits your workflow.`,
 		Version: fmt.Sprintf("%s (built: %s, commit: %s)", version, buildTime, gitCommit),
 	}
 
+	rootCmd.SetFlagErrorFunc(func(c *cobra.Command, err error) error {
+		return cmd.NewExitError(cmd.ExitValidationError, err)
+	})
+
 	// Add only minimal commands that don't need service initialization
 	rootCmd.AddCommand(
 		cmd.NewStatusCommand(),
 		cmd.NewStopCommand(),
+		cmd.NewConfigCommand(),
 	)
+	cmd.ValidateArgs(rootCmd)
 
 	return rootCmd
 }
 
-// initializeServices initializes all application services
-func initializeServices(ctx context.Context) (*AppServices, error) {
+// handleFirstRun is called when initialization failed and no configuration exists.
+// In a terminal it offers to run 'akira config init' and retries; otherwise it
+// explains where the configuration is expected.
+func handleFirstRun(ctx context.Context, initErr error, noCookieCache, allowOffline bool) (*AppServices, error) {
+	configPath := config.ConfigPath()
+
+	if !cmd.IsInteractive(os.Stdin) {
+		return nil, fmt.Errorf("no configuration found (expected %s); run 'akira config init' or set the QBITTORRENT_* environment variables: %w",
+			configPath, initErr)
+	}
+
+	fmt.Printf("👋 No Akira configuration found (expected %s).\n", configPath)
+	fmt.Printf("❓ Create one now? (Y/n): ")
+
+	reader := bufio.NewReader(os.Stdin)
+	response, readErr := reader.ReadString('\n')
+	response = strings.ToLower(strings.TrimSpace(response))
+	if (readErr != nil && response == "") || (response != "" && response != "y" && response != "yes") {
+		return nil, fmt.Errorf("no configuration found; run 'akira config init' to create %s: %w", configPath, initErr)
+	}
+
+	fmt.Println()
+	if err := cmd.RunConfigInit(reader, os.Stdout, configPath, false); err != nil {
+		return nil, err
+	}
+	fmt.Println()
+
+	return initializeServices(ctx, noCookieCache, allowOffline)
+}
+
+// machineReadableOutput reports whether the command was asked for JSON or CSV
+// output on stdout, through --json or --output
+func machineReadableOutput(cmd *cobra.Command) bool {
+	if jsonOutput, err := cmd.Flags().GetBool("json"); err == nil && jsonOutput {
+		return true
+	}
+	if output, err := cmd.Flags().GetString("output"); err == nil && cmd.Flags().Changed("output") {
+		return output == string(cli.OutputJSON) || output == string(cli.OutputCSV)
+	}
+	return false
+}
+
+// launchesTUI reports whether the arguments run the TUI, either explicitly or
+// as the default when no command is given
+func launchesTUI(args []string) bool {
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, "-") {
+			return arg == "tui"
+		}
+	}
+	return true
+}
+
+// initializeServices initializes all application services. With allowOffline,
+// failing to reach qBittorrent is logged instead of returned.
+func initializeServices(ctx context.Context, noCookieCache, allowOffline bool) (*AppServices, error) {
 	// Load configuration
 	cfg, err := config.LoadConfig()
 	if err != nil {
@@ -223,20 +341,31 @@ func initializeServices(ctx context.Context) (*AppServices, error) {
 	}
 
 	// Initialize qBittorrent client
-	qbClient, err := qbittorrent.NewClient(cfg.QBittorrent.URL, cfg.QBittorrent.Username, cfg.QBittorrent.Password)
+	clientOptions := []qbittorrent.ClientOption{
+		qbittorrent.WithTimeout(cfg.QBittorrent.RequestTimeout),
+		qbittorrent.WithRetries(cfg.QBittorrent.MaxRetries, cfg.QBittorrent.RetryDelay),
+	}
+	if cfg.QBittorrent.CookieCache && !noCookieCache {
+		clientOptions = append(clientOptions, qbittorrent.WithSessionFile(cfg.QBittorrent.SessionFile))
+	}
+	qbClient, err := qbittorrent.NewClient(cfg.QBittorrent.URL, cfg.QBittorrent.Username, cfg.QBittorrent.Password, clientOptions...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create qBittorrent client: %w", err)
+		return nil, fmt.Errorf("failed to create qBittorrent client (check QBITTORRENT_URL): %w", err)
 	}
 
 	// Test qBittorrent connection
-	if err := qbClient.Login(ctx); err != nil {
-		return nil, fmt.Errorf("failed to connect to qBittorrent: %w", err)
+	if err := qbClient.Connect(ctx); err != nil {
+		if !allowOffline {
+			return nil, fmt.Errorf("failed to connect to qBittorrent: %w", err)
+		}
+		mainLogger.WithError(err).Warn("qBittorrent is unreachable, starting anyway")
+	} else {
+		mainLogger.Info("✅ Connected to qBittorrent successfully")
 	}
-	mainLogger.Info("✅ Connected to qBittorrent successfully")
 
 	// Initialize core services
 	torrentService := core.NewTorrentService(qbClient, cfg, cacheManager)
-	diskService := core.NewDiskService(cfg, cacheManager)
+	diskService := core.NewDiskService(cfg, cacheManager, qbClient)
 	seedingService := core.NewSeedingService(cfg, torrentService, qbClient)
 
 	// Start seeding service
@@ -245,6 +374,19 @@ func initializeServices(ctx context.Context) (*AppServices, error) {
 	}
 	mainLogger.Info("🌱 Seeding management service started")
 
+	// Start the metrics server. Another akira process may already be serving
+	// metrics on the same address, so failing to listen isn't fatal.
+	var metricsServer *metrics.Server
+	if cfg.Metrics.Enabled {
+		metricsServer = metrics.NewServer(cfg.Metrics.Addr, qbClient, torrentService, diskService, seedingService)
+		if err := metricsServer.Start(); err != nil {
+			mainLogger.WithError(err).Warn("Failed to start metrics server")
+			metricsServer = nil
+		} else {
+			mainLogger.WithField("addr", cfg.Metrics.Addr).Info("📈 Metrics server started")
+		}
+	}
+
 	mainLogger.Info("✅ All services initialized successfully")
 
 	return &AppServices{
@@ -255,11 +397,13 @@ func initializeServices(ctx context.Context) (*AppServices, error) {
 		TorrentService: torrentService,
 		DiskService:    diskService,
 		SeedingService: seedingService,
+		MetricsServer:  metricsServer,
 	}, nil
 }
 
-// cleanup gracefully shuts down all services
-func cleanup(services *AppServices) {
+// cleanup gracefully shuts down all services. stopSeeding is set when a
+// long-running session ended, for SEEDING_STOP_ON_EXIT.
+func cleanup(services *AppServices, stopSeeding bool) {
 	if services == nil {
 		return
 	}
@@ -267,6 +411,38 @@ func cleanup(services *AppServices) {
 	mainLogger := logging.GetLogger()
 	mainLogger.Info("🧹 Cleaning up services...")
 
+	// Let skip patterns of just added torrents finish before the process ends
+	if services.TorrentService != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		if err := services.TorrentService.WaitBackground(ctx); err != nil {
+			mainLogger.WithError(err).Warn("Gave up waiting for skip patterns to be applied")
+		}
+		cancel()
+	}
+
+	// Free upload bandwidth while Akira isn't running, if requested
+	if stopSeeding && services.SeedingService != nil && services.Config.Seeding.StopOnExit {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		stopped, err := services.SeedingService.StopAllSeeding(ctx, qbittorrent.SeedingStopReasonExit)
+		cancel()
+		if err != nil {
+			mainLogger.WithError(err).Error("Failed to stop seeding on exit")
+		} else {
+			mainLogger.WithField("count", stopped).Info("✅ Stopped seeding on exit")
+		}
+	}
+
+	// Stop metrics server
+	if services.MetricsServer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := services.MetricsServer.Stop(ctx); err != nil {
+			mainLogger.WithError(err).Error("Failed to stop metrics server")
+		} else {
+			mainLogger.Info("✅ Metrics server stopped")
+		}
+		cancel()
+	}
+
 	// Stop seeding service
 	if services.SeedingService != nil {
 		if err := services.SeedingService.Stop(); err != nil {
@@ -276,8 +452,8 @@ func cleanup(services *AppServices) {
 		}
 	}
 
-	// Logout from qBittorrent
-	if services.QBClient != nil {
+	// Logout from qBittorrent, unless the session is kept for the next invocation
+	if services.QBClient != nil && !services.QBClient.CachesSession() {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 		if err := services.QBClient.Logout(ctx); err != nil {